@@ -4,6 +4,7 @@ import (
 	"darius/models"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/spf13/viper"
 	"gorm.io/driver/mysql"
@@ -11,8 +12,28 @@ import (
 )
 
 type Database interface {
-	CreateReport(entry, res, resp, requestKey string, amount float64) error
+	CreateReport(entry, res, resp, requestKey, provider, model string, amount float64) error
 	GetByRequestKey(requestKey string) (*models.LLMCallReport, error)
+
+	CreateJob(job *models.Job) error
+	GetJobByRequestKey(requestKey string) (*models.Job, error)
+	ListJobs(status string, updatedBefore time.Time) ([]models.Job, error)
+	ClaimJob(job *models.Job) (bool, error)
+	TouchJob(job *models.Job) error
+	ListPendingCallbacks(updatedBefore time.Time) ([]models.Job, error)
+	ClaimCallback(job *models.Job) (bool, error)
+	UpdateJob(job *models.Job) error
+
+	CreateQuestions(questions []models.Question) error
+	SearchQuestions(filter models.QuestionFilter) ([]models.Question, int64, error)
+	GetQuestion(id uint) (*models.Question, error)
+	UpdateQuestionStatus(id uint, status string) error
+	CreateExposures(exposures []models.QuestionExposure) error
+
+	CreateDocument(document *models.Document) error
+	GetDocuments(ids []uint) ([]models.Document, error)
+	ListDocumentChunks(documentIds []uint) ([]models.DocumentChunk, error)
+	GetDocumentChunks(ids []uint) ([]models.DocumentChunk, error)
 }
 
 type db struct {
@@ -26,7 +47,7 @@ func NewDatabase() (Database, error) {
 		log.Printf("Error connecting to database: %v", err)
 		return nil, err
 	}
-	db.DB.AutoMigrate(&models.LLMCallReport{})
+	db.DB.AutoMigrate(&models.LLMCallReport{}, &models.Job{}, &models.Question{}, &models.QuestionTag{}, &models.QuestionExposure{}, &models.Document{}, &models.DocumentChunk{})
 	return db, nil
 }
 
@@ -59,11 +80,13 @@ func (d *db) connectDatabase() error {
 	return nil
 }
 
-func (d *db) CreateReport(entry, res, resp, requestKey string, amount float64) error {
+func (d *db) CreateReport(entry, res, resp, requestKey, provider, model string, amount float64) error {
 	report := models.LLMCallReport{
 		Entry:      entry,
 		Res:        res,
 		Resp:       resp,
+		Provider:   provider,
+		Model:      model,
 		Amount:     amount,
 		RequestKey: requestKey,
 	}
@@ -80,3 +103,166 @@ func (d *db) GetByRequestKey(requestKey string) (*models.LLMCallReport, error) {
 	}
 	return &report, nil
 }
+
+func (d *db) CreateJob(job *models.Job) error {
+	return d.DB.Create(job).Error
+}
+
+func (d *db) GetJobByRequestKey(requestKey string) (*models.Job, error) {
+	var job models.Job
+	result := d.DB.Where("request_key = ?", requestKey).First(&job)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &job, nil
+}
+
+func (d *db) ListJobs(status string, updatedBefore time.Time) ([]models.Job, error) {
+	var jobs []models.Job
+	result := d.DB.Where("status = ? AND updated_at < ?", status, updatedBefore).Order("id").Find(&jobs)
+	return jobs, result.Error
+}
+
+// ClaimJob moves a job to running as long as nobody touched it since it was read. It
+// reports false when another worker changed the job first.
+func (d *db) ClaimJob(job *models.Job) (bool, error) {
+	now := time.Now()
+	result := d.DB.Model(&models.Job{}).
+		Where("id = ? AND status = ? AND updated_at = ?", job.ID, job.Status, job.UpdatedAt).
+		Updates(map[string]interface{}{
+			"status":     models.JobStatusRunning,
+			"attempts":   gorm.Expr("attempts + 1"),
+			"started_at": now,
+			"updated_at": now,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// TouchJob bumps the updated_at of a job that is still running, so it isn't taken for
+// abandoned while its worker is busy with it.
+func (d *db) TouchJob(job *models.Job) error {
+	return d.DB.Model(&models.Job{}).
+		Where("id = ? AND status = ?", job.ID, models.JobStatusRunning).
+		Update("updated_at", time.Now()).Error
+}
+
+// ListPendingCallbacks returns the finished jobs whose callback is still to be delivered.
+func (d *db) ListPendingCallbacks(updatedBefore time.Time) ([]models.Job, error) {
+	var jobs []models.Job
+	result := d.DB.Where("callback_status = ? AND updated_at < ?", models.CallbackStatusPending, updatedBefore).Order("id").Find(&jobs)
+	return jobs, result.Error
+}
+
+// ClaimCallback takes over the pending callback of a job as long as nobody touched the job
+// since it was read. It reports false when another instance got to it first.
+func (d *db) ClaimCallback(job *models.Job) (bool, error) {
+	result := d.DB.Model(&models.Job{}).
+		Where("id = ? AND callback_status = ? AND updated_at = ?", job.ID, models.CallbackStatusPending, job.UpdatedAt).
+		Update("updated_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (d *db) UpdateJob(job *models.Job) error {
+	return d.DB.Save(job).Error
+}
+
+// CreateQuestions saves the questions with their tags in one transaction.
+func (d *db) CreateQuestions(questions []models.Question) error {
+	return d.DB.Create(&questions).Error
+}
+
+// SearchQuestions returns one page of the questions matching the filter, newest first,
+// and how many match in total. Query is matched against the full-text index of the
+// question text.
+func (d *db) SearchQuestions(filter models.QuestionFilter) ([]models.Question, int64, error) {
+	matching := func(tx *gorm.DB) *gorm.DB {
+		if filter.Query != "" {
+			tx = tx.Where("MATCH(text) AGAINST(? IN NATURAL LANGUAGE MODE)", filter.Query)
+		}
+		columns := []struct{ column, value string }{
+			{"topic", filter.Topic},
+			{"level", filter.Level},
+			{"type", filter.Type},
+			{"language", filter.Language},
+			{"status", filter.Status},
+			{"author_id", filter.AuthorId},
+		}
+		for _, c := range columns {
+			if c.value != "" {
+				tx = tx.Where(c.column+" = ?", c.value)
+			}
+		}
+		if len(filter.Tags) > 0 {
+			tagged := d.DB.Model(&models.QuestionTag{}).
+				Select("question_id").
+				Where("tag IN ?", filter.Tags).
+				Group("question_id").
+				Having("COUNT(DISTINCT tag) = ?", len(filter.Tags))
+			tx = tx.Where("id IN (?)", tagged)
+		}
+		if filter.UnseenBy != "" {
+			seen := d.DB.Model(&models.QuestionExposure{}).
+				Select("question_id").
+				Where("candidate_pool = ? AND created_at > ?", filter.UnseenBy, filter.SeenSince)
+			tx = tx.Where("id NOT IN (?)", seen)
+		}
+		return tx
+	}
+
+	var total int64
+	if err := d.DB.Model(&models.Question{}).Scopes(matching).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var questions []models.Question
+	result := d.DB.Scopes(matching).Preload("Tags").Order("id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&questions)
+	return questions, total, result.Error
+}
+
+func (d *db) GetQuestion(id uint) (*models.Question, error) {
+	var question models.Question
+	result := d.DB.Preload("Tags").First(&question, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &question, nil
+}
+
+func (d *db) UpdateQuestionStatus(id uint, status string) error {
+	result := d.DB.Model(&models.Question{}).Where("id = ?", id).Update("status", status)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (d *db) CreateExposures(exposures []models.QuestionExposure) error {
+	return d.DB.Create(&exposures).Error
+}
+
+// CreateDocument saves the document with its chunks in one transaction.
+func (d *db) CreateDocument(document *models.Document) error {
+	return d.DB.Create(document).Error
+}
+
+// GetDocuments returns the documents with the ids that exist, without their chunks.
+func (d *db) GetDocuments(ids []uint) ([]models.Document, error) {
+	var documents []models.Document
+	result := d.DB.Where("id IN ?", ids).Find(&documents)
+	return documents, result.Error
+}
+
+// ListDocumentChunks returns every chunk of the documents, in document order.
+func (d *db) ListDocumentChunks(documentIds []uint) ([]models.DocumentChunk, error) {
+	var chunks []models.DocumentChunk
+	result := d.DB.Where("document_id IN ?", documentIds).Order("document_id, position").Find(&chunks)
+	return chunks, result.Error
+}
+
+func (d *db) GetDocumentChunks(ids []uint) ([]models.DocumentChunk, error) {
+	var chunks []models.DocumentChunk
+	result := d.DB.Where("id IN ?", ids).Find(&chunks)
+	return chunks, result.Error
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	suggest "darius/pkg/proto/suggest"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
@@ -15,6 +17,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func corsMiddleware(h http.Handler) http.Handler {
@@ -24,7 +30,7 @@ func corsMiddleware(h http.Handler) http.Handler {
 		}
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-user-id")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-user-id, x-user-role")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusNoContent)
@@ -36,7 +42,7 @@ func corsMiddleware(h http.Handler) http.Handler {
 }
 
 func customHeaderMatcher(key string) (string, bool) {
-	if strings.ToLower(key) == "x-user-id" {
+	if lower := strings.ToLower(key); lower == "x-user-id" || lower == "x-user-role" {
 		return key, true
 	}
 	return runtime.DefaultHeaderMatcher(key)
@@ -60,6 +66,98 @@ func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler ru
 	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
 }
 
+// jobEventsHandler serves WatchJob as server-sent events, one "event: <status>" message
+// per state transition of the job.
+func jobEventsHandler(client suggest.SuggestServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		ctx := r.Context()
+		if userId := r.Header.Get("X-User-Id"); userId != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", userId)
+		}
+
+		stream, err := client.WatchJob(ctx, &suggest.GetJobRequest{RequestKey: pathParams["requestKey"]})
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		started := false
+		for {
+			job, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				if !started {
+					http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+					return
+				}
+				fmt.Fprintf(w, "event: error\ndata: %q\n\n", status.Convert(err).Message())
+				flusher.Flush()
+				return
+			}
+
+			if !started {
+				started = true
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.Header().Set("Cache-Control", "no-cache")
+				w.Header().Set("Connection", "keep-alive")
+				w.WriteHeader(http.StatusOK)
+			}
+			data, _ := protojson.Marshal(job)
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", job.GetStatus(), data)
+			flusher.Flush()
+		}
+	}
+}
+
+// examDownloadHandler serves ExportExam as a file download. The ids of the questions the
+// format can't express are listed in the X-Skipped-Questions header.
+func examDownloadHandler(client suggest.SuggestServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req := &suggest.ExportExamRequest{}
+		if err := protojson.Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+		if userId := r.Header.Get("X-User-Id"); userId != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", userId)
+		}
+
+		file, err := client.ExportExam(ctx, req)
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		var skipped []string
+		for _, violation := range file.GetSkipped() {
+			skipped = append(skipped, strconv.Itoa(int(violation.GetQuestionId())))
+		}
+		w.Header().Set("Content-Type", file.GetContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.GetFileName()))
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Disposition, X-Skipped-Questions")
+		if len(skipped) > 0 {
+			w.Header().Set("X-Skipped-Questions", strings.Join(skipped, ","))
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(file.GetContent())
+	}
+}
+
 func startGateway() {
 	grpcPort := viper.GetString("grpc.port")
 
@@ -73,6 +171,20 @@ func startGateway() {
 		log.Fatalf("Failed to register gateway: %v", err)
 	}
 
+	conn, err := grpc.NewClient("localhost:"+grpcPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect gateway to gRPC server: %v", err)
+	}
+	defer conn.Close()
+	err = grpcMux.HandlePath(http.MethodGet, "/v1/jobs/{requestKey}/events", jobEventsHandler(suggest.NewSuggestServiceClient(conn)))
+	if err != nil {
+		log.Fatalf("Failed to register job events endpoint: %v", err)
+	}
+	err = grpcMux.HandlePath(http.MethodPost, "/v2/export_exam/download", examDownloadHandler(suggest.NewSuggestServiceClient(conn)))
+	if err != nil {
+		log.Fatalf("Failed to register exam download endpoint: %v", err)
+	}
+
 	mainMux := http.NewServeMux()
 
 	mainMux.Handle("/metrics", promhttp.Handler())
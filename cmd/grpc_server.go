@@ -3,12 +3,16 @@ package cmd
 import (
 	"context"
 	"darius/cmd/db"
+	"darius/internal/constants"
 	"darius/internal/handler"
 	f2_score "darius/internal/handler/f2-score"
+	"darius/internal/resilience"
+	"darius/internal/sandbox"
 	bulbasaurService "darius/internal/services/bulbasaur"
 	llm_grpc "darius/internal/services/llm-grpc"
 	missfortune "darius/internal/services/missfortune"
 	databaseService "darius/internal/services/repo"
+	jobManager "darius/managers/job"
 	llmManager "darius/managers/llm"
 	arceus "darius/pkg/proto/deps/arceus"
 	"darius/pkg/proto/deps/bulbasaur"
@@ -48,6 +52,25 @@ func AuthInterceptor(
 	return handler(ctx, req)
 }
 
+func StreamAuthInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	userId, err := ctxdata.GetUserIdFromContext(ss.Context())
+	if err != nil {
+		log.Printf("Error getting user ID from context: %v", err)
+		return status.Error(codes.Internal, "failed to get user ID from context")
+	}
+
+	if userId == "" {
+		return status.Error(codes.Unauthenticated, "user ID is required")
+	}
+
+	return handler(srv, ss)
+}
+
 func startGRPC() {
 	//server gateway
 	port := viper.GetString("grpc.port")
@@ -75,7 +98,12 @@ func startGRPC() {
 	}
 	addr := flag.String("addr", llmGRPCAddress+":"+llmGRPCPort, "the address to connect to")
 	flag.Parse()
-	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	arceusGuard := initGuard(llm_grpc.ProviderArceus, llmGuardConfig)
+	conn, err := grpc.NewClient(*addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(resilience.UnaryClientInterceptor(arceusGuard)),
+		grpc.WithStreamInterceptor(resilience.StreamClientInterceptor(arceusGuard)),
+	)
 	if err != nil {
 		log.Printf("did not connect: %v", err)
 	}
@@ -94,7 +122,10 @@ func startGRPC() {
 	}
 
 	bulbasaurAddr := bulbasaurHost + ":" + bulbasaurPort
-	bulbasaurConn, err := grpc.NewClient(bulbasaurAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	bulbasaurConn, err := grpc.NewClient(bulbasaurAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(resilience.UnaryClientInterceptor(initGuard("bulbasaur", bulbasaurGuardConfig))),
+	)
 	if err != nil {
 		log.Printf("did not connect: %v", err)
 	}
@@ -102,7 +133,9 @@ func startGRPC() {
 
 	arceusClient := arceus.NewArceusClient(conn)
 	log.Printf("Connected to LLM gRPC server at %s", *addr)
-	llmGRPCService := llm_grpc.NewService(arceusClient, llmGRPCModel)
+	llmProviders := initLLMProviders(arceusClient)
+	initLLMRoutes()
+	llmGRPCService := llm_grpc.NewService(llmProviders, llmGRPCModel)
 
 	bulbasaurClient := bulbasaur.NewVenusaurClient(bulbasaurConn)
 	bulbasaurService := bulbasaurService.NewService(bulbasaurClient)
@@ -139,6 +172,7 @@ func startGRPC() {
 
 	dbService := databaseService.NewService(db)
 	llmManager := llmManager.NewManager(llmGRPCService, dbService)
+	jobManager := jobManager.NewManager(databaseService.NewJobService(db), initJobConfig())
 
 	if f2reqCh != nil && f2respCh != nil {
 		f2scoringHandler := f2_score.NewScoringHandler(llmManager, f2respCh, f2respQ)
@@ -166,24 +200,27 @@ func startGRPC() {
 	// 		Model:   llmModel,
 	// 		Content: "Hello, how are you?"})
 
-	// LlmService := llm.NewLLM(&llm.Config{
-	// 	Host:  llmHost,
-	// 	Model: llmModel,
-	// })
 	// if err != nil {
 	// 	log.Printff("could not greet: %v", err)
 	// }
 	// log.Printf("Greeting: %s", r.Content)
 
 	handler := handler.NewHandlerWithDeps(handler.Dependency{
-		// LlmService: LlmService,
-		LLMManager:  llmManager,
-		Missfortune: missfortuneService,
-		Bulbasaur:   bulbasaurService,
+		LLMManager:   llmManager,
+		JobManager:   jobManager,
+		Missfortune:  missfortuneService,
+		Bulbasaur:    bulbasaurService,
+		Sandbox:      sandbox.New(initSandboxConfig()),
+		QuestionBank: databaseService.NewQuestionService(db),
+		Documents:    databaseService.NewDocumentService(db),
+		Config:       initHandlerConfig(),
 	})
+	jobManager.Register(constants.F1_SUGGEST_QUESTIONS, handler.RunSuggestQuestionsJob)
+	jobManager.Start(context.Background())
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(AuthInterceptor),
+		grpc.StreamInterceptor(StreamAuthInterceptor),
 	)
 	// hello.RegisterHelloServiceServer(grpcServer, handler)
 	suggest.RegisterSuggestServiceServer(grpcServer, handler)
@@ -1,13 +1,217 @@
 package cmd
 
 import (
+	"darius/internal/constants"
+	"darius/internal/handler"
+	"darius/internal/resilience"
+	"darius/internal/sandbox"
+	llm_grpc "darius/internal/services/llm-grpc"
+	jobManager "darius/managers/job"
+	arceus "darius/pkg/proto/deps/arceus"
+	"log"
 	"net/http"
+	"strings"
 	"time"
+
+	"github.com/spf13/viper"
+)
+
+var (
+	llmGuardConfig = resilience.Config{
+		MaxConcurrent:    16,
+		MaxWait:          2 * time.Second,
+		Timeout:          180 * time.Second,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+	bulbasaurGuardConfig = resilience.Config{
+		MaxConcurrent:    32,
+		MaxWait:          time.Second,
+		Timeout:          5 * time.Second,
+		FailureThreshold: 5,
+		OpenTimeout:      15 * time.Second,
+	}
+	missfortuneGuardConfig = resilience.Config{
+		MaxConcurrent:    16,
+		MaxWait:          time.Second,
+		Timeout:          30 * time.Second,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+	jobConfig = jobManager.Config{
+		Workers:      4,
+		MaxAttempts:  3,
+		PollInterval: 10 * time.Second,
+		StaleAfter:   10 * time.Minute,
+	}
+	handlerConfig = handler.Config{
+		ExamChunking: handler.ExamChunkingConfig{
+			Threshold: 20,
+			ChunkSize: 10,
+			Workers:   4,
+		},
+		DuplicateThreshold: 0.8,
+		AnswerKey: handler.AnswerKeyConfig{
+			Enabled:       true,
+			MinConfidence: 0.6,
+			Regenerate:    true,
+		},
+		TagCheck: handler.TagCheckConfig{
+			Enabled:      true,
+			MinutesRatio: 2,
+			Regenerate:   false,
+		},
+		QuestionBank: handler.QuestionBankConfig{
+			CandidatesPerCell: 50,
+			RecentExposure:    30 * 24 * time.Hour,
+		},
+		References: handler.ReferenceConfig{
+			ChunkWords:       200,
+			ChunkOverlap:     40,
+			PassagesPerTopic: 4,
+		},
+	}
+	sandboxConfig = sandbox.Config{
+		Timeout:           5 * time.Second,
+		CompileTimeout:    60 * time.Second,
+		CPUSeconds:        5,
+		CompileCPUSeconds: 60,
+		MemoryMB:          256,
+		CompileMemoryMB:   1024,
+		MaxProcesses:      64,
+		MaxOutputKB:       1024,
+		Languages:         sandbox.DefaultLanguages,
+	}
 )
 
+// initSandboxConfig reads the limits and languages of the coding question sandbox under
+// sandbox in the config. Configured languages are added to the built-in ones.
+func initSandboxConfig() sandbox.Config {
+	config := sandboxConfig
+	config.Languages = make(map[string]sandbox.Language, len(sandboxConfig.Languages))
+	for name, language := range sandboxConfig.Languages {
+		config.Languages[name] = language
+	}
+	if err := viper.UnmarshalKey("sandbox", &config); err != nil {
+		log.Printf("Failed to read sandbox config: %v", err)
+		config = sandboxConfig
+	}
+	log.Printf("Sandbox config: %+v", config)
+	return config
+}
+
+// initHandlerConfig reads the generation settings of the handlers under suggest in the config.
+func initHandlerConfig() handler.Config {
+	config := handlerConfig
+	if err := viper.UnmarshalKey("suggest", &config); err != nil {
+		log.Printf("Failed to read suggest config: %v", err)
+		config = handlerConfig
+	}
+	log.Printf("Suggest config: %+v", config)
+	return config
+}
+
+// initJobConfig reads the async job worker pool settings under jobs in the config.
+func initJobConfig() jobManager.Config {
+	config := jobConfig
+	if err := viper.UnmarshalKey("jobs", &config); err != nil {
+		log.Printf("Failed to read jobs config: %v", err)
+		config = jobConfig
+	}
+	logged := config
+	if logged.Webhook.SecretKey != "" {
+		logged.Webhook.SecretKey = "<redacted>"
+	}
+	log.Printf("Job config: %+v", logged)
+	return config
+}
+
+// initGuard builds the circuit breaker and bulkhead of one outbound dependency. The
+// defaults can be overridden under resilience.<name> in the config.
+func initGuard(name string, defaults resilience.Config) *resilience.Guard {
+	config := defaults
+	if err := viper.UnmarshalKey("resilience."+name, &config); err != nil {
+		log.Printf("Failed to read resilience config for %s: %v", name, err)
+		config = defaults
+	}
+	log.Printf("Resilience config for %s: %+v", name, config)
+	return resilience.NewGuard(name, config)
+}
+
 func initMissfortuneClient() *http.Client {
 	client := &http.Client{
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
+		Transport: resilience.Transport(initGuard("missfortune", missfortuneGuardConfig), nil),
+	}
+	return client
+}
+
+func initLLMHTTPClient(providerName string) *http.Client {
+	client := &http.Client{
+		Timeout:   180 * time.Second,
+		Transport: resilience.Transport(initGuard(providerName, llmGuardConfig), nil),
 	}
 	return client
 }
+
+// getConfigOrDefault reads a config key and falls back when it is unset or still an
+// unexpanded "$VAR" placeholder.
+func getConfigOrDefault(key, fallback string) string {
+	value := viper.GetString(key)
+	log.Printf("%s before hardcode: %s", key, value)
+	if value == "" || strings.HasPrefix(value, "$") {
+		return fallback
+	}
+	return value
+}
+
+// initLLMProviders builds every configured LLM backend. The one named by LLM_PROVIDER
+// (arceus, openai or ollama) comes first and serves as the default; the others are
+// available as failover targets in llm_routes.
+func initLLMProviders(arceusClient arceus.ArceusClient) []llm_grpc.Provider {
+	primary := getConfigOrDefault("LLM_PROVIDER", llm_grpc.ProviderArceus)
+
+	providers := map[string]llm_grpc.Provider{
+		llm_grpc.ProviderArceus: llm_grpc.NewArceusProvider(arceusClient),
+	}
+
+	if primary == llm_grpc.ProviderOpenAI || viper.GetString("OPENAI_API_KEY") != "" || viper.GetString("OPENAI_BASE_URL") != "" {
+		baseURL := getConfigOrDefault("OPENAI_BASE_URL", "https://api.openai.com/v1")
+		log.Printf("Using OpenAI-compatible LLM provider at %s", baseURL)
+		providers[llm_grpc.ProviderOpenAI] = llm_grpc.NewOpenAIProvider(baseURL, viper.GetString("OPENAI_API_KEY"), initLLMHTTPClient(llm_grpc.ProviderOpenAI))
+	}
+
+	if primary == llm_grpc.ProviderOllama || viper.GetString("OLLAMA_HOST") != "" {
+		host := getConfigOrDefault("OLLAMA_HOST", "http://localhost:11434")
+		log.Printf("Using Ollama LLM provider at %s", host)
+		providers[llm_grpc.ProviderOllama] = llm_grpc.NewOllamaProvider(host, initLLMHTTPClient(llm_grpc.ProviderOllama))
+	}
+
+	if _, ok := providers[primary]; !ok {
+		log.Printf("Unknown LLM provider %q, falling back to %s", primary, llm_grpc.ProviderArceus)
+		primary = llm_grpc.ProviderArceus
+	}
+
+	result := []llm_grpc.Provider{providers[primary]}
+	for name, provider := range providers {
+		if name != primary {
+			result = append(result, provider)
+		}
+	}
+	return result
+}
+
+// initLLMRoutes applies the per-feature overrides from the llm_routes section of the config,
+// e.g. llm_routes.f2_score.model or llm_routes.f1_suggest_exam.timeout.
+func initLLMRoutes() {
+	routes := map[string]constants.LLMRoute{}
+	if err := viper.UnmarshalKey("llm_routes", &routes); err != nil {
+		log.Printf("Failed to read llm_routes config: %v", err)
+		return
+	}
+
+	for feature, route := range routes {
+		log.Printf("Overriding LLM route for %s: %+v", feature, route)
+		constants.OverrideLLMRoute(feature, route)
+	}
+}
@@ -9,6 +9,9 @@ import (
 
 const HttpCodeHeader string = "X-Http-Code"
 
+// RoleReviewer is the x-user-role of users who review the questions of every author.
+const RoleReviewer = "reviewer"
+
 func GetUserIdFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -23,6 +26,26 @@ func GetUserIdFromContext(ctx context.Context) (string, error) {
 	return userID[0], nil
 }
 
+// GetUserRoleFromContext returns the x-user-role of the request, empty when there is none.
+func GetUserRoleFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	role := md.Get("x-user-role")
+	if len(role) == 0 {
+		return ""
+	}
+	return role[0]
+}
+
+// WithUserId returns a context carrying the user id the way an incoming request does, for
+// work that runs outside of the request that started it.
+func WithUserId(ctx context.Context, userId string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("x-user-id", userId))
+}
+
 func SetHeaders(ctx context.Context, key, value string) error {
 	md := metadata.Pairs(key, value)
 	return grpc.SetHeader(ctx, md)
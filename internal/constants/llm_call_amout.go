@@ -7,8 +7,17 @@ type LLMCallAmount struct {
 
 var AmountMap = map[string]LLMCallAmount{
 	F1_SUGGEST_EXAM:                {Amount: 5, Desc: "F1 Suggest Exam"},
+	F1_VERIFY_ANSWER_KEY:           {Amount: 0, Desc: "F1 Verify Answer Key"},
+	F1_CLASSIFY_TAGS:               {Amount: 0, Desc: "F1 Classify Tags"},
+	F1_REGENERATE_QUESTION:         {Amount: 1, Desc: "F1 Regenerate Question"},
+	F1_REWRITE_QUESTION:            {Amount: 1, Desc: "F1 Rewrite Question"},
+	F1_VARY_QUESTION:               {Amount: 2, Desc: "F1 Vary Question"},
+	F1_TRANSLATE_EXAM:              {Amount: 2, Desc: "F1 Translate Exam"},
+	F1_ASSEMBLE_EXAM:               {Amount: 5, Desc: "F1 Assemble Exam"},
 	F1_SUGGEST_QUESTIONS:           {Amount: 5, Desc: "F1 Suggest Questions"},
 	F1_SUGGEST_OUTLINES:            {Amount: 0, Desc: "F1 Suggest Outlines"},
+	F1_SUGGEST_CRITERIA:            {Amount: 1, Desc: "F1 Suggest Criteria"},
+	F1_SUGGEST_OPTIONS:             {Amount: 1, Desc: "F1 Suggest Options"},
 	F2_SCORE:                       {Amount: 0, Desc: "F2 Score"},
 	F3_SUGGEST_INTERVIEW_QUESTIONS: {Amount: 0, Desc: "F3 Suggest Interview Questions"},
 	F3_SCORE_INTERVIEW_QUESTIONS:   {Amount: 0, Desc: "F3 Score Interview Questions"},
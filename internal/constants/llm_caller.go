@@ -1,9 +1,18 @@
 package constants
 
 const (
+	F1_SUGGEST_CRITERIA            string = "f1_suggest_criteria"
+	F1_SUGGEST_OPTIONS             string = "f1_suggest_options"
 	F1_SUGGEST_OUTLINES            string = "f1_suggest_outlines"
 	F1_SUGGEST_QUESTIONS           string = "f1_suggest_questions"
 	F1_SUGGEST_EXAM                string = "f1_suggest_exam"
+	F1_VERIFY_ANSWER_KEY           string = "f1_verify_answer_key"
+	F1_CLASSIFY_TAGS               string = "f1_classify_tags"
+	F1_REGENERATE_QUESTION         string = "f1_regenerate_question"
+	F1_REWRITE_QUESTION            string = "f1_rewrite_question"
+	F1_VARY_QUESTION               string = "f1_vary_question"
+	F1_TRANSLATE_EXAM              string = "f1_translate_exam"
+	F1_ASSEMBLE_EXAM               string = "f1_assemble_exam"
 	F2_SCORE                       string = "f2_score"
 	F3_SUGGEST_INTERVIEW_QUESTIONS string = "f3_suggest_interview_questions"
 	F3_SCORE_INTERVIEW_QUESTIONS   string = "f3_score_interview_questions"
@@ -0,0 +1,92 @@
+package constants
+
+import "time"
+
+// LLMTarget is one provider/model pair of a failover chain. An empty provider means
+// the default provider, an empty model means the service default model.
+type LLMTarget struct {
+	Provider string `mapstructure:"provider"`
+	Model    string `mapstructure:"model"`
+}
+
+// LLMRoute holds the generation parameters used for one feature. Empty fields fall
+// back to the service defaults (provider, model) or the provider defaults (sampling).
+// Fallbacks are tried in order when the primary target times out or is unavailable.
+// MaxContinuations bounds how often a truncated answer is continued on its conversation.
+type LLMRoute struct {
+	Provider         string        `mapstructure:"provider"`
+	Model            string        `mapstructure:"model"`
+	Temperature      *float32      `mapstructure:"temperature"`
+	MaxTokens        *int32        `mapstructure:"max_tokens"`
+	Timeout          time.Duration `mapstructure:"timeout"`
+	Fallbacks        []LLMTarget   `mapstructure:"fallbacks"`
+	MaxContinuations *int          `mapstructure:"max_continuations"`
+}
+
+// Targets returns the failover chain of the route, primary target first.
+func (r LLMRoute) Targets() []LLMTarget {
+	return append([]LLMTarget{{Provider: r.Provider, Model: r.Model}}, r.Fallbacks...)
+}
+
+var RouteMap = map[string]LLMRoute{
+	F1_SUGGEST_EXAM:                {Temperature: float32Ptr(0.7), MaxTokens: int32Ptr(16000), Timeout: 180 * time.Second, MaxContinuations: intPtr(3)},
+	F1_VERIFY_ANSWER_KEY:           {Temperature: float32Ptr(0), MaxTokens: int32Ptr(4096), Timeout: 90 * time.Second, MaxContinuations: intPtr(1)},
+	F1_CLASSIFY_TAGS:               {Temperature: float32Ptr(0), MaxTokens: int32Ptr(4096), Timeout: 90 * time.Second, MaxContinuations: intPtr(1)},
+	F1_REGENERATE_QUESTION:         {Temperature: float32Ptr(0.8), MaxTokens: int32Ptr(4096), Timeout: 60 * time.Second, MaxContinuations: intPtr(1)},
+	F1_REWRITE_QUESTION:            {Temperature: float32Ptr(0.5), MaxTokens: int32Ptr(4096), Timeout: 60 * time.Second, MaxContinuations: intPtr(1)},
+	F1_VARY_QUESTION:               {Temperature: float32Ptr(0.7), MaxTokens: int32Ptr(8192), Timeout: 120 * time.Second, MaxContinuations: intPtr(2)},
+	F1_TRANSLATE_EXAM:              {Temperature: float32Ptr(0.2), MaxTokens: int32Ptr(16000), Timeout: 180 * time.Second, MaxContinuations: intPtr(3)},
+	F1_SUGGEST_QUESTIONS:           {Temperature: float32Ptr(0.7), MaxTokens: int32Ptr(16000), Timeout: 180 * time.Second, MaxContinuations: intPtr(3)},
+	F1_SUGGEST_OUTLINES:            {Temperature: float32Ptr(0.8), MaxTokens: int32Ptr(1024), Timeout: 60 * time.Second, MaxContinuations: intPtr(1)},
+	F1_SUGGEST_CRITERIA:            {Temperature: float32Ptr(0.7), MaxTokens: int32Ptr(2048), Timeout: 60 * time.Second, MaxContinuations: intPtr(1)},
+	F1_SUGGEST_OPTIONS:             {Temperature: float32Ptr(0.7), MaxTokens: int32Ptr(1024), Timeout: 60 * time.Second, MaxContinuations: intPtr(1)},
+	F2_SCORE:                       {Temperature: float32Ptr(0.2), MaxTokens: int32Ptr(2048), Timeout: 60 * time.Second, MaxContinuations: intPtr(1)},
+	F3_SUGGEST_INTERVIEW_QUESTIONS: {Temperature: float32Ptr(0.7), MaxTokens: int32Ptr(1024), Timeout: 60 * time.Second, MaxContinuations: intPtr(1)},
+	F3_SCORE_INTERVIEW_QUESTIONS:   {Temperature: float32Ptr(0.2), MaxTokens: int32Ptr(4096), Timeout: 90 * time.Second, MaxContinuations: intPtr(2)},
+}
+
+func GetLLMRoute(key string) LLMRoute {
+	if route, exists := RouteMap[key]; exists {
+		return route
+	}
+	return LLMRoute{}
+}
+
+// OverrideLLMRoute merges the non-empty fields of override into the route of the feature.
+func OverrideLLMRoute(key string, override LLMRoute) {
+	route := RouteMap[key]
+	if override.Provider != "" {
+		route.Provider = override.Provider
+	}
+	if override.Model != "" {
+		route.Model = override.Model
+	}
+	if override.Temperature != nil {
+		route.Temperature = override.Temperature
+	}
+	if override.MaxTokens != nil {
+		route.MaxTokens = override.MaxTokens
+	}
+	if override.Timeout > 0 {
+		route.Timeout = override.Timeout
+	}
+	if len(override.Fallbacks) > 0 {
+		route.Fallbacks = override.Fallbacks
+	}
+	if override.MaxContinuations != nil {
+		route.MaxContinuations = override.MaxContinuations
+	}
+	RouteMap[key] = route
+}
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func intPtr(v int) *int {
+	return &v
+}
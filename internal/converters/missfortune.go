@@ -17,8 +17,8 @@ func ConvertExamRequestToMissfortuneRequest(ctx context.Context, req *suggest.Su
 
 		Creativity: req.GetCreativity(),
 		Context: &missfortune.SuggestExamQuestionRequest_Context{
-			Text:  "a",
-			Links: []string{"a", "b"},
+			Text:  req.GetContext().GetText(),
+			Links: req.GetContext().GetLinks(),
 		},
 		QuestionType: "Multiple Choice",
 	}
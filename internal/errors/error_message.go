@@ -13,6 +13,7 @@ const (
 	ErrGeneral            = "An unexpected error occurred"
 	ErrNotEnoughCredits   = "Not enough credits to perform this operation"
 	ErrChargingFailed     = "Charging failed, please try again later"
+	ErrNotFound           = "The requested resource was not found"
 )
 
 func Error(err string) error {
@@ -35,6 +36,8 @@ func Error(err string) error {
 		return fmt.Errorf(ErrChargingFailed)
 	case ErrDataHasNotReady:
 		return fmt.Errorf(ErrDataHasNotReady)
+	case ErrNotFound:
+		return fmt.Errorf(ErrNotFound)
 	default:
 		return fmt.Errorf(ErrGeneral)
 	}
@@ -64,6 +67,8 @@ func GetHTTPStatusCode(err error) string {
 		return "503"
 	case ErrDataHasNotReady:
 		return "503"
+	case ErrNotFound:
+		return "404"
 	default:
 		return "500"
 	}
@@ -0,0 +1,45 @@
+package exporter
+
+import (
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"strings"
+)
+
+// maxAikenOptions is how many options the letters of the Aiken format can label.
+const maxAikenOptions = 26
+
+// writeAiken writes the MCQs and true/false questions in the Aiken format, one line per
+// question and option. Aiken can't give points, so the questions are worth the default of
+// the quiz they are imported into. Questions of the other types are left out.
+func writeAiken(title string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) ([]byte, []int32, error) {
+	var out strings.Builder
+	var skipped []int32
+	for _, question := range questions {
+		options, correct := choices(question)
+		isChoice := question.GetType() == validation.QuestionTypeMCQ || question.GetType() == validation.QuestionTypeTrueFalse
+		answer := -1
+		for i, isCorrect := range correct {
+			if isCorrect {
+				answer = i
+			}
+		}
+		if !isChoice || answer < 0 || len(options) > maxAikenOptions {
+			skipped = append(skipped, question.GetId())
+			continue
+		}
+
+		fmt.Fprintln(&out, oneLine(question.GetText()))
+		for i, option := range options {
+			fmt.Fprintf(&out, "%c. %s\n", 'A'+i, oneLine(option))
+		}
+		fmt.Fprintf(&out, "ANSWER: %c\n\n", 'A'+answer)
+	}
+	return []byte(out.String()), skipped, nil
+}
+
+// oneLine joins the lines of the text with spaces.
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,72 @@
+package exporter
+
+import (
+	"bytes"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"encoding/csv"
+	"strconv"
+	"strings"
+)
+
+// csvHeader are the columns of an exported CSV file. Lists, such as the options, have one
+// item per line of their cell.
+var csvHeader = []string{"id", "type", "text", "points", "options", "correct", "answer"}
+
+// writeCSV writes one row per question, for spreadsheets and LMS bulk uploads:
+//   - options lists the options of choice questions, the "left => right" pairs of matching
+//     questions and the items of ordering questions in their correct order
+//   - correct has the letters of the correct options, or TRUE or FALSE
+//   - answer has the accepted answers of a blank, the expected answer of a long answer or
+//     the reference solution of a coding question
+func writeCSV(title string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) ([]byte, []int32, error) {
+	var out bytes.Buffer
+	w := csv.NewWriter(&out)
+	if err := w.Write(csvHeader); err != nil {
+		return nil, nil, err
+	}
+	for _, question := range questions {
+		var options []string
+		var correct, answer string
+		detail := question.GetDetail()
+		switch question.GetType() {
+		case validation.QuestionTypeMCQ, validation.QuestionTypeMultiSelect:
+			var isCorrect []bool
+			options, isCorrect = choices(question)
+			var letters []string
+			for i, ok := range isCorrect {
+				if ok {
+					letters = append(letters, string(rune('A'+i)))
+				}
+			}
+			correct = strings.Join(letters, ",")
+		case validation.QuestionTypeTrueFalse:
+			correct = strings.ToUpper(strconv.FormatBool(detail.GetTrueFalse().GetCorrectAnswer()))
+		case validation.QuestionTypeFillInBlank:
+			answer = strings.Join(detail.GetFillInBlank().GetAcceptedAnswers(), "\n")
+		case validation.QuestionTypeMatching:
+			for _, pair := range detail.GetMatching().GetPairs() {
+				options = append(options, pair.GetLeft()+" => "+pair.GetRight())
+			}
+		case validation.QuestionTypeOrdering:
+			options = detail.GetOrdering().GetItems()
+		case validation.QuestionTypeLongAnswer, validation.QuestionTypeCode:
+			answer = modelAnswer(question)
+		}
+
+		err := w.Write([]string{
+			strconv.Itoa(int(question.GetId())),
+			question.GetType(),
+			question.GetText(),
+			strconv.Itoa(int(question.GetPoints())),
+			strings.Join(options, "\n"),
+			correct,
+			answer,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	w.Flush()
+	return out.Bytes(), nil, w.Error()
+}
@@ -0,0 +1,127 @@
+// Package exporter writes exams in the interchange formats learning management systems
+// such as Moodle and Canvas import, so trainers don't have to copy questions over by hand.
+// Every format keeps the points of a question when it has a way to, and questions of a
+// type a format can't express are left out and reported.
+package exporter
+
+import (
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	FormatQTI       = "qti" // IMS QTI 2.1 content package
+	FormatMoodleXML = "moodle_xml"
+	FormatGIFT      = "gift"
+	FormatAiken     = "aiken"
+	FormatCSV       = "csv"
+)
+
+var Formats = map[string]bool{
+	FormatQTI:       true,
+	FormatMoodleXML: true,
+	FormatGIFT:      true,
+	FormatAiken:     true,
+	FormatCSV:       true,
+}
+
+// File is an exported exam.
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+	Skipped     []int32 // ids of the questions the format can't express, left out of the file
+}
+
+// writer encodes the questions it can in its format and returns the ids of the others.
+type writer func(title string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) ([]byte, []int32, error)
+
+var writers = map[string]struct {
+	extension   string
+	contentType string
+	write       writer
+}{
+	FormatQTI:       {".zip", "application/zip", writeQTI},
+	FormatMoodleXML: {".xml", "application/xml", writeMoodleXML},
+	FormatGIFT:      {".gift.txt", "text/plain; charset=utf-8", writeGIFT},
+	FormatAiken:     {".aiken.txt", "text/plain; charset=utf-8", writeAiken},
+	FormatCSV:       {".csv", "text/csv; charset=utf-8", writeCSV},
+}
+
+// Export writes the questions of the exam in the format, under a file name made from the
+// title.
+func Export(format, title string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) (*File, error) {
+	w, ok := writers[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+	content, skipped, err := w.write(title, questions)
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		Name:        fileName(title) + w.extension,
+		ContentType: w.contentType,
+		Content:     content,
+		Skipped:     skipped,
+	}, nil
+}
+
+var unsafeFileName = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+// fileName turns the title into a file name without an extension, "exam" when it has
+// nothing usable.
+func fileName(title string) string {
+	name := strings.Trim(unsafeFileName.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if name == "" {
+		return "exam"
+	}
+	return name
+}
+
+// choices returns the options of an MCQ, MULTI_SELECT or TRUE_FALSE question and which of
+// them are correct. A true/false statement has the options "True" and "False".
+func choices(question *suggest.SuggestExamQuestionResponseV2_Quetion) ([]string, []bool) {
+	detail := question.GetDetail()
+	switch question.GetType() {
+	case validation.QuestionTypeMCQ:
+		correct := make([]bool, len(detail.GetOptions()))
+		if i := int(detail.GetCorrectOption()); i >= 0 && i < len(correct) {
+			correct[i] = true
+		}
+		return detail.GetOptions(), correct
+	case validation.QuestionTypeMultiSelect:
+		correct := make([]bool, len(detail.GetMultiSelect().GetOptions()))
+		for _, i := range detail.GetMultiSelect().GetCorrectOptions() {
+			if int(i) >= 0 && int(i) < len(correct) {
+				correct[i] = true
+			}
+		}
+		return detail.GetMultiSelect().GetOptions(), correct
+	case validation.QuestionTypeTrueFalse:
+		answer := detail.GetTrueFalse().GetCorrectAnswer()
+		return []string{"True", "False"}, []bool{answer, !answer}
+	}
+	return nil, nil
+}
+
+// explanation is the explanation of the i-th option, if the question has one.
+func explanation(question *suggest.SuggestExamQuestionResponseV2_Quetion, i int) string {
+	explanations := question.GetDetail().GetOptionExplanations()
+	if i < len(explanations) {
+		return explanations[i].GetExplanation()
+	}
+	return ""
+}
+
+// modelAnswer is what a grader compares an essay answer with: the expected answer of a
+// long answer question, or the reference solution of a coding question.
+func modelAnswer(question *suggest.SuggestExamQuestionResponseV2_Quetion) string {
+	if question.GetType() == validation.QuestionTypeCode {
+		return question.GetDetail().GetCode().GetReferenceSolution()
+	}
+	return question.GetDetail().GetCorrectAnswer()
+}
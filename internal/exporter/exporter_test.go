@@ -0,0 +1,400 @@
+package exporter
+
+import (
+	"archive/zip"
+	"bytes"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func exam() []*suggest.SuggestExamQuestionResponseV2_Quetion {
+	return []*suggest.SuggestExamQuestionResponseV2_Quetion{
+		{
+			Id:     1,
+			Text:   "What does `v := <-ch` do on a nil channel?",
+			Type:   validation.QuestionTypeMCQ,
+			Points: 2,
+			Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+				Type:          validation.QuestionTypeMCQ,
+				Options:       []string{"Blocks forever", "Panics", "Returns the zero value", "Fails to compile"},
+				CorrectOption: 0,
+				OptionExplanations: []*suggest.SuggestExamQuestionResponseV2_OptionExplanation{
+					{Explanation: "Receiving from a nil channel never proceeds."},
+					{Explanation: "Only closing a nil channel panics."},
+					{Explanation: "That is a closed channel."},
+					{Explanation: "It is valid Go."},
+				},
+			},
+		},
+		{
+			Id:     2,
+			Text:   "Explain when to use a mutex instead of a channel.\nGive an example.",
+			Type:   validation.QuestionTypeLongAnswer,
+			Points: 5,
+			Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+				Type:          validation.QuestionTypeLongAnswer,
+				CorrectAnswer: "A mutex guards shared state {e.g. a cache}; channels pass ownership.",
+			},
+		},
+		{
+			Id:     3,
+			Text:   "Which statements can block?",
+			Type:   validation.QuestionTypeMultiSelect,
+			Points: 3,
+			Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+				Type: validation.QuestionTypeMultiSelect,
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_MultiSelect{MultiSelect: &suggest.SuggestExamQuestionResponseV2_MultiSelectDetail{
+					Options:        []string{"ch <- v", "len(ch)", "wg.Wait()"},
+					CorrectOptions: []int32{0, 2},
+				}},
+			},
+		},
+		{
+			Id:     4,
+			Text:   "A closed channel can still be received from.",
+			Type:   validation.QuestionTypeTrueFalse,
+			Points: 1,
+			Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+				Type:   validation.QuestionTypeTrueFalse,
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_TrueFalse{TrueFalse: &suggest.SuggestExamQuestionResponseV2_TrueFalseDetail{CorrectAnswer: true}},
+			},
+		},
+		{
+			Id:     5,
+			Text:   "Order the steps of a graceful shutdown.",
+			Type:   validation.QuestionTypeOrdering,
+			Points: 2,
+			Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+				Type: validation.QuestionTypeOrdering,
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_Ordering{Ordering: &suggest.SuggestExamQuestionResponseV2_OrderingDetail{
+					Items: []string{"Stop accepting requests", "Drain in-flight requests", "Close the database"},
+				}},
+			},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	file, err := Export(FormatMoodleXML, "Go: Concurrency 101", exam())
+	assert.NoError(t, err)
+	assert.Equal(t, "go-concurrency-101.xml", file.Name)
+	assert.Equal(t, "application/xml", file.ContentType)
+	assert.Equal(t, []int32{5}, file.Skipped)
+
+	file, err = Export(FormatQTI, "", exam())
+	assert.NoError(t, err)
+	assert.Equal(t, "exam.zip", file.Name)
+	assert.Empty(t, file.Skipped)
+
+	_, err = Export("docx", "Go", exam())
+	assert.Error(t, err)
+}
+
+func TestExport_QTI(t *testing.T) {
+	file, err := Export(FormatQTI, "Go", exam())
+	assert.NoError(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(file.Content), int64(len(file.Content)))
+	assert.NoError(t, err)
+	read := func(name string, v interface{}) {
+		t.Helper()
+		f, err := archive.Open(name)
+		assert.NoError(t, err)
+		content, err := io.ReadAll(f)
+		assert.NoError(t, err)
+		assert.NoError(t, xml.Unmarshal(content, v))
+	}
+
+	var manifest qtiManifest
+	read("imsmanifest.xml", &manifest)
+	assert.Len(t, manifest.Resources, 6)
+	assert.Equal(t, qtiTestResource, manifest.Resources[0].Type)
+	assert.Len(t, manifest.Resources[0].Dependencies, 5)
+
+	var test qtiTest
+	read(qtiTestHref, &test)
+	assert.Equal(t, "Go", test.Title)
+	assert.Len(t, test.Part.Section.Items, 5)
+
+	items := make([]qtiItem, len(test.Part.Section.Items))
+	for i, ref := range test.Part.Section.Items {
+		read(ref.Href, &items[i])
+	}
+	maxScore := func(item qtiItem) string {
+		return item.Outcomes[1].Default.Values[0]
+	}
+
+	mcq := items[0]
+	assert.Equal(t, exam()[0].GetText(), mcq.Body.Choice.Prompt)
+	assert.Equal(t, 1, mcq.Body.Choice.MaxChoices)
+	assert.Len(t, mcq.Body.Choice.Choices, 4)
+	assert.Equal(t, "Returns the zero value", mcq.Body.Choice.Choices[2].Text)
+	assert.Equal(t, []string{"choice0"}, mcq.Response.Correct.Values)
+	assert.Equal(t, "2", maxScore(mcq))
+	assert.Contains(t, mcq.Processing.Rules, `<baseValue baseType="float">2</baseValue>`)
+
+	essay := items[1]
+	assert.Equal(t, exam()[1].GetText(), essay.Body.ExtendedText.Prompt)
+	assert.Equal(t, "scorer", essay.Body.Rubric.View)
+	assert.Equal(t, exam()[1].GetDetail().GetCorrectAnswer(), essay.Body.Rubric.Text)
+	assert.Equal(t, "5", maxScore(essay))
+	assert.Nil(t, essay.Processing)
+
+	multiSelect := items[2]
+	assert.Equal(t, "multiple", multiSelect.Response.Cardinality)
+	assert.Equal(t, []string{"choice0", "choice2"}, multiSelect.Response.Correct.Values)
+	assert.Equal(t, []qtiMapEntry{{Key: "choice0", Value: "1.5"}, {Key: "choice1", Value: "-3"}, {Key: "choice2", Value: "1.5"}}, multiSelect.Response.Mapping.Entries)
+	assert.Equal(t, "3", multiSelect.Response.Mapping.UpperBound)
+
+	assert.Equal(t, []string{"choice0"}, items[3].Response.Correct.Values)
+	assert.Equal(t, "ordered", items[4].Response.Cardinality)
+	assert.Equal(t, "Drain in-flight requests", items[4].Body.Order.Choices[1].Text)
+}
+
+// questionFromQTI reads an exported item back into the parts of a question that QTI
+// carries: the text, the points and the options with their answer key, or the model answer.
+func questionFromQTI(t *testing.T, item qtiItem) *suggest.SuggestExamQuestionResponseV2_Quetion {
+	t.Helper()
+	points, err := strconv.Atoi(item.Outcomes[1].Default.Values[0])
+	assert.NoError(t, err)
+	question := &suggest.SuggestExamQuestionResponseV2_Quetion{Points: int32(points), Detail: &suggest.SuggestExamQuestionResponseV2_Detail{}}
+	keyOf := func(identifier, prefix string) int32 {
+		i, err := strconv.Atoi(strings.TrimPrefix(identifier, prefix))
+		assert.NoError(t, err)
+		return int32(i)
+	}
+
+	body := item.Body
+	switch {
+	case body.Choice != nil:
+		question.Text = body.Choice.Prompt
+		var options []string
+		for _, choice := range body.Choice.Choices {
+			options = append(options, choice.Text)
+		}
+		var keys []int32
+		for _, value := range item.Response.Correct.Values {
+			keys = append(keys, keyOf(value, "choice"))
+		}
+		switch {
+		case item.Response.Cardinality == "multiple":
+			question.Type = validation.QuestionTypeMultiSelect
+			question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_MultiSelect{MultiSelect: &suggest.SuggestExamQuestionResponseV2_MultiSelectDetail{Options: options, CorrectOptions: keys}}
+		case strings.Join(options, ",") == "True,False":
+			question.Type = validation.QuestionTypeTrueFalse
+			question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_TrueFalse{TrueFalse: &suggest.SuggestExamQuestionResponseV2_TrueFalseDetail{CorrectAnswer: keys[0] == 0}}
+		default:
+			question.Type = validation.QuestionTypeMCQ
+			question.Detail.Options, question.Detail.CorrectOption = options, keys[0]
+		}
+	case body.Order != nil:
+		question.Type, question.Text = validation.QuestionTypeOrdering, body.Order.Prompt
+		items := make([]string, len(body.Order.Choices))
+		for _, value := range item.Response.Correct.Values {
+			for _, choice := range body.Order.Choices {
+				if choice.Identifier == value {
+					items[keyOf(value, "item")] = choice.Text
+				}
+			}
+		}
+		question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_Ordering{Ordering: &suggest.SuggestExamQuestionResponseV2_OrderingDetail{Items: items}}
+	case body.ExtendedText != nil:
+		question.Type, question.Text = validation.QuestionTypeLongAnswer, body.ExtendedText.Prompt
+		question.Detail.CorrectAnswer = body.Rubric.Text
+	default:
+		t.Fatalf("item %s has no interaction", item.Identifier)
+	}
+	question.Detail.Type = question.Type
+	return question
+}
+
+func TestExport_QTIRoundTrip(t *testing.T) {
+	file, err := Export(FormatQTI, "Go", exam())
+	assert.NoError(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(file.Content), int64(len(file.Content)))
+	assert.NoError(t, err)
+
+	for i, want := range exam() {
+		f, err := archive.Open(fmt.Sprintf("items/item%d.xml", i+1))
+		assert.NoError(t, err)
+		content, err := io.ReadAll(f)
+		assert.NoError(t, err)
+		var item qtiItem
+		assert.NoError(t, xml.Unmarshal(content, &item))
+
+		// Ids and option explanations don't survive the trip.
+		want.Id = 0
+		want.Detail.OptionExplanations = nil
+		got := questionFromQTI(t, item)
+		assert.True(t, proto.Equal(want, got), "question %d: want %v, got %v", i+1, want, got)
+	}
+}
+
+func TestExport_MoodleXML(t *testing.T) {
+	file, err := Export(FormatMoodleXML, "Go", exam())
+	assert.NoError(t, err)
+	var quiz moodleQuiz
+	assert.NoError(t, xml.Unmarshal(file.Content, &quiz))
+	assert.Len(t, quiz.Questions, 5)
+	assert.Equal(t, "$course$/top/Go", quiz.Questions[0].Category.Text)
+
+	mcq := quiz.Questions[1]
+	assert.Equal(t, "multichoice", mcq.Type)
+	assert.Equal(t, "true", mcq.Single)
+	assert.Equal(t, exam()[0].GetText(), mcq.QuestionText.Text)
+	assert.Equal(t, "2", mcq.DefaultGrade)
+	var fractions []string
+	for _, answer := range mcq.Answers {
+		fractions = append(fractions, answer.Fraction)
+	}
+	assert.Equal(t, []string{"100", "0", "0", "0"}, fractions)
+	assert.Equal(t, "Panics", mcq.Answers[1].Text)
+	assert.Equal(t, "Only closing a nil channel panics.", mcq.Answers[1].Feedback.Text)
+
+	essay := quiz.Questions[2]
+	assert.Equal(t, "essay", essay.Type)
+	assert.Equal(t, exam()[1].GetText(), essay.QuestionText.Text)
+	assert.Equal(t, "5", essay.DefaultGrade)
+	assert.Equal(t, exam()[1].GetDetail().GetCorrectAnswer(), essay.GraderInfo.Text)
+
+	multiSelect := quiz.Questions[3]
+	assert.Equal(t, "false", multiSelect.Single)
+	assert.Equal(t, "-100", multiSelect.Answers[1].Fraction)
+	assert.Equal(t, "50", multiSelect.Answers[2].Fraction)
+
+	trueFalse := quiz.Questions[4]
+	assert.Equal(t, "truefalse", trueFalse.Type)
+	assert.Equal(t, []moodleAnswer{{Fraction: "100", Text: "true"}, {Fraction: "0", Text: "false"}}, trueFalse.Answers)
+}
+
+// giftQuestion is an exported GIFT question, read back.
+type giftQuestion struct {
+	points int
+	name   string
+	text   string
+	answer string
+}
+
+var giftQuestionPattern = regexp.MustCompile(`(?s)^// points: (\d+)\n::(.*?)::\[markdown\](.*?) (\{.*\})$`)
+
+func readGIFT(t *testing.T, content string) []giftQuestion {
+	var questions []giftQuestion
+	for _, block := range strings.Split(strings.TrimSpace(content), "\n\n") {
+		if strings.HasPrefix(block, "$CATEGORY:") {
+			continue
+		}
+		match := giftQuestionPattern.FindStringSubmatch(block)
+		if !assert.NotNil(t, match, block) {
+			continue
+		}
+		points, _ := strconv.Atoi(match[1])
+		questions = append(questions, giftQuestion{points: points, name: match[2], text: match[3], answer: match[4]})
+	}
+	return questions
+}
+
+// giftSplit splits the text at the unescaped separators and unescapes the parts.
+func giftSplit(text string, separator rune) []string {
+	var parts []string
+	var part strings.Builder
+	escaped := false
+	for _, r := range text {
+		switch {
+		case escaped && r == 'n':
+			part.WriteRune('\n')
+		case escaped:
+			part.WriteRune(r)
+		case r == '\\':
+			escaped = true
+			continue
+		case r == separator:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteRune(r)
+		}
+		escaped = false
+	}
+	return append(parts, part.String())
+}
+
+func TestExport_GIFT(t *testing.T) {
+	file, err := Export(FormatGIFT, "Go", exam())
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{5}, file.Skipped)
+	assert.True(t, strings.HasPrefix(string(file.Content), "$CATEGORY: $course$/top/Go\n\n"))
+
+	questions := readGIFT(t, string(file.Content))
+	assert.Len(t, questions, 4)
+
+	mcq := questions[0]
+	assert.Equal(t, 2, mcq.points)
+	assert.Equal(t, "Q1", mcq.name)
+	assert.Equal(t, []string{exam()[0].GetText()}, giftSplit(mcq.text, 0))
+	lines := strings.Split(strings.Trim(mcq.answer, "{}\n"), "\n")
+	assert.Len(t, lines, 4)
+	assert.Equal(t, []string{"=Blocks forever", "Receiving from a nil channel never proceeds."}, giftSplit(strings.TrimSpace(lines[0]), '#'))
+	assert.Equal(t, []string{"~Panics", "Only closing a nil channel panics."}, giftSplit(strings.TrimSpace(lines[1]), '#'))
+
+	essay := questions[1]
+	assert.Equal(t, 5, essay.points)
+	assert.Equal(t, []string{exam()[1].GetText()}, giftSplit(essay.text, 0))
+	assert.True(t, strings.HasPrefix(essay.answer, "{####"))
+	assert.Equal(t, []string{exam()[1].GetDetail().GetCorrectAnswer()}, giftSplit(strings.TrimSuffix(strings.TrimPrefix(essay.answer, "{####"), "}"), 0))
+
+	assert.Contains(t, questions[2].answer, "~%50%wg.Wait()")
+	assert.Contains(t, questions[2].answer, "~%-100%len(ch)")
+	assert.Equal(t, "{TRUE}", questions[3].answer)
+}
+
+func TestExport_Aiken(t *testing.T) {
+	file, err := Export(FormatAiken, "Go", exam())
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{2, 3, 5}, file.Skipped)
+
+	blocks := strings.Split(strings.TrimSpace(string(file.Content)), "\n\n")
+	assert.Len(t, blocks, 2)
+	mcq := strings.Split(blocks[0], "\n")
+	assert.Equal(t, []string{
+		exam()[0].GetText(),
+		"A. Blocks forever",
+		"B. Panics",
+		"C. Returns the zero value",
+		"D. Fails to compile",
+		"ANSWER: A",
+	}, mcq)
+	assert.Equal(t, []string{exam()[3].GetText(), "A. True", "B. False", "ANSWER: A"}, strings.Split(blocks[1], "\n"))
+}
+
+func TestExport_CSV(t *testing.T) {
+	file, err := Export(FormatCSV, "Go", exam())
+	assert.NoError(t, err)
+	assert.Empty(t, file.Skipped)
+	rows, err := csv.NewReader(bytes.NewReader(file.Content)).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, rows, 6)
+	assert.Equal(t, csvHeader, rows[0])
+
+	mcq := rows[1]
+	assert.Equal(t, []string{"1", validation.QuestionTypeMCQ, exam()[0].GetText(), "2"}, mcq[:4])
+	assert.Equal(t, exam()[0].GetDetail().GetOptions(), strings.Split(mcq[4], "\n"))
+	assert.Equal(t, "A", mcq[5])
+
+	essay := rows[2]
+	assert.Equal(t, []string{"2", validation.QuestionTypeLongAnswer, exam()[1].GetText(), "5", "", ""}, essay[:6])
+	assert.Equal(t, exam()[1].GetDetail().GetCorrectAnswer(), essay[6])
+
+	assert.Equal(t, "A,C", rows[3][5])
+	assert.Equal(t, "TRUE", rows[4][5])
+	assert.Equal(t, exam()[4].GetDetail().GetOrdering().GetItems(), strings.Split(rows[5][4], "\n"))
+}
@@ -0,0 +1,116 @@
+package exporter
+
+import (
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// writeGIFT writes the questions in Moodle's GIFT format. GIFT has no notion of points, so
+// they go in a "// points:" comment above each question, which Moodle ignores. Ordering
+// questions are left out.
+func writeGIFT(title string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) ([]byte, []int32, error) {
+	var out strings.Builder
+	if title != "" {
+		fmt.Fprintf(&out, "$CATEGORY: $course$/top/%s\n\n", strings.Join(strings.Fields(title), " "))
+	}
+
+	var skipped []int32
+	for _, question := range questions {
+		answer, ok := giftAnswer(question)
+		if !ok {
+			skipped = append(skipped, question.GetId())
+			continue
+		}
+		fmt.Fprintf(&out, "// points: %d\n::Q%d::[markdown]%s %s\n\n", question.GetPoints(), question.GetId(), giftEscape(question.GetText()), answer)
+	}
+	return []byte(out.String()), skipped, nil
+}
+
+// giftAnswer is the answer block of the question, in braces.
+func giftAnswer(question *suggest.SuggestExamQuestionResponseV2_Quetion) (string, bool) {
+	detail := question.GetDetail()
+	switch question.GetType() {
+	case validation.QuestionTypeMCQ, validation.QuestionTypeMultiSelect:
+		options, correct := choices(question)
+		right := 0
+		for _, isCorrect := range correct {
+			if isCorrect {
+				right++
+			}
+		}
+		var block strings.Builder
+		block.WriteString("{\n")
+		for i, option := range options {
+			switch {
+			case question.GetType() == validation.QuestionTypeMCQ && correct[i]:
+				block.WriteString("\t=")
+			case question.GetType() == validation.QuestionTypeMCQ:
+				block.WriteString("\t~")
+			case correct[i]:
+				fmt.Fprintf(&block, "\t~%%%s%%", fraction(100, right))
+			default:
+				fmt.Fprintf(&block, "\t~%%-%s%%", fraction(100, len(options)-right))
+			}
+			block.WriteString(giftEscape(option))
+			if feedback := explanation(question, i); feedback != "" {
+				block.WriteString("#" + giftEscape(feedback))
+			}
+			block.WriteString("\n")
+		}
+		block.WriteString("}")
+		return block.String(), true
+	case validation.QuestionTypeTrueFalse:
+		return "{" + strings.ToUpper(strconv.FormatBool(detail.GetTrueFalse().GetCorrectAnswer())) + "}", true
+	case validation.QuestionTypeFillInBlank:
+		var answers []string
+		for _, answer := range detail.GetFillInBlank().GetAcceptedAnswers() {
+			answers = append(answers, "="+giftEscape(answer))
+		}
+		return "{" + strings.Join(answers, " ") + "}", true
+	case validation.QuestionTypeMatching:
+		var block strings.Builder
+		block.WriteString("{\n")
+		for _, pair := range detail.GetMatching().GetPairs() {
+			fmt.Fprintf(&block, "\t=%s -> %s\n", giftEscape(pair.GetLeft()), giftEscape(pair.GetRight()))
+		}
+		block.WriteString("}")
+		return block.String(), true
+	case validation.QuestionTypeLongAnswer, validation.QuestionTypeCode:
+		if answer := modelAnswer(question); answer != "" {
+			return "{####" + giftEscape(answer) + "}", true
+		}
+		return "{}", true
+	}
+	return "", false
+}
+
+// giftEscape escapes the characters GIFT gives a meaning to and keeps line breaks as \n,
+// since a blank line ends a question.
+func giftEscape(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		switch r {
+		case '\\', '~', '=', '#', '{', '}', ':':
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		case '\n':
+			escaped.WriteString(`\n`)
+		case '\r':
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+	return escaped.String()
+}
+
+// fraction is the share of 100 each of n answers gets, as Moodle writes grade fractions.
+func fraction(total, n int) string {
+	if n == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(math.Round(float64(total)/float64(n)*1e5)/1e5, 'f', -1, 64)
+}
@@ -0,0 +1,159 @@
+package exporter
+
+import (
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// The Moodle XML question format, as far as the exported question types need it.
+type moodleQuiz struct {
+	XMLName   xml.Name         `xml:"quiz"`
+	Questions []moodleQuestion `xml:"question"`
+}
+
+type moodleQuestion struct {
+	Type            string              `xml:"type,attr"`
+	Category        *moodleText         `xml:"category"`
+	Name            *moodleText         `xml:"name"`
+	QuestionText    *moodleText         `xml:"questiontext"`
+	DefaultGrade    string              `xml:"defaultgrade,omitempty"`
+	Single          string              `xml:"single,omitempty"`
+	ShuffleAnswers  string              `xml:"shuffleanswers,omitempty"`
+	AnswerNumbering string              `xml:"answernumbering,omitempty"`
+	UseCase         string              `xml:"usecase,omitempty"`
+	ResponseFormat  string              `xml:"responseformat,omitempty"`
+	GraderInfo      *moodleText         `xml:"graderinfo"`
+	Answers         []moodleAnswer      `xml:"answer"`
+	Subquestions    []moodleSubquestion `xml:"subquestion"`
+}
+
+type moodleText struct {
+	Format string `xml:"format,attr,omitempty"`
+	Text   string `xml:"text"`
+}
+
+type moodleAnswer struct {
+	Fraction string      `xml:"fraction,attr"`
+	Format   string      `xml:"format,attr,omitempty"`
+	Text     string      `xml:"text"`
+	Feedback *moodleText `xml:"feedback"`
+}
+
+type moodleSubquestion struct {
+	Format string     `xml:"format,attr,omitempty"`
+	Text   string     `xml:"text"`
+	Answer moodleText `xml:"answer"`
+}
+
+// moodleFormat is the text format of question texts and answers. Generated questions are
+// written in markdown, code included.
+const moodleFormat = "markdown"
+
+// writeMoodleXML writes the questions in the Moodle XML format, under a category named
+// after the exam. Long answer and coding questions become essays graded by hand against
+// the model answer, and ordering questions, which Moodle has no core type for, are left
+// out.
+func writeMoodleXML(title string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) ([]byte, []int32, error) {
+	quiz := moodleQuiz{}
+	if title != "" {
+		quiz.Questions = append(quiz.Questions, moodleQuestion{Type: "category", Category: &moodleText{Text: "$course$/top/" + title}})
+	}
+
+	var skipped []int32
+	for _, question := range questions {
+		converted, ok := moodleQuestionOf(question)
+		if !ok {
+			skipped = append(skipped, question.GetId())
+			continue
+		}
+		quiz.Questions = append(quiz.Questions, converted)
+	}
+
+	content, err := xml.MarshalIndent(quiz, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return append([]byte(xml.Header), append(content, '\n')...), skipped, nil
+}
+
+func moodleQuestionOf(question *suggest.SuggestExamQuestionResponseV2_Quetion) (moodleQuestion, bool) {
+	converted := moodleQuestion{
+		Name:         &moodleText{Text: fmt.Sprintf("Question %d", question.GetId())},
+		QuestionText: &moodleText{Format: moodleFormat, Text: question.GetText()},
+		DefaultGrade: strconv.Itoa(int(question.GetPoints())),
+	}
+
+	detail := question.GetDetail()
+	switch question.GetType() {
+	case validation.QuestionTypeMCQ, validation.QuestionTypeMultiSelect:
+		options, correct := choices(question)
+		right := 0
+		for _, isCorrect := range correct {
+			if isCorrect {
+				right++
+			}
+		}
+		converted.Type = "multichoice"
+		converted.Single = strconv.FormatBool(question.GetType() == validation.QuestionTypeMCQ)
+		converted.ShuffleAnswers = "true"
+		converted.AnswerNumbering = "abc"
+		for i, option := range options {
+			answer := moodleAnswer{Fraction: "0", Format: moodleFormat, Text: option}
+			switch {
+			case correct[i]:
+				answer.Fraction = fraction(100, right)
+			case question.GetType() == validation.QuestionTypeMultiSelect:
+				answer.Fraction = "-" + fraction(100, len(options)-right)
+			}
+			if feedback := explanation(question, i); feedback != "" {
+				answer.Feedback = &moodleText{Format: moodleFormat, Text: feedback}
+			}
+			converted.Answers = append(converted.Answers, answer)
+		}
+	case validation.QuestionTypeTrueFalse:
+		answer := detail.GetTrueFalse().GetCorrectAnswer()
+		fractions := map[bool]string{true: "100", false: "0"}
+		converted.Type = "truefalse"
+		converted.Answers = []moodleAnswer{
+			{Fraction: fractions[answer], Text: "true"},
+			{Fraction: fractions[!answer], Text: "false"},
+		}
+	case validation.QuestionTypeFillInBlank:
+		converted.Type = "shortanswer"
+		converted.UseCase = strconv.Itoa(btoi(detail.GetFillInBlank().GetCaseSensitive()))
+		for _, answer := range detail.GetFillInBlank().GetAcceptedAnswers() {
+			converted.Answers = append(converted.Answers, moodleAnswer{Fraction: "100", Text: answer})
+		}
+	case validation.QuestionTypeMatching:
+		converted.Type = "matching"
+		converted.ShuffleAnswers = "true"
+		for _, pair := range detail.GetMatching().GetPairs() {
+			converted.Subquestions = append(converted.Subquestions, moodleSubquestion{
+				Format: moodleFormat,
+				Text:   pair.GetLeft(),
+				Answer: moodleText{Text: pair.GetRight()},
+			})
+		}
+	case validation.QuestionTypeLongAnswer, validation.QuestionTypeCode:
+		converted.Type = "essay"
+		converted.ResponseFormat = "editor"
+		if question.GetType() == validation.QuestionTypeCode {
+			converted.ResponseFormat = "monospaced"
+		}
+		converted.GraderInfo = &moodleText{Format: moodleFormat, Text: modelAnswer(question)}
+	default:
+		return moodleQuestion{}, false
+	}
+	return converted, true
+}
+
+// btoi writes a flag the way Moodle does.
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,377 @@
+package exporter
+
+import (
+	"archive/zip"
+	"bytes"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+const (
+	qtiNamespace      = "http://www.imsglobal.org/xsd/imsqti_v2p1"
+	qtiMapResponse    = "http://www.imsglobal.org/question/qti_v2p1/rptemplates/map_response"
+	imsCPNamespace    = "http://www.imsglobal.org/xsd/imscp_v1p1"
+	qtiItemResource   = "imsqti_item_xmlv2p1"
+	qtiTestResource   = "imsqti_test_xmlv2p1"
+	qtiResponse       = "RESPONSE"
+	qtiScore          = "SCORE"
+	qtiMaxScore       = "MAXSCORE"
+	qtiTestIdentifier = "test"
+	qtiTestHref       = "test.xml"
+)
+
+// The parts of QTI 2.1 the exported question types need.
+type qtiItem struct {
+	XMLName       xml.Name                `xml:"assessmentItem"`
+	Namespace     string                  `xml:"xmlns,attr"`
+	Identifier    string                  `xml:"identifier,attr"`
+	Title         string                  `xml:"title,attr"`
+	Adaptive      bool                    `xml:"adaptive,attr"`
+	TimeDependent bool                    `xml:"timeDependent,attr"`
+	Response      qtiResponseDeclaration  `xml:"responseDeclaration"`
+	Outcomes      []qtiOutcomeDeclaration `xml:"outcomeDeclaration"`
+	Body          qtiItemBody             `xml:"itemBody"`
+	Processing    *qtiResponseProcessing  `xml:"responseProcessing"`
+}
+
+type qtiResponseDeclaration struct {
+	Identifier  string      `xml:"identifier,attr"`
+	Cardinality string      `xml:"cardinality,attr"`
+	BaseType    string      `xml:"baseType,attr"`
+	Correct     *qtiValues  `xml:"correctResponse"`
+	Mapping     *qtiMapping `xml:"mapping"`
+}
+
+type qtiValues struct {
+	Values []string `xml:"value"`
+}
+
+type qtiMapping struct {
+	LowerBound   string        `xml:"lowerBound,attr,omitempty"`
+	UpperBound   string        `xml:"upperBound,attr,omitempty"`
+	DefaultValue string        `xml:"defaultValue,attr"`
+	Entries      []qtiMapEntry `xml:"mapEntry"`
+}
+
+type qtiMapEntry struct {
+	Key           string `xml:"mapKey,attr"`
+	Value         string `xml:"mappedValue,attr"`
+	CaseSensitive string `xml:"caseSensitive,attr,omitempty"`
+}
+
+type qtiOutcomeDeclaration struct {
+	Identifier  string     `xml:"identifier,attr"`
+	Cardinality string     `xml:"cardinality,attr"`
+	BaseType    string     `xml:"baseType,attr"`
+	Default     *qtiValues `xml:"defaultValue"`
+}
+
+type qtiItemBody struct {
+	Paragraphs   []qtiParagraph              `xml:"p"`
+	Choice       *qtiChoiceInteraction       `xml:"choiceInteraction"`
+	ExtendedText *qtiExtendedTextInteraction `xml:"extendedTextInteraction"`
+	Match        *qtiMatchInteraction        `xml:"matchInteraction"`
+	Order        *qtiOrderInteraction        `xml:"orderInteraction"`
+	Rubric       *qtiRubricBlock             `xml:"rubricBlock"`
+}
+
+type qtiChoiceInteraction struct {
+	ResponseIdentifier string      `xml:"responseIdentifier,attr"`
+	Shuffle            bool        `xml:"shuffle,attr"`
+	MaxChoices         int         `xml:"maxChoices,attr"`
+	Prompt             string      `xml:"prompt"`
+	Choices            []qtiChoice `xml:"simpleChoice"`
+}
+
+type qtiChoice struct {
+	Identifier string `xml:"identifier,attr"`
+	Text       string `xml:",chardata"`
+}
+
+// qtiParagraph is a paragraph of text, or holding the inline interaction of a blank.
+type qtiParagraph struct {
+	Text      string                   `xml:",chardata"`
+	TextEntry *qtiTextEntryInteraction `xml:"textEntryInteraction"`
+}
+
+type qtiTextEntryInteraction struct {
+	ResponseIdentifier string `xml:"responseIdentifier,attr"`
+}
+
+type qtiExtendedTextInteraction struct {
+	ResponseIdentifier string `xml:"responseIdentifier,attr"`
+	Format             string `xml:"format,attr,omitempty"`
+	Prompt             string `xml:"prompt"`
+}
+
+type qtiMatchInteraction struct {
+	ResponseIdentifier string        `xml:"responseIdentifier,attr"`
+	Shuffle            bool          `xml:"shuffle,attr"`
+	MaxAssociations    int           `xml:"maxAssociations,attr"`
+	Prompt             string        `xml:"prompt"`
+	Sets               []qtiMatchSet `xml:"simpleMatchSet"`
+}
+
+type qtiMatchSet struct {
+	Choices []qtiAssociable `xml:"simpleAssociableChoice"`
+}
+
+type qtiAssociable struct {
+	Identifier string `xml:"identifier,attr"`
+	MatchMax   int    `xml:"matchMax,attr"`
+	Text       string `xml:",chardata"`
+}
+
+type qtiOrderInteraction struct {
+	ResponseIdentifier string      `xml:"responseIdentifier,attr"`
+	Shuffle            bool        `xml:"shuffle,attr"`
+	Prompt             string      `xml:"prompt"`
+	Choices            []qtiChoice `xml:"simpleChoice"`
+}
+
+type qtiRubricBlock struct {
+	View string `xml:"view,attr"`
+	Text string `xml:"p"`
+}
+
+type qtiResponseProcessing struct {
+	Template string `xml:"template,attr,omitempty"`
+	Rules    string `xml:",innerxml"`
+}
+
+// IMS content packaging of the items and the test that lists them.
+type qtiManifest struct {
+	XMLName       xml.Name      `xml:"manifest"`
+	Namespace     string        `xml:"xmlns,attr"`
+	Identifier    string        `xml:"identifier,attr"`
+	Schema        string        `xml:"metadata>schema"`
+	SchemaVersion string        `xml:"metadata>schemaversion"`
+	Organizations struct{}      `xml:"organizations"`
+	Resources     []qtiResource `xml:"resources>resource"`
+}
+
+type qtiResource struct {
+	Identifier   string          `xml:"identifier,attr"`
+	Type         string          `xml:"type,attr"`
+	Href         string          `xml:"href,attr"`
+	Files        []qtiHref       `xml:"file"`
+	Dependencies []qtiDependency `xml:"dependency"`
+}
+
+type qtiHref struct {
+	Href string `xml:"href,attr"`
+}
+
+type qtiDependency struct {
+	Ref string `xml:"identifierref,attr"`
+}
+
+type qtiTest struct {
+	XMLName    xml.Name `xml:"assessmentTest"`
+	Namespace  string   `xml:"xmlns,attr"`
+	Identifier string   `xml:"identifier,attr"`
+	Title      string   `xml:"title,attr"`
+	Part       struct {
+		Identifier     string `xml:"identifier,attr"`
+		NavigationMode string `xml:"navigationMode,attr"`
+		SubmissionMode string `xml:"submissionMode,attr"`
+		Section        struct {
+			Identifier string       `xml:"identifier,attr"`
+			Title      string       `xml:"title,attr"`
+			Visible    bool         `xml:"visible,attr"`
+			Items      []qtiItemRef `xml:"assessmentItemRef"`
+		} `xml:"assessmentSection"`
+	} `xml:"testPart"`
+}
+
+type qtiItemRef struct {
+	Identifier string `xml:"identifier,attr"`
+	Href       string `xml:"href,attr"`
+}
+
+// writeQTI writes an IMS QTI 2.1 content package: a zip with one assessment item per
+// question, an assessment test listing them in order and the manifest. Every item scores
+// its question's points, all or nothing for single answers and in proportion for multiple
+// selections, blanks and matches. Long answer and coding questions are scored by hand
+// against the model answer, shown to scorers only.
+func writeQTI(title string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) ([]byte, []int32, error) {
+	if title == "" {
+		title = "Exam"
+	}
+	manifest := qtiManifest{
+		Namespace:     imsCPNamespace,
+		Identifier:    "manifest",
+		Schema:        "QTIv2.1 Package",
+		SchemaVersion: "1.0.0",
+	}
+	testResource := qtiResource{Identifier: qtiTestIdentifier, Type: qtiTestResource, Href: qtiTestHref, Files: []qtiHref{{Href: qtiTestHref}}}
+	test := qtiTest{Namespace: qtiNamespace, Identifier: qtiTestIdentifier, Title: title}
+	test.Part.Identifier, test.Part.NavigationMode, test.Part.SubmissionMode = "part", "nonlinear", "simultaneous"
+	test.Part.Section.Identifier, test.Part.Section.Title, test.Part.Section.Visible = "section", title, true
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	var skipped []int32
+	for _, question := range questions {
+		identifier := fmt.Sprintf("item%d", len(test.Part.Section.Items)+1)
+		item, ok := qtiItemOf(identifier, question)
+		if !ok {
+			skipped = append(skipped, question.GetId())
+			continue
+		}
+		href := "items/" + identifier + ".xml"
+		if err := writeXMLFile(archive, href, item); err != nil {
+			return nil, nil, err
+		}
+		test.Part.Section.Items = append(test.Part.Section.Items, qtiItemRef{Identifier: identifier, Href: href})
+		testResource.Dependencies = append(testResource.Dependencies, qtiDependency{Ref: identifier})
+		manifest.Resources = append(manifest.Resources, qtiResource{Identifier: identifier, Type: qtiItemResource, Href: href, Files: []qtiHref{{Href: href}}})
+	}
+	manifest.Resources = append([]qtiResource{testResource}, manifest.Resources...)
+
+	if err := writeXMLFile(archive, qtiTestHref, test); err != nil {
+		return nil, nil, err
+	}
+	if err := writeXMLFile(archive, "imsmanifest.xml", manifest); err != nil {
+		return nil, nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), skipped, nil
+}
+
+func writeXMLFile(archive *zip.Writer, name string, v interface{}) error {
+	content, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append([]byte(xml.Header), content...))
+	return err
+}
+
+func qtiItemOf(identifier string, question *suggest.SuggestExamQuestionResponseV2_Quetion) (qtiItem, bool) {
+	points := strconv.Itoa(int(question.GetPoints()))
+	item := qtiItem{
+		Namespace:  qtiNamespace,
+		Identifier: identifier,
+		Title:      fmt.Sprintf("Question %d", question.GetId()),
+		Response:   qtiResponseDeclaration{Identifier: qtiResponse, Cardinality: "single", BaseType: "identifier"},
+		Outcomes: []qtiOutcomeDeclaration{
+			{Identifier: qtiScore, Cardinality: "single", BaseType: "float", Default: &qtiValues{Values: []string{"0"}}},
+			{Identifier: qtiMaxScore, Cardinality: "single", BaseType: "float", Default: &qtiValues{Values: []string{points}}},
+		},
+	}
+	// map_response scores the sum of the mapped values of the answer, within the bounds.
+	mapped := func(entries []qtiMapEntry) {
+		item.Response.Mapping = &qtiMapping{LowerBound: "0", UpperBound: points, DefaultValue: "0", Entries: entries}
+		item.Processing = &qtiResponseProcessing{Template: qtiMapResponse}
+	}
+
+	detail := question.GetDetail()
+	switch question.GetType() {
+	case validation.QuestionTypeMCQ, validation.QuestionTypeTrueFalse, validation.QuestionTypeMultiSelect:
+		options, correct := choices(question)
+		interaction := &qtiChoiceInteraction{ResponseIdentifier: qtiResponse, MaxChoices: 1, Prompt: question.GetText()}
+		var correctIds []string
+		for i, option := range options {
+			id := fmt.Sprintf("choice%d", i)
+			interaction.Choices = append(interaction.Choices, qtiChoice{Identifier: id, Text: option})
+			if correct[i] {
+				correctIds = append(correctIds, id)
+			}
+		}
+		item.Response.Correct = &qtiValues{Values: correctIds}
+		if question.GetType() == validation.QuestionTypeMultiSelect {
+			interaction.MaxChoices = 0
+			item.Response.Cardinality = "multiple"
+			var entries []qtiMapEntry
+			for i, choice := range interaction.Choices {
+				value := pointShare(question.GetPoints(), len(correctIds))
+				if !correct[i] {
+					value = "-" + pointShare(question.GetPoints(), len(options)-len(correctIds))
+				}
+				entries = append(entries, qtiMapEntry{Key: choice.Identifier, Value: value})
+			}
+			mapped(entries)
+		} else {
+			item.Processing = qtiAllOrNothing(points)
+		}
+		item.Body.Choice = interaction
+	case validation.QuestionTypeFillInBlank:
+		caseSensitive := strconv.FormatBool(detail.GetFillInBlank().GetCaseSensitive())
+		item.Response.BaseType = "string"
+		var entries []qtiMapEntry
+		for _, answer := range detail.GetFillInBlank().GetAcceptedAnswers() {
+			entries = append(entries, qtiMapEntry{Key: answer, Value: points, CaseSensitive: caseSensitive})
+		}
+		if len(entries) > 0 {
+			item.Response.Correct = &qtiValues{Values: []string{entries[0].Key}}
+		}
+		mapped(entries)
+		item.Body.Paragraphs = []qtiParagraph{
+			{Text: question.GetText()},
+			{TextEntry: &qtiTextEntryInteraction{ResponseIdentifier: qtiResponse}},
+		}
+	case validation.QuestionTypeMatching:
+		pairs := detail.GetMatching().GetPairs()
+		interaction := &qtiMatchInteraction{ResponseIdentifier: qtiResponse, Shuffle: true, MaxAssociations: len(pairs), Prompt: question.GetText()}
+		interaction.Sets = make([]qtiMatchSet, 2)
+		item.Response.Cardinality, item.Response.BaseType = "multiple", "directedPair"
+		item.Response.Correct = &qtiValues{}
+		var entries []qtiMapEntry
+		for i, pair := range pairs {
+			left, right := fmt.Sprintf("left%d", i), fmt.Sprintf("right%d", i)
+			interaction.Sets[0].Choices = append(interaction.Sets[0].Choices, qtiAssociable{Identifier: left, MatchMax: 1, Text: pair.GetLeft()})
+			interaction.Sets[1].Choices = append(interaction.Sets[1].Choices, qtiAssociable{Identifier: right, MatchMax: 1, Text: pair.GetRight()})
+			item.Response.Correct.Values = append(item.Response.Correct.Values, left+" "+right)
+			entries = append(entries, qtiMapEntry{Key: left + " " + right, Value: pointShare(question.GetPoints(), len(pairs))})
+		}
+		mapped(entries)
+		item.Body.Match = interaction
+	case validation.QuestionTypeOrdering:
+		interaction := &qtiOrderInteraction{ResponseIdentifier: qtiResponse, Shuffle: true, Prompt: question.GetText()}
+		item.Response.Cardinality = "ordered"
+		item.Response.Correct = &qtiValues{}
+		for i, text := range detail.GetOrdering().GetItems() {
+			id := fmt.Sprintf("item%d", i)
+			interaction.Choices = append(interaction.Choices, qtiChoice{Identifier: id, Text: text})
+			item.Response.Correct.Values = append(item.Response.Correct.Values, id)
+		}
+		item.Processing = qtiAllOrNothing(points)
+		item.Body.Order = interaction
+	case validation.QuestionTypeLongAnswer, validation.QuestionTypeCode:
+		item.Response.BaseType = "string"
+		interaction := &qtiExtendedTextInteraction{ResponseIdentifier: qtiResponse, Prompt: question.GetText()}
+		if question.GetType() == validation.QuestionTypeCode {
+			interaction.Format = "preFormatted"
+		}
+		item.Body.ExtendedText = interaction
+		if answer := modelAnswer(question); answer != "" {
+			item.Body.Rubric = &qtiRubricBlock{View: "scorer", Text: answer}
+		}
+	default:
+		return qtiItem{}, false
+	}
+	return item, true
+}
+
+// qtiAllOrNothing scores the points when the response is the correct one, and nothing
+// otherwise.
+func qtiAllOrNothing(points string) *qtiResponseProcessing {
+	return &qtiResponseProcessing{Rules: `<responseCondition><responseIf><match><variable identifier="` + qtiResponse +
+		`"/><correct identifier="` + qtiResponse + `"/></match><setOutcomeValue identifier="` + qtiScore +
+		`"><baseValue baseType="float">` + points + `</baseValue></setOutcomeValue></responseIf></responseCondition>`}
+}
+
+// pointShare is the share of the points each of n answers is worth.
+func pointShare(points int32, n int) string {
+	return fraction(int(points), n)
+}
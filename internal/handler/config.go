@@ -0,0 +1,54 @@
+package handler
+
+import "time"
+
+// Config tunes how the handlers drive the LLM.
+type Config struct {
+	ExamChunking ExamChunkingConfig `mapstructure:"exam_chunking"`
+	// DuplicateThreshold is the TF-IDF cosine similarity at which two generated questions or
+	// outlines count as the same one. 0 turns the check off.
+	DuplicateThreshold float64            `mapstructure:"duplicate_threshold"`
+	AnswerKey          AnswerKeyConfig    `mapstructure:"answer_key"`
+	TagCheck           TagCheckConfig     `mapstructure:"tag_check"`
+	QuestionBank       QuestionBankConfig `mapstructure:"question_bank"`
+	References         ReferenceConfig    `mapstructure:"references"`
+}
+
+// ReferenceConfig controls how uploaded documents are split into passages, and how many
+// of them are retrieved to ground each topic of an exam.
+type ReferenceConfig struct {
+	ChunkWords       int `mapstructure:"chunk_words"`        // most words in one passage
+	ChunkOverlap     int `mapstructure:"chunk_overlap"`      // words consecutive passages of a section share
+	PassagesPerTopic int `mapstructure:"passages_per_topic"` // passages shown to the model for every topic
+}
+
+// QuestionBankConfig controls how AssembleExam draws questions from the question bank.
+type QuestionBankConfig struct {
+	CandidatesPerCell int           `mapstructure:"candidates_per_cell"` // approved questions weighed per topic and level
+	RecentExposure    time.Duration `mapstructure:"recent_exposure"`     // a candidate pool doesn't see a question again within this long
+}
+
+// AnswerKeyConfig controls the blind solving pass that double-checks the correctOption of
+// generated MCQs. The solver runs on the f1_verify_answer_key LLM route, so it can be
+// pointed at a different model than the one writing the questions.
+type AnswerKeyConfig struct {
+	Enabled       bool    `mapstructure:"enabled"`
+	MinConfidence float32 `mapstructure:"min_confidence"` // keys less likely than this to be right are flagged
+	Regenerate    bool    `mapstructure:"regenerate"`     // rewrite flagged questions instead of only reporting them
+}
+
+// TagCheckConfig controls the classification pass that double-checks the Bloom level and
+// solve time generated questions are tagged with. It runs on the f1_classify_tags LLM route.
+type TagCheckConfig struct {
+	Enabled      bool    `mapstructure:"enabled"`
+	MinutesRatio float32 `mapstructure:"minutes_ratio"` // solve times off by more than this factor either way are flagged, 0 only checks levels
+	Regenerate   bool    `mapstructure:"regenerate"`    // rewrite flagged questions instead of only reporting them
+}
+
+// ExamChunkingConfig controls how large exams are split into per-topic, per-level chunks
+// that are generated concurrently. A Threshold of 0 turns chunking off.
+type ExamChunkingConfig struct {
+	Threshold int `mapstructure:"threshold"`  // exams with more questions than this are chunked
+	ChunkSize int `mapstructure:"chunk_size"` // most questions asked for in one prompt
+	Workers   int `mapstructure:"workers"`    // chunks generated at the same time
+}
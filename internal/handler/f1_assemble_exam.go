@@ -0,0 +1,459 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/errors"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	sourceBank      = "bank"
+	sourceGenerated = "generated"
+
+	// overtimePenalty is what a minute over the time budget costs while picking bank
+	// questions, in points off the points target.
+	overtimePenalty = 100
+	maxSwapRounds   = 20
+)
+
+// typeMinutes is about how long a candidate takes to answer a question of each type.
+var typeMinutes = map[string]float64{
+	validation.QuestionTypeMCQ:         1.5,
+	validation.QuestionTypeTrueFalse:   0.5,
+	validation.QuestionTypeMultiSelect: 2,
+	validation.QuestionTypeFillInBlank: 1,
+	validation.QuestionTypeMatching:    2,
+	validation.QuestionTypeOrdering:    2,
+	validation.QuestionTypeLongAnswer:  8,
+	validation.QuestionTypeCode:        20,
+}
+
+func questionMinutes(questionType string) float64 {
+	if minutes, ok := typeMinutes[questionType]; ok {
+		return minutes
+	}
+	return 2
+}
+
+// solveMinutes is the solve time the question was tagged with, or the usual time of its
+// type for questions without one.
+func solveMinutes(question *suggest.SuggestExamQuestionResponseV2_Quetion) float64 {
+	if minutes := question.GetEstimatedMinutes(); minutes > 0 {
+		return float64(minutes)
+	}
+	return questionMinutes(question.GetType())
+}
+
+// assemblyCell is one topic and level of the blueprint, with the bank questions that could
+// fill it and the ones picked.
+type assemblyCell struct {
+	topic      string
+	level      int // index into examLevels
+	count      int
+	candidates []*suggest.BankQuestion
+	picked     []int // indexes into candidates
+	chunks     []*examChunk
+}
+
+// assemblyTarget is what the picked bank questions are weighed against.
+type assemblyTarget struct {
+	questionCount int
+	totalPoints   int
+	minutes       float64
+	gapMinutes    float64        // expected time of a generated question
+	typeCounts    map[string]int // most questions per type, when the exam has a type ratio
+}
+
+// AssembleExam builds the exam a SuggestExamQuestionRequest blueprint describes out of
+// approved bank questions, and generates only the questions the bank can't provide. Bank
+// questions are picked to meet the points target and time budget, and questions the
+// candidate pool saw recently are left out.
+func (h *handler) AssembleExam(ctx context.Context, req *suggest.AssembleExamRequest) (*suggest.AssembleExamResponse, error) {
+	if req.GetBlueprint() == nil || req.GetTotalPoints() < 0 || req.GetMinutesToAnswer() < 0 {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("blueprint is missing or a target is negative"), errors.ErrInvalidInput)
+	}
+	blueprint := proto.Clone(req.GetBlueprint()).(*suggest.SuggestExamQuestionRequest)
+	if blueprint.GetQuestionType() == "" {
+		blueprint.QuestionType = validation.QuestionTypeMixed
+	}
+	if err := validation.CheckQuestionType(blueprint.GetQuestionType(), blueprint.GetTypeRatio()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	spec := validation.ExamSpecFromRequest(blueprint)
+	if spec.QuestionCount == 0 {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("blueprint asks for no question"), errors.ErrInvalidInput)
+	}
+	if err := validation.CheckBloomDistribution(blueprint.GetBloomDistribution(), spec.QuestionCount); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	if err := h.checkDocuments(ctx, blueprint.GetDocumentIds()); err != nil {
+		return nil, err
+	}
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+
+	cells, err := h.assemblyCells(ctx, blueprint, spec, tags, req.GetCandidatePool())
+	if err != nil {
+		log.Printf("[AssembleExam] error searching the question bank: %v", err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrDatabaseConnection)
+	}
+	pickBankQuestions(cells, assemblyTarget{
+		questionCount: spec.QuestionCount,
+		totalPoints:   int(req.GetTotalPoints()),
+		minutes:       float64(req.GetMinutesToAnswer()),
+		gapMinutes:    gapMinutes(spec),
+		typeCounts:    spec.TypeCounts,
+	})
+
+	var bankTexts []string
+	var bankIds []uint
+	bankPoints, gapCount := 0, 0
+	bankTypes, bankLevels := make(map[string]int), make(map[string]int)
+	for _, cell := range cells {
+		for _, i := range cell.picked {
+			question := cell.candidates[i].GetQuestion()
+			bankTexts = append(bankTexts, question.GetText())
+			bankIds = append(bankIds, uint(cell.candidates[i].GetId()))
+			bankPoints += int(question.GetPoints())
+			bankTypes[question.GetType()]++
+			bankLevels[question.GetBloomLevel()]++
+		}
+		gapCount += cell.count - len(cell.picked)
+	}
+	log.Printf("[AssembleExam] %d of %d questions come from the bank", len(bankIds), spec.QuestionCount)
+
+	var generated []*suggest.SuggestExamQuestionResponseV2_Quetion
+	if gapCount > 0 {
+		chargeCode, err := h.checkCanCall(ctx, constants.F1_ASSEMBLE_EXAM)
+		if err != nil {
+			return nil, err
+		}
+		generated, err = h.generateGaps(ctx, blueprint, cells, spec, bankTypes, bankLevels, bankTexts)
+		if err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNetworkConnection)
+		}
+		if req.GetTotalPoints() > 0 {
+			spreadPoints(generated, int(req.GetTotalPoints())-bankPoints)
+		}
+		if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+			log.Printf("[AssembleExam] Charge Code %s failed to charge for LLM call", chargeCode)
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+		}
+	}
+
+	// Bank and generated questions of a topic and level stay together, in blueprint order.
+	resp := &suggest.AssembleExamResponse{RequestKey: blueprint.GetRequestKey()}
+	add := func(question *suggest.SuggestExamQuestionResponseV2_Quetion, item *suggest.AssembledItem) {
+		question.Id = int32(len(resp.Questions) + 1)
+		item.QuestionId = question.GetId()
+		item.Minutes = float32(solveMinutes(question))
+		resp.Questions = append(resp.Questions, question)
+		resp.Items = append(resp.Items, item)
+		resp.TotalPoints += question.GetPoints()
+		resp.EstimatedMinutes += item.Minutes
+	}
+	for _, cell := range cells {
+		level := examLevels[cell.level].name
+		for _, i := range cell.picked {
+			question := proto.Clone(cell.candidates[i].GetQuestion()).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+			question.TestId = ""
+			question.Topic, question.Level = cell.topic, level
+			add(question, &suggest.AssembledItem{Source: sourceBank, BankQuestionId: cell.candidates[i].GetId(), Topic: cell.topic, Level: level})
+		}
+		for _, chunk := range cell.chunks {
+			for _, question := range chunk.questions {
+				add(question, &suggest.AssembledItem{Source: sourceGenerated, Topic: cell.topic, Level: level})
+			}
+		}
+	}
+
+	spec.Banked = make(map[int32]bool, len(bankIds))
+	for _, item := range resp.Items {
+		if item.GetSource() == sourceBank {
+			spec.Banked[item.GetQuestionId()] = true
+		}
+	}
+	violations := validation.ValidateExam(resp.Questions, spec)
+	violations = append(violations, h.repeatedQuestions(generated, bankTexts)...)
+	if req.GetTotalPoints() > 0 && resp.TotalPoints != req.GetTotalPoints() {
+		violations = append(violations, validation.Violation{
+			Field:   "points",
+			Code:    validation.CodePointsTotal,
+			Message: fmt.Sprintf("questions add up to %d points, expected %d", resp.TotalPoints, req.GetTotalPoints()),
+		})
+	}
+	if req.GetMinutesToAnswer() > 0 && resp.EstimatedMinutes > float32(req.GetMinutesToAnswer()) {
+		violations = append(violations, validation.Violation{
+			Field:   "minutesToAnswer",
+			Code:    validation.CodeTimeBudget,
+			Message: fmt.Sprintf("questions take about %.0f minutes, the budget is %d", resp.EstimatedMinutes, req.GetMinutesToAnswer()),
+		})
+	}
+	resp.Violations = toProtoViolations(violations)
+
+	if pool := req.GetCandidatePool(); pool != "" {
+		if err := h.questionBank.RecordExposures(ctx, pool, bankIds); err != nil {
+			log.Printf("[AssembleExam] error recording exposures of %s: %v", pool, err)
+		}
+	}
+	return resp, nil
+}
+
+// assemblyCells looks up the approved bank questions that could fill each topic and level
+// of the blueprint.
+func (h *handler) assemblyCells(ctx context.Context, blueprint *suggest.SuggestExamQuestionRequest, spec validation.ExamSpec, tags []string, candidatePool string) ([]*assemblyCell, error) {
+	questionType := ""
+	if spec.QuestionType != validation.QuestionTypeMixed {
+		questionType = spec.QuestionType
+	}
+	limit := h.config.QuestionBank.CandidatesPerCell
+	if limit < 1 {
+		limit = maxQuestionPageSize
+	}
+
+	var cells []*assemblyCell
+	for _, topic := range blueprint.GetTopics() {
+		for level := range examLevels {
+			count := int(examLevels[level].get(topic.GetDifficultyDistribution()))
+			if count <= 0 {
+				continue
+			}
+			rows, _, err := h.questionBank.SearchQuestions(ctx, models.QuestionFilter{
+				Topic:     topic.GetName(),
+				Level:     examLevels[level].name,
+				Type:      questionType,
+				Language:  blueprint.GetLanguage(),
+				Status:    models.QuestionStatusApproved,
+				Tags:      tags,
+				UnseenBy:  candidatePool,
+				SeenSince: time.Now().Add(-h.config.QuestionBank.RecentExposure),
+				Limit:     limit,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			cell := &assemblyCell{topic: topic.GetName(), level: level, count: count}
+			for _, row := range rows {
+				if !spec.Allows(row.Type) {
+					continue
+				}
+				if candidate, err := toBankQuestion(row); err == nil {
+					cell.candidates = append(cell.candidates, candidate)
+				}
+			}
+			cells = append(cells, cell)
+		}
+	}
+	return cells, nil
+}
+
+// pickBankQuestions fills every cell with as many of its candidates as it takes, then swaps
+// picks for other candidates of the same cell for as long as that brings the exam closer to
+// its points target and time budget. A question is picked once at most, and never beyond
+// the type counts of the exam.
+func pickBankQuestions(cells []*assemblyCell, target assemblyTarget) {
+	used := make(map[uint64]bool)
+	types := make(map[string]int)
+	fits := func(questionType string) bool {
+		return len(target.typeCounts) == 0 || types[questionType] < target.typeCounts[questionType]
+	}
+
+	for _, cell := range cells {
+		for i, candidate := range cell.candidates {
+			if len(cell.picked) == cell.count {
+				break
+			}
+			questionType := candidate.GetQuestion().GetType()
+			if used[candidate.GetId()] || !fits(questionType) {
+				continue
+			}
+			cell.picked = append(cell.picked, i)
+			used[candidate.GetId()] = true
+			types[questionType]++
+		}
+	}
+
+	cost := assemblyCost(cells, target)
+	for round := 0; round < maxSwapRounds; round++ {
+		improved := false
+		for _, cell := range cells {
+			for slot, current := range cell.picked {
+				for i, candidate := range cell.candidates {
+					currentType, questionType := cell.candidates[current].GetQuestion().GetType(), candidate.GetQuestion().GetType()
+					if used[candidate.GetId()] || (questionType != currentType && !fits(questionType)) {
+						continue
+					}
+					cell.picked[slot] = i
+					swapped := assemblyCost(cells, target)
+					if swapped >= cost {
+						cell.picked[slot] = current
+						continue
+					}
+					used[cell.candidates[current].GetId()], used[candidate.GetId()] = false, true
+					types[currentType]--
+					types[questionType]++
+					cost, current, improved = swapped, i, true
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+}
+
+// assemblyCost weighs the picked bank questions: how far their points are from their share
+// of the points target, and how far the exam would run over the time budget once the gaps
+// are generated.
+func assemblyCost(cells []*assemblyCell, target assemblyTarget) float64 {
+	count, points, minutes := 0, 0, 0.0
+	for _, cell := range cells {
+		for _, i := range cell.picked {
+			question := cell.candidates[i].GetQuestion()
+			count++
+			points += int(question.GetPoints())
+			minutes += solveMinutes(question)
+		}
+	}
+
+	cost := 0.0
+	if target.totalPoints > 0 && target.questionCount > 0 {
+		share := float64(target.totalPoints) * float64(count) / float64(target.questionCount)
+		cost += math.Abs(float64(points) - share)
+	}
+	if target.minutes > 0 {
+		expected := minutes + float64(target.questionCount-count)*target.gapMinutes
+		cost += overtimePenalty * math.Max(0, expected-target.minutes)
+	}
+	return cost
+}
+
+// gapMinutes is the expected time of a generated question of the exam.
+func gapMinutes(spec validation.ExamSpec) float64 {
+	if len(spec.TypeCounts) > 0 {
+		total, count := 0.0, 0
+		for questionType, n := range spec.TypeCounts {
+			total += questionMinutes(questionType) * float64(n)
+			count += n
+		}
+		return total / float64(count)
+	}
+	types := spec.AllowedTypes()
+	total := 0.0
+	for _, questionType := range types {
+		total += questionMinutes(questionType)
+	}
+	return total / float64(len(types))
+}
+
+// generateGaps generates the questions the bank couldn't provide, as one chunk per topic
+// and level, and hands them to their cells. A type ratio and Bloom distribution are kept
+// over the whole exam, so the gaps get whatever the bank questions left of each.
+func (h *handler) generateGaps(ctx context.Context, blueprint *suggest.SuggestExamQuestionRequest, cells []*assemblyCell, spec validation.ExamSpec, bankTypes, bankLevels map[string]int, bankTexts []string) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	var opts []llmManager.GenerateOption
+	if temperature, ok := creativityToTemperature(blueprint.GetCreativity()); ok {
+		opts = append(opts, llmManager.WithTemperature(temperature))
+	}
+
+	var chunks []*examChunk
+	for _, cell := range cells {
+		for remaining := cell.count - len(cell.picked); remaining > 0; {
+			count := remaining
+			if chunkSize := h.config.ExamChunking.ChunkSize; chunkSize > 0 && count > chunkSize {
+				count = chunkSize
+			}
+			chunk := &examChunk{index: len(chunks), topic: cell.topic, level: cell.level, count: count}
+			cell.chunks = append(cell.chunks, chunk)
+			chunks = append(chunks, chunk)
+			remaining -= count
+		}
+	}
+
+	gapSpec := spec
+	gapSpec.QuestionCount = 0
+	if len(spec.TypeCounts) > 0 {
+		gapSpec.TypeCounts = make(map[string]int, len(spec.TypeCounts))
+		for questionType, n := range spec.TypeCounts {
+			if left := n - bankTypes[questionType]; left > 0 {
+				gapSpec.TypeCounts[questionType] = left
+			}
+		}
+		assignChunkTypes(chunks, gapSpec.TypeCounts)
+	}
+	if len(spec.BloomCounts) > 0 {
+		gapSpec.BloomCounts = make(map[string]int, len(spec.BloomCounts))
+		for level, n := range spec.BloomCounts {
+			if left := n - bankLevels[level]; left > 0 {
+				gapSpec.BloomCounts[level] = left
+			}
+		}
+		assignChunkBloom(chunks, gapSpec.BloomCounts)
+	}
+	log.Printf("[AssembleExam] generating the gaps in %d chunks", len(chunks))
+
+	lastErr := h.fillChunks(ctx, blueprint, chunks, bankTexts, gapSpec, opts...)
+	var generated []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, chunk := range chunks {
+		generated = append(generated, chunk.questions...)
+	}
+	if len(generated) == 0 {
+		return nil, lastErr
+	}
+	return generated, nil
+}
+
+// spreadPoints shares total points out over the questions in proportion to the points the
+// model gave them, so the generated questions make up what the bank questions leave of the
+// points target. Every question keeps at least one point.
+func spreadPoints(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, total int) {
+	if len(questions) == 0 {
+		return
+	}
+	weights, sum := make([]int, len(questions)), 0
+	for i, question := range questions {
+		weights[i] = int(question.GetPoints())
+		if weights[i] < 1 {
+			weights[i] = 1
+		}
+		sum += weights[i]
+	}
+	if total < len(questions) {
+		total = len(questions)
+	}
+
+	// Everyone gets one point, the rest goes by largest remainder.
+	rest := total - len(questions)
+	remainders := make([]int, len(questions))
+	assigned := 0
+	for i, question := range questions {
+		share := rest * weights[i] / sum
+		remainders[i] = rest * weights[i] % sum
+		question.Points = int32(1 + share)
+		assigned += share
+	}
+	for assigned < rest {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+		questions[largest].Points++
+		remainders[largest] = -1
+		assigned++
+	}
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"darius/internal/validation"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func bankCandidate(id uint64, questionType string, points int32) *suggest.BankQuestion {
+	return &suggest.BankQuestion{Id: id, Question: &suggest.SuggestExamQuestionResponseV2_Quetion{Type: questionType, Points: points}}
+}
+
+func bankRow(id uint, status string, question *suggest.SuggestExamQuestionResponseV2_Quetion) models.Question {
+	content, _ := protojson.Marshal(question)
+	return models.Question{
+		ID:       id,
+		Text:     question.GetText(),
+		Type:     question.GetType(),
+		Topic:    "Go",
+		Level:    "Junior",
+		Language: "English",
+		Points:   question.GetPoints(),
+		Content:  string(content),
+		Status:   status,
+	}
+}
+
+// bankedQuestion is a complete MCQ, as questions that made it through review are.
+func bankedQuestion() *suggest.SuggestExamQuestionResponseV2_Quetion {
+	question := &suggest.SuggestExamQuestionResponseV2_Quetion{}
+	protojson.Unmarshal([]byte(editedQuestionJSON("7", "Which keyword starts a goroutine?", "0")), question)
+	question.TestId = "test-1"
+	return question
+}
+
+func pickedIds(cells []*assemblyCell) []uint64 {
+	var ids []uint64
+	for _, cell := range cells {
+		for _, i := range cell.picked {
+			ids = append(ids, cell.candidates[i].GetId())
+		}
+	}
+	return ids
+}
+
+func Test_pickBankQuestions(t *testing.T) {
+	mcq, longAnswer := validation.QuestionTypeMCQ, validation.QuestionTypeLongAnswer
+
+	// The first two candidates add up to 11 points, the target is 5.
+	cells := []*assemblyCell{{count: 2, candidates: []*suggest.BankQuestion{
+		bankCandidate(1, mcq, 10), bankCandidate(2, mcq, 1), bankCandidate(3, mcq, 2), bankCandidate(4, mcq, 3),
+	}}}
+	pickBankQuestions(cells, assemblyTarget{questionCount: 2, totalPoints: 5})
+	assert.ElementsMatch(t, []uint64{3, 4}, pickedIds(cells))
+
+	// Long answers don't fit in 5 minutes.
+	cells = []*assemblyCell{{count: 2, candidates: []*suggest.BankQuestion{
+		bankCandidate(1, longAnswer, 5), bankCandidate(2, mcq, 5), bankCandidate(3, mcq, 5),
+	}}}
+	pickBankQuestions(cells, assemblyTarget{questionCount: 2, minutes: 5})
+	assert.ElementsMatch(t, []uint64{2, 3}, pickedIds(cells))
+
+	// The type counts hold over every cell, and a question shared by two cells is picked once.
+	shared := bankCandidate(1, mcq, 1)
+	cells = []*assemblyCell{
+		{count: 1, candidates: []*suggest.BankQuestion{shared}},
+		{count: 2, candidates: []*suggest.BankQuestion{shared, bankCandidate(2, mcq, 1), bankCandidate(3, longAnswer, 1), bankCandidate(4, longAnswer, 1)}},
+	}
+	pickBankQuestions(cells, assemblyTarget{questionCount: 3, typeCounts: map[string]int{mcq: 1, longAnswer: 2}})
+	assert.Equal(t, []uint64{1, 3, 4}, pickedIds(cells))
+
+	// An empty bank leaves every question to be generated.
+	cells = []*assemblyCell{{count: 3}}
+	pickBankQuestions(cells, assemblyTarget{questionCount: 3, totalPoints: 9, minutes: 1})
+	assert.Empty(t, pickedIds(cells))
+}
+
+func Test_spreadPoints(t *testing.T) {
+	questions := []*suggest.SuggestExamQuestionResponseV2_Quetion{{Points: 2}, {Points: 2}, {Points: 4}}
+	spreadPoints(questions, 10)
+	assert.Equal(t, []int32{3, 3, 4}, []int32{questions[0].Points, questions[1].Points, questions[2].Points})
+
+	spreadPoints(questions, 1)
+	assert.Equal(t, []int32{1, 1, 1}, []int32{questions[0].Points, questions[1].Points, questions[2].Points})
+}
+
+var assembleConfig = Config{
+	DuplicateThreshold: 0.8,
+	ExamChunking:       ExamChunkingConfig{ChunkSize: 10, Workers: 1},
+	QuestionBank:       QuestionBankConfig{CandidatesPerCell: 50, RecentExposure: 24 * time.Hour},
+}
+
+func Test_AssembleExam(t *testing.T) {
+	banked := bankedQuestion()
+	seen := &suggest.SuggestExamQuestionResponseV2_Quetion{Text: "Which keyword delays a call?", Type: validation.QuestionTypeMCQ, Points: 2}
+	bank := &mockQuestionBank{
+		questions: []models.Question{
+			bankRow(1, models.QuestionStatusApproved, banked),
+			bankRow(2, models.QuestionStatusApproved, seen),
+			bankRow(3, models.QuestionStatusDraft, seen),
+		},
+		seen: map[string][]uint{"campaign-1": {2}},
+	}
+	h, llm, ctx := newTestHandler(assembleConfig, examResponse("Which statement waits on several channel operations?"))
+	h.questionBank = bank
+
+	resp, err := h.AssembleExam(ctx, &suggest.AssembleExamRequest{
+		Blueprint: &suggest.SuggestExamQuestionRequest{
+			Language:     "English",
+			QuestionType: validation.QuestionTypeMCQ,
+			Topics:       []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+			RequestKey:   "assemble-1",
+		},
+		TotalPoints:     6,
+		MinutesToAnswer: 10,
+		CandidatePool:   "campaign-1",
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetViolations())
+	assert.Len(t, resp.GetQuestions(), 2)
+	assert.Equal(t, "assemble-1", resp.GetRequestKey())
+
+	items := resp.GetItems()
+	assert.Equal(t, []string{sourceBank, sourceGenerated}, []string{items[0].GetSource(), items[1].GetSource()})
+	assert.Equal(t, uint64(1), items[0].GetBankQuestionId())
+	assert.Equal(t, "Junior", items[1].GetLevel())
+	assert.Equal(t, banked.GetText(), resp.GetQuestions()[0].GetText())
+	assert.Empty(t, resp.GetQuestions()[0].GetTestId())
+	assert.Equal(t, []int32{1, 2}, []int32{resp.GetQuestions()[0].GetId(), resp.GetQuestions()[1].GetId()})
+
+	// The generated question makes up the rest of the points.
+	assert.Equal(t, int32(4), resp.GetQuestions()[1].GetPoints())
+	assert.Equal(t, int32(6), resp.GetTotalPoints())
+	// Both questions are tagged to take a minute.
+	assert.Equal(t, float32(2), resp.GetEstimatedMinutes())
+
+	assert.Len(t, llm.prompts, 1)
+	assert.Contains(t, llm.prompts[0], "Topic: **Go**, Level: **Junior**, Quantity: **1**")
+	assert.Contains(t, llm.prompts[0], "Do not repeat or paraphrase any of them:\n- "+banked.GetText())
+	assert.Equal(t, "campaign-1", bank.filter.UnseenBy)
+	assert.Equal(t, []uint{2, 1}, bank.seen["campaign-1"])
+}
+
+func Test_AssembleExam_UntaggedBankQuestion(t *testing.T) {
+	// Saved before questions were tagged or had their options explained.
+	banked := bankedQuestion()
+	banked.BloomLevel, banked.EstimatedMinutes = "", 0
+	banked.Detail.OptionExplanations = nil
+	bank := &mockQuestionBank{questions: []models.Question{bankRow(1, models.QuestionStatusApproved, banked)}}
+	h, _, ctx := newTestHandler(assembleConfig, examResponse("Which statement waits on several channel operations?"))
+	h.questionBank = bank
+
+	resp, err := h.AssembleExam(ctx, &suggest.AssembleExamRequest{
+		Blueprint: &suggest.SuggestExamQuestionRequest{
+			Language:     "English",
+			QuestionType: validation.QuestionTypeMCQ,
+			Topics:       []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetQuestions(), 2)
+	assert.Empty(t, resp.GetViolations())
+}
+
+func Test_AssembleExam_BankOnly(t *testing.T) {
+	banked := bankedQuestion()
+	banked.EstimatedMinutes = 3
+	bank := &mockQuestionBank{questions: []models.Question{bankRow(1, models.QuestionStatusApproved, banked)}}
+	h, llm, ctx := newTestHandler(assembleConfig)
+	h.questionBank = bank
+
+	resp, err := h.AssembleExam(ctx, &suggest.AssembleExamRequest{
+		Blueprint: &suggest.SuggestExamQuestionRequest{
+			Language: "English",
+			Topics:   []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 1}}},
+		},
+		TotalPoints:     5,
+		MinutesToAnswer: 1,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, llm.prompts, "a full bank needs no generation")
+	assert.Len(t, resp.GetQuestions(), 1)
+	assert.Equal(t, sourceBank, resp.GetItems()[0].GetSource())
+
+	codes := make(map[string]bool)
+	for _, violation := range resp.GetViolations() {
+		codes[violation.GetCode()] = true
+	}
+	assert.True(t, codes[validation.CodePointsTotal])
+	assert.True(t, codes[validation.CodeTimeBudget])
+	assert.Empty(t, bank.seen, "exposures are only recorded for a candidate pool")
+}
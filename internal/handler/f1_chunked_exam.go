@@ -0,0 +1,346 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/llmjson"
+	"darius/internal/similarity"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// maxTopUpRounds bounds how often missing questions are asked for again after merging.
+const maxTopUpRounds = 2
+
+// examLevels are the difficulty levels of a DifficultyDistribution, in order.
+var examLevels = []struct {
+	name string
+	get  func(*suggest.DifficultyDistribution) int32
+	set  func(*suggest.DifficultyDistribution, int32)
+}{
+	{"Intern", (*suggest.DifficultyDistribution).GetIntern, func(d *suggest.DifficultyDistribution, n int32) { d.Intern = n }},
+	{"Junior", (*suggest.DifficultyDistribution).GetJunior, func(d *suggest.DifficultyDistribution, n int32) { d.Junior = n }},
+	{"Middle", (*suggest.DifficultyDistribution).GetMiddle, func(d *suggest.DifficultyDistribution, n int32) { d.Middle = n }},
+	{"Senior", (*suggest.DifficultyDistribution).GetSenior, func(d *suggest.DifficultyDistribution, n int32) { d.Senior = n }},
+	{"Lead", (*suggest.DifficultyDistribution).GetLead, func(d *suggest.DifficultyDistribution, n int32) { d.Lead = n }},
+	{"Expert", (*suggest.DifficultyDistribution).GetExpert, func(d *suggest.DifficultyDistribution, n int32) { d.Expert = n }},
+}
+
+// examChunk is a part of an exam with a single topic and level, generated on its own so
+// the count per level can be checked and topped up.
+type examChunk struct {
+	index     int
+	topic     string
+	level     int // index into examLevels
+	count     int
+	types     map[string]int // questions per type, when the exam has a type ratio
+	bloom     map[string]int // questions per Bloom level, when the exam has a Bloom distribution
+	questions []*suggest.SuggestExamQuestionResponseV2_Quetion
+}
+
+// request copies req down to this chunk's topic, asking for count questions of its level,
+// split over types and Bloom levels when given.
+func (c *examChunk) request(req *suggest.SuggestExamQuestionRequest, count int, types, bloom map[string]int) *suggest.SuggestExamQuestionRequest {
+	chunkReq := proto.Clone(req).(*suggest.SuggestExamQuestionRequest)
+	distribution := &suggest.DifficultyDistribution{}
+	examLevels[c.level].set(distribution, int32(count))
+	chunkReq.Topics = []*suggest.Topic{{Name: c.topic, DifficultyDistribution: distribution}}
+	if len(types) > 0 {
+		chunkReq.TypeRatio = make(map[string]int32, len(types))
+		for questionType, n := range types {
+			chunkReq.TypeRatio[questionType] = int32(n)
+		}
+	}
+	chunkReq.BloomDistribution = nil
+	if len(bloom) > 0 {
+		chunkReq.BloomDistribution = validation.NewBloomDistribution(bloom)
+	}
+	return chunkReq
+}
+
+// missingTypes counts the questions of each type the chunk still lacks. When its questions
+// can't be matched up with its types, the missing ones are split over the types in the
+// same proportion instead.
+func (c *examChunk) missingTypes() map[string]int {
+	if len(c.types) == 0 {
+		return nil
+	}
+	have := make(map[string]int)
+	for _, question := range c.questions {
+		have[question.GetType()]++
+	}
+	missing, total := make(map[string]int), 0
+	for questionType, want := range c.types {
+		if have[questionType] < want {
+			missing[questionType] = want - have[questionType]
+			total += missing[questionType]
+		}
+	}
+	if total != c.missing() {
+		ratio := make(map[string]int32, len(c.types))
+		for questionType, n := range c.types {
+			ratio[questionType] = int32(n)
+		}
+		return validation.TypeCounts(ratio, c.missing())
+	}
+	return missing
+}
+
+// missingBloom counts the questions of each Bloom level the chunk still lacks, split over
+// the levels in proportion when its questions can't be matched up with them.
+func (c *examChunk) missingBloom() map[string]int {
+	if len(c.bloom) == 0 {
+		return nil
+	}
+	have := make(map[string]int)
+	for _, question := range c.questions {
+		have[validation.QuestionBloomLevel(question)]++
+	}
+	short := make(map[string]int)
+	for level, want := range c.bloom {
+		if have[level] < want {
+			short[level] = want - have[level]
+		}
+	}
+	return validation.ScaleBloomCounts(short, c.missing())
+}
+
+func (c *examChunk) missing() int {
+	if len(c.questions) >= c.count {
+		return 0
+	}
+	return c.count - len(c.questions)
+}
+
+// planExamChunks splits the request into one chunk per topic and level, cutting levels
+// with more than chunkSize questions into several chunks.
+func planExamChunks(req *suggest.SuggestExamQuestionRequest, chunkSize int) []*examChunk {
+	var chunks []*examChunk
+	for _, topic := range req.GetTopics() {
+		for level := range examLevels {
+			remaining := int(examLevels[level].get(topic.GetDifficultyDistribution()))
+			for remaining > 0 {
+				count := remaining
+				if chunkSize > 0 && count > chunkSize {
+					count = chunkSize
+				}
+				chunks = append(chunks, &examChunk{
+					index: len(chunks),
+					topic: topic.GetName(),
+					level: level,
+					count: count,
+				})
+				remaining -= count
+			}
+		}
+	}
+	return chunks
+}
+
+// assignChunkTypes hands out the exam's questions per type over the chunks, in proportion
+// to what is left, so every chunk gets its share of each type.
+func assignChunkTypes(chunks []*examChunk, typeCounts map[string]int) {
+	remaining := make(map[string]int32, len(typeCounts))
+	for questionType, n := range typeCounts {
+		remaining[questionType] = int32(n)
+	}
+	for _, chunk := range chunks {
+		chunk.types = validation.TypeCounts(remaining, chunk.count)
+		for questionType, n := range chunk.types {
+			remaining[questionType] -= int32(n)
+		}
+	}
+}
+
+// assignChunkBloom hands out the exam's questions per Bloom level over the chunks, the way
+// assignChunkTypes does with types.
+func assignChunkBloom(chunks []*examChunk, bloomCounts map[string]int) {
+	remaining := make(map[string]int, len(bloomCounts))
+	for level, n := range bloomCounts {
+		remaining[level] = n
+	}
+	for _, chunk := range chunks {
+		chunk.bloom = validation.ScaleBloomCounts(remaining, chunk.count)
+		for level, n := range chunk.bloom {
+			remaining[level] -= n
+		}
+	}
+}
+
+// generateExamInChunks generates a large exam as concurrent per-topic, per-level chunks,
+// merges them, drops questions that repeat one another and asks again for whatever is
+// missing, so the exam ends up with the requested number of questions per level.
+func (h *handler) generateExamInChunks(ctx context.Context, req *suggest.SuggestExamQuestionRequest, spec validation.ExamSpec, opts ...llmManager.GenerateOption) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	chunks := planExamChunks(req, h.config.ExamChunking.ChunkSize)
+	assignChunkTypes(chunks, spec.TypeCounts)
+	assignChunkBloom(chunks, spec.BloomCounts)
+	log.Printf("[SuggestExamQuestion] generating %d questions in %d chunks", spec.QuestionCount, len(chunks))
+
+	lastErr := h.fillChunks(ctx, req, chunks, nil, spec, opts...)
+	var questions []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, chunk := range chunks {
+		questions = append(questions, chunk.questions...)
+	}
+	if len(questions) == 0 {
+		return nil, lastErr
+	}
+	for i, question := range questions {
+		question.Id = int32(i + 1)
+	}
+	return questions, nil
+}
+
+// fillChunks generates the questions of the chunks, topping up the ones that came back
+// short, and returns the last error any chunk hit. references are the texts of questions
+// the exam already has outside of the chunks, which must not be repeated.
+func (h *handler) fillChunks(ctx context.Context, req *suggest.SuggestExamQuestionRequest, chunks []*examChunk, references []string, spec validation.ExamSpec, opts ...llmManager.GenerateOption) error {
+	var lastErr error
+	for round := 0; round <= maxTopUpRounds; round++ {
+		var pending []*examChunk
+		for _, chunk := range chunks {
+			if chunk.missing() > 0 {
+				pending = append(pending, chunk)
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+		if round > 0 {
+			log.Printf("[SuggestExamQuestion] top-up round %d for %d chunks", round, len(pending))
+		}
+
+		existing := append(append([]string(nil), references...), chunkQuestionTexts(chunks)...)
+		lastErr = h.forEachChunk(pending, func(chunk *examChunk) error {
+			questions, err := h.generateChunk(ctx, req, round, chunk, existing, spec, opts...)
+			if err != nil {
+				return err
+			}
+			chunk.questions = append(chunk.questions, questions...)
+			return nil
+		})
+		dedupeChunks(chunks, h.config.DuplicateThreshold)
+	}
+
+	for _, chunk := range chunks {
+		if len(chunk.questions) > chunk.count {
+			chunk.questions = chunk.questions[:chunk.count]
+		}
+	}
+	return lastErr
+}
+
+// forEachChunk runs fn on the chunks with at most the configured number of workers and
+// returns the last error any of them hit.
+func (h *handler) forEachChunk(chunks []*examChunk, fn func(*examChunk) error) error {
+	workers := h.config.ExamChunking.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		lastErr error
+	)
+	slots := make(chan struct{}, workers)
+	for _, chunk := range chunks {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(chunk *examChunk) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := fn(chunk); err != nil {
+				log.Printf("[SuggestExamQuestion] chunk %d (%s, %s) failed: %v", chunk.index+1, chunk.topic, examLevels[chunk.level].name, err)
+				mu.Lock()
+				lastErr = err
+				mu.Unlock()
+			}
+		}(chunk)
+	}
+	wg.Wait()
+	return lastErr
+}
+
+// generateChunk asks for the questions a chunk is still missing. Questions already in the
+// exam are listed so the model doesn't repeat them.
+func (h *handler) generateChunk(ctx context.Context, req *suggest.SuggestExamQuestionRequest, round int, chunk *examChunk, existing []string, spec validation.ExamSpec, opts ...llmManager.GenerateOption) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	missing, missingTypes, missingBloom := chunk.missing(), chunk.missingTypes(), chunk.missingBloom()
+	prompt := h.examQuestionPrompt(ctx, chunk.request(req, missing, missingTypes, missingBloom))
+	if len(existing) > 0 {
+		prompt += fmt.Sprintf("\nThese questions are already part of the exam. Do not repeat or paraphrase any of them:\n- %v\n", strings.Join(existing, "\n- "))
+	}
+
+	// Every call is reported under its own key, derived from the request key.
+	chunkKey := subRequestKey(req.GetRequestKey(), "%d.%d", chunk.index+1, round)
+	conversationId, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_EXAM, prompt, chunkKey, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	exam := &suggest.SuggestExamQuestionResponseV2{}
+	if err := llmjson.UnmarshalProto(llmResponse, exam); err != nil {
+		return nil, err
+	}
+
+	// A chunk only asks for its own topic and level, so its questions are labelled with them
+	// rather than checked against the breakdown.
+	chunkSpec := spec
+	chunkSpec.QuestionCount = missing
+	chunkSpec.Cells = nil
+	chunkSpec.TypeCounts = missingTypes
+	chunkSpec.BloomCounts = missingBloom
+	questions, violations := h.repairExamQuestions(ctx, chunkKey, conversationId, exam.GetQuestions(), chunkSpec, opts...)
+
+	// Questions that are still broken are dropped here and asked for again in the next round.
+	// Doubtful answer keys and tags, and code that could not be run, are kept and reported
+	// with the exam.
+	valid, _ := dropBrokenQuestions(questions, violations)
+	for _, question := range valid {
+		question.Topic, question.Level = chunk.topic, examLevels[chunk.level].name
+	}
+	return valid, nil
+}
+
+func chunkQuestionTexts(chunks []*examChunk) []string {
+	var texts []string
+	for _, chunk := range chunks {
+		for _, question := range chunk.questions {
+			texts = append(texts, question.GetText())
+		}
+	}
+	return texts
+}
+
+// dedupeChunks removes questions that repeat an earlier question of the exam, across and
+// within chunks.
+func dedupeChunks(chunks []*examChunk, threshold float64) {
+	duplicates := similarity.Duplicates(chunkQuestionTexts(chunks), nil, threshold)
+	if len(duplicates) == 0 {
+		return
+	}
+	dropped := make(map[int]bool, len(duplicates))
+	for _, duplicate := range duplicates {
+		dropped[duplicate.Index] = true
+	}
+
+	index := 0
+	for _, chunk := range chunks {
+		var unique []*suggest.SuggestExamQuestionResponseV2_Quetion
+		for _, question := range chunk.questions {
+			if dropped[index] {
+				log.Printf("[SuggestExamQuestion] dropping duplicate question: %s", question.GetText())
+			} else {
+				unique = append(unique, question)
+			}
+			index++
+		}
+		chunk.questions = unique
+	}
+}
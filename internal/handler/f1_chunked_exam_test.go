@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/validation"
+	"darius/pkg/proto/deps/missfortune"
+	"darius/pkg/proto/suggest"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMissfortune struct{}
+
+func (mockMissfortune) GetExamQuestionContent(ctx context.Context, req *missfortune.SuggestExamQuestionRequest) (*missfortune.SuggestExamQuestionResponse, error) {
+	return nil, errors.New("missfortune is down")
+}
+
+var breakdownPattern = regexp.MustCompile(`Topic: \*\*(\w+)\*\*, Level: \*\*(\w+)\*\*, Quantity: \*\*(\d+)\*\*`)
+
+// examResponse answers with an MCQ per text, on the Go topic at the Junior level.
+func examResponse(texts ...string) string {
+	var questions []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for i, text := range texts {
+		questions = append(questions, &suggest.SuggestExamQuestionResponseV2_Quetion{
+			Id:               int32(i + 1),
+			Text:             text,
+			Type:             validation.QuestionTypeMCQ,
+			Topic:            "Go",
+			Level:            "Junior",
+			BloomLevel:       validation.BloomRemember,
+			EstimatedMinutes: 1,
+			Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+				Type:    validation.QuestionTypeMCQ,
+				Options: []string{"The first choice", "The second choice", "The third choice", "The fourth choice"},
+				OptionExplanations: []*suggest.SuggestExamQuestionResponseV2_OptionExplanation{
+					{Explanation: "The first choice is right"},
+					{Explanation: "The second choice is wrong", Misconception: "second-guessing"},
+					{Explanation: "The third choice is wrong", Misconception: "third-guessing"},
+					{Explanation: "The fourth choice is wrong", Misconception: "fourth-guessing"},
+				},
+			},
+		})
+	}
+	response, _ := json.Marshal(&suggest.SuggestExamQuestionResponseV2{Questions: questions})
+	return string(response)
+}
+
+func Test_planExamChunks(t *testing.T) {
+	chunks := planExamChunks(&suggest.SuggestExamQuestionRequest{
+		Topics: []*suggest.Topic{
+			{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 12, Senior: 3}},
+			{Name: "SQL", DifficultyDistribution: &suggest.DifficultyDistribution{Intern: 2}},
+		},
+	}, 10)
+
+	var plan []string
+	for _, chunk := range chunks {
+		plan = append(plan, fmt.Sprintf("%s/%s/%d", chunk.topic, examLevels[chunk.level].name, chunk.count))
+	}
+	assert.Equal(t, []string{"Go/Junior/10", "Go/Junior/2", "Go/Senior/3", "SQL/Intern/2"}, plan)
+
+	req := chunks[2].request(&suggest.SuggestExamQuestionRequest{Title: "Backend"}, 1, nil, nil)
+	assert.Equal(t, "Backend", req.GetTitle())
+	assert.Equal(t, "Go", req.GetTopics()[0].GetName())
+	assert.Equal(t, int32(1), req.GetTopics()[0].GetDifficultyDistribution().GetSenior())
+	assert.Equal(t, 1, validation.ExamSpecFromRequest(req).QuestionCount)
+
+	// A 3:1 ratio over 17 questions is shared out over the chunks.
+	assignChunkTypes(chunks, validation.TypeCounts(map[string]int32{"MCQ": 3, "TRUE_FALSE": 1}, 17))
+	var types []map[string]int
+	for _, chunk := range chunks {
+		types = append(types, chunk.types)
+	}
+	assert.Equal(t, []map[string]int{{"MCQ": 8, "TRUE_FALSE": 2}, {"MCQ": 1, "TRUE_FALSE": 1}, {"MCQ": 2, "TRUE_FALSE": 1}, {"MCQ": 2}}, types)
+
+	req = chunks[0].request(&suggest.SuggestExamQuestionRequest{QuestionType: "MIXED"}, 3, map[string]int{"TRUE_FALSE": 3}, nil)
+	assert.Equal(t, map[string]int{"TRUE_FALSE": 3}, validation.ExamSpecFromRequest(req).TypeCounts)
+
+	// So is a Bloom distribution, and every chunk asks only for its own share.
+	assignChunkBloom(chunks, map[string]int{validation.BloomRemember: 9, validation.BloomApply: 8})
+	var levels []map[string]int
+	for _, chunk := range chunks {
+		levels = append(levels, chunk.bloom)
+	}
+	assert.Equal(t, []map[string]int{{"REMEMBER": 5, "APPLY": 5}, {"REMEMBER": 1, "APPLY": 1}, {"REMEMBER": 2, "APPLY": 1}, {"REMEMBER": 1, "APPLY": 1}}, levels)
+
+	whole := &suggest.SuggestExamQuestionRequest{BloomDistribution: &suggest.BloomDistribution{Remember: 9, Apply: 8}}
+	assert.Equal(t, map[string]int{"APPLY": 2}, validation.ExamSpecFromRequest(chunks[1].request(whole, 2, nil, map[string]int{"APPLY": 2})).BloomCounts)
+	assert.Nil(t, chunks[1].request(whole, 2, nil, nil).GetBloomDistribution())
+}
+
+func Test_generateExamInChunks(t *testing.T) {
+	generated := 0
+	llm := &mockLLMManager{respond: func(prompt string) string {
+		match := breakdownPattern.FindStringSubmatch(prompt)
+		if match == nil {
+			// Repair prompts get nothing useful back.
+			return `{"questions": []}`
+		}
+		topic, level := match[1], match[2]
+		quantity, _ := strconv.Atoi(match[3])
+
+		var texts []string
+		switch {
+		case topic == "SQL" && generated < 100:
+			// The first SQL answer repeats a Go question and comes up one short.
+			texts = append(texts, "Which keyword starts a new goroutine in Go?")
+			generated = 100
+		case topic == "Go" && level == "Senior":
+			texts = append(texts, "Which keyword starts a new goroutine in Go?")
+		}
+		for len(texts) < quantity {
+			generated++
+			texts = append(texts, fmt.Sprintf("Which statement about %s at the %s level holds for case%da, case%db and case%dc?", topic, level, generated, generated, generated))
+		}
+		return examResponse(texts...)
+	}}
+	h := &handler{
+		llmManager:  llm,
+		missfortune: mockMissfortune{},
+		config: Config{
+			ExamChunking:       ExamChunkingConfig{Threshold: 10, ChunkSize: 10, Workers: 3},
+			DuplicateThreshold: 0.8,
+		},
+	}
+
+	req := &suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeMCQ,
+		RequestKey:   "key",
+		Topics: []*suggest.Topic{
+			{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 12, Senior: 3}},
+			{Name: "SQL", DifficultyDistribution: &suggest.DifficultyDistribution{Intern: 2}},
+		},
+	}
+	spec := validation.ExamSpecFromRequest(req)
+
+	questions, err := h.generateExamInChunks(context.Background(), req, spec)
+	assert.NoError(t, err)
+	assert.Len(t, questions, 17)
+	assert.Empty(t, validation.ValidateExam(questions, spec))
+
+	seen := make(map[string]bool)
+	for i, question := range questions {
+		assert.Equal(t, int32(i+1), question.GetId())
+		assert.False(t, seen[question.GetText()], question.GetText())
+		seen[question.GetText()] = true
+	}
+
+	// The goroutine question stays with Go/Senior, so SQL asks for one more and is told about it.
+	var topUp string
+	for _, prompt := range llm.prompts {
+		if match := breakdownPattern.FindStringSubmatch(prompt); match != nil && match[1] == "SQL" {
+			topUp = prompt
+		}
+	}
+	assert.Contains(t, topUp, "Quantity: **1**")
+	assert.Contains(t, topUp, "Which keyword starts a new goroutine in Go?")
+}
+
+func Test_dedupeChunks(t *testing.T) {
+	question := func(text string) *suggest.SuggestExamQuestionResponseV2_Quetion {
+		return &suggest.SuggestExamQuestionResponseV2_Quetion{Text: text}
+	}
+	chunks := []*examChunk{
+		{questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{
+			question("What does the defer keyword do in Go?"),
+			question("How do you close a channel in Go?"),
+		}},
+		{questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{
+			question("What does the `defer` keyword do in Go??"),
+			question("How do you create a buffered channel in Go?"),
+		}},
+	}
+
+	dedupeChunks(chunks, 0.8)
+
+	assert.Len(t, chunks[0].questions, 2)
+	assert.Equal(t, []*suggest.SuggestExamQuestionResponseV2_Quetion{question("How do you create a buffered channel in Go?")}, chunks[1].questions)
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	"darius/pkg/proto/suggest"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// tagClassifier has every question classified again in a separate prompt that does not
+// show its tags, and records on the question the Bloom level and solve time it found.
+// Questions are classified once per repair, only the ones the model rewrote are sent again.
+type tagClassifier struct {
+	llmManager   llmManager.Manager
+	minutesRatio float32
+	requestKey   string
+	calls        int
+	classified   map[*suggest.SuggestExamQuestionResponseV2_Quetion]bool
+}
+
+// newTagClassifier returns nil when the tag check is turned off.
+func (h *handler) newTagClassifier(requestKey string) *tagClassifier {
+	if !h.config.TagCheck.Enabled {
+		return nil
+	}
+	return &tagClassifier{
+		llmManager:   h.llmManager,
+		minutesRatio: h.config.TagCheck.MinutesRatio,
+		requestKey:   requestKey,
+		classified:   make(map[*suggest.SuggestExamQuestionResponseV2_Quetion]bool),
+	}
+}
+
+type classifiedTags struct {
+	Id               int32   `json:"id"`
+	BloomLevel       string  `json:"bloomLevel"`
+	EstimatedMinutes float32 `json:"estimatedMinutes"`
+	Reason           string  `json:"reason"`
+}
+
+// check classifies the questions that were not classified yet in one call and reports a
+// tag_mismatch violation for every question whose tags the classification disagrees with.
+// A failed classifying call is logged and leaves the questions unchecked.
+func (c *tagClassifier) check(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []validation.Violation {
+	if c == nil {
+		return nil
+	}
+	var pending []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, question := range questions {
+		if !c.classified[question] {
+			question.TagCheck = nil
+			pending = append(pending, question)
+		}
+	}
+
+	if len(pending) > 0 {
+		tags, err := c.classify(ctx, pending)
+		if err != nil {
+			log.Printf("[ClassifyTags] error classifying %d questions: %v", len(pending), err)
+		}
+		for _, question := range pending {
+			c.classified[question] = true
+			if tag, ok := tags[question.GetId()]; ok {
+				question.TagCheck = &suggest.SuggestExamQuestionResponseV2_TagCheck{
+					BloomLevel:       strings.ToUpper(strings.TrimSpace(tag.BloomLevel)),
+					EstimatedMinutes: tag.EstimatedMinutes,
+					Reason:           tag.Reason,
+				}
+			}
+		}
+	}
+	return tagViolations(questions, c.minutesRatio)
+}
+
+func (c *tagClassifier) classify(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) (map[int32]classifiedTags, error) {
+	c.calls++
+	_, llmResponse, err := c.llmManager.Generate(ctx, constants.F1_CLASSIFY_TAGS, classifyTagsPrompt(questions), subRequestKey(c.requestKey, "classify.%d", c.calls), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Tags []classifiedTags `json:"tags"`
+	}
+	if err := llmjson.Unmarshal(llmResponse, &result); err != nil {
+		return nil, err
+	}
+	tags := make(map[int32]classifiedTags, len(result.Tags))
+	for _, tag := range result.Tags {
+		tags[tag.Id] = tag
+	}
+	return tags, nil
+}
+
+// tagViolations flags the questions whose Bloom level is not the one the classification
+// found, and, with a minutesRatio, those whose solve time is off from its estimate by more
+// than that factor either way.
+func tagViolations(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, minutesRatio float32) []validation.Violation {
+	var violations []validation.Violation
+	for _, question := range questions {
+		check := question.GetTagCheck()
+		if check == nil {
+			continue
+		}
+		if level := check.GetBloomLevel(); validation.IsBloomLevel(level) && level != question.GetBloomLevel() {
+			violations = append(violations, validation.Violation{
+				QuestionId: question.GetId(),
+				Field:      "bloomLevel",
+				Code:       validation.CodeTagMismatch,
+				Message:    fmt.Sprintf("tagged %s, but a separate classification found it tests %s (%s); make the question require %s thinking, or tag it with the level it really tests", question.GetBloomLevel(), level, check.GetReason(), question.GetBloomLevel()),
+			})
+		}
+		tagged, estimated := question.GetEstimatedMinutes(), check.GetEstimatedMinutes()
+		if minutesRatio > 0 && tagged > 0 && estimated > 0 && (tagged > estimated*minutesRatio || estimated > tagged*minutesRatio) {
+			violations = append(violations, validation.Violation{
+				QuestionId: question.GetId(),
+				Field:      "estimatedMinutes",
+				Code:       validation.CodeTagMismatch,
+				Message:    fmt.Sprintf("estimated to take %.1f minutes, but a separate estimate found %.1f; correct estimatedMinutes", tagged, estimated),
+			})
+		}
+	}
+	return violations
+}
+
+func classifyTagsPrompt(questions []*suggest.SuggestExamQuestionResponseV2_Quetion) string {
+	var untagged strings.Builder
+	for _, question := range questions {
+		shown := proto.Clone(question).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+		shown.TestId, shown.BloomLevel, shown.EstimatedMinutes = "", "", 0
+		shown.KeyCheck, shown.TagCheck, shown.CodeCheck, shown.Citations = nil, nil, nil, nil
+		content, err := protojson.Marshal(shown)
+		if err != nil {
+			log.Printf("[ClassifyTags] error marshalling question %d: %v", question.GetId(), err)
+			continue
+		}
+		fmt.Fprintf(&untagged, "%s\n", content)
+	}
+	levels, _ := json.Marshal(validation.BloomLevels)
+
+	return fmt.Sprintf(`
+You are an assessment expert reviewing exam questions. Classify every question below on your own.
+
+📥 Questions, one JSON object per line:
+%s
+🔁 For every question give:
+- "bloomLevel": the level of Bloom's taxonomy answering it requires, one of %s. Judge the thinking a candidate has to do, not how hard the topic is: recalling a fact is REMEMBER even for an advanced topic.
+- "estimatedMinutes": about how many minutes a prepared candidate needs to answer it.
+- "reason": one short sentence explaining "bloomLevel".
+
+📤 Output Format:
+Return only a valid JSON object {"tags": [{"id": 1, "bloomLevel": "APPLY", "estimatedMinutes": 2, "reason": "..."}]} with one entry per question id. No notes, markdown, or trailing commas.
+`, untagged.String(), levels)
+}
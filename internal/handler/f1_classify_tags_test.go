@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/errors"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_tagViolations(t *testing.T) {
+	tagged := func(level string, minutes float32, check *suggest.SuggestExamQuestionResponseV2_TagCheck) *suggest.SuggestExamQuestionResponseV2_Quetion {
+		return &suggest.SuggestExamQuestionResponseV2_Quetion{Id: 1, BloomLevel: level, EstimatedMinutes: minutes, TagCheck: check}
+	}
+
+	tests := []struct {
+		name     string
+		question *suggest.SuggestExamQuestionResponseV2_Quetion
+		want     []string
+	}{
+		{name: "unchecked", question: tagged(validation.BloomApply, 2, nil)},
+		{name: "agrees", question: tagged(validation.BloomApply, 2, &suggest.SuggestExamQuestionResponseV2_TagCheck{BloomLevel: validation.BloomApply, EstimatedMinutes: 3})},
+		{name: "other level", question: tagged(validation.BloomApply, 2, &suggest.SuggestExamQuestionResponseV2_TagCheck{BloomLevel: validation.BloomRemember, EstimatedMinutes: 2}), want: []string{"bloomLevel"}},
+		{name: "unknown level", question: tagged(validation.BloomApply, 2, &suggest.SuggestExamQuestionResponseV2_TagCheck{BloomLevel: "SYNTHESIZE", EstimatedMinutes: 2})},
+		{name: "too long", question: tagged(validation.BloomApply, 2, &suggest.SuggestExamQuestionResponseV2_TagCheck{BloomLevel: validation.BloomApply, EstimatedMinutes: 0.5}), want: []string{"estimatedMinutes"}},
+		{name: "too short", question: tagged(validation.BloomCreate, 5, &suggest.SuggestExamQuestionResponseV2_TagCheck{BloomLevel: validation.BloomRemember, EstimatedMinutes: 15}), want: []string{"bloomLevel", "estimatedMinutes"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields []string
+			for _, violation := range tagViolations([]*suggest.SuggestExamQuestionResponseV2_Quetion{tt.question}, 2) {
+				assert.Equal(t, validation.CodeTagMismatch, violation.Code)
+				fields = append(fields, violation.Field)
+			}
+			assert.Equal(t, tt.want, fields)
+		})
+	}
+}
+
+// tagClassifierFor answers the classification prompt with the given Bloom level of each
+// question id, all estimated to take a minute, and anything else with the responses in order.
+func tagClassifierFor(levels map[int]string, responses ...string) func(prompt string) string {
+	return func(prompt string) string {
+		if strings.Contains(prompt, "reviewing exam questions") {
+			var tags []string
+			for id, level := range levels {
+				if strings.Contains(prompt, fmt.Sprintf(`"id":%d`, id)) || strings.Contains(prompt, fmt.Sprintf(`"id": %d`, id)) {
+					tags = append(tags, fmt.Sprintf(`{"id": %d, "bloomLevel": %q, "estimatedMinutes": 1, "reason": "it asks to %s"}`, id, strings.ToLower(level), strings.ToLower(level)))
+				}
+			}
+			return `{"tags": [` + strings.Join(tags, ", ") + `]}`
+		}
+		response := responses[0]
+		responses = responses[1:]
+		return response
+	}
+}
+
+func Test_generateExam_Tags(t *testing.T) {
+	req := &suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeMCQ,
+		Topics:       []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+	}
+	exam := examResponse("Which keyword starts a goroutine?", "Which statement waits on several channel operations?")
+
+	t.Run("regenerates a wrong tag", func(t *testing.T) {
+		repaired := &suggest.SuggestExamQuestionResponseV2{}
+		json.Unmarshal([]byte(exam), repaired)
+		repaired.Questions = repaired.Questions[1:]
+		repaired.Questions[0].BloomLevel = validation.BloomApply
+		repair, _ := json.Marshal(repaired)
+		llm := &mockLLMManager{respond: tagClassifierFor(map[int]string{1: validation.BloomRemember, 2: validation.BloomApply}, exam, string(repair))}
+		h := &handler{llmManager: llm, missfortune: mockMissfortune{}, config: Config{TagCheck: TagCheckConfig{Enabled: true, MinutesRatio: 2, Regenerate: true}}}
+
+		keyed := proto.Clone(req).(*suggest.SuggestExamQuestionRequest)
+		keyed.RequestKey = "key"
+		resp, err := h.generateExam(context.Background(), keyed, validation.ExamSpecFromRequest(req))
+		assert.NoError(t, err)
+		assert.Empty(t, resp.GetViolations())
+		// Generate, classify both, repair the second, classify only the repaired one.
+		assert.Len(t, llm.prompts, 4)
+		assert.Equal(t, []string{"key", "key#classify.1", "key#repair.1", "key#classify.2"}, llm.requestKeys)
+		assert.Contains(t, llm.prompts[0], `"bloomLevel"`)
+		assert.Contains(t, llm.prompts[2], "tagged REMEMBER, but a separate classification found it tests APPLY (it asks to apply)")
+		assert.NotContains(t, llm.prompts[3], "starts a goroutine")
+
+		assert.Equal(t, validation.BloomApply, resp.GetQuestions()[1].GetBloomLevel())
+		assert.Equal(t, validation.BloomApply, resp.GetQuestions()[1].GetTagCheck().GetBloomLevel())
+		assert.Equal(t, validation.BloomRemember, resp.GetQuestions()[0].GetTagCheck().GetBloomLevel())
+	})
+
+	t.Run("reports a wrong tag", func(t *testing.T) {
+		llm := &mockLLMManager{respond: tagClassifierFor(map[int]string{1: validation.BloomRemember, 2: validation.BloomAnalyze}, exam)}
+		h := &handler{llmManager: llm, missfortune: mockMissfortune{}, config: Config{TagCheck: TagCheckConfig{Enabled: true}}}
+
+		resp, err := h.generateExam(context.Background(), proto.Clone(req).(*suggest.SuggestExamQuestionRequest), validation.ExamSpecFromRequest(req))
+		assert.NoError(t, err)
+		assert.Len(t, llm.prompts, 2)
+		assert.Len(t, resp.GetViolations(), 1)
+		assert.Equal(t, int32(2), resp.GetViolations()[0].GetQuestionId())
+		assert.Equal(t, validation.CodeTagMismatch, resp.GetViolations()[0].GetCode())
+		assert.Equal(t, validation.BloomAnalyze, validation.QuestionBloomLevel(resp.GetQuestions()[1]))
+	})
+}
+
+func Test_SuggestExamQuestionV2_BloomDistribution(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{}, examResponse("Which keyword starts a goroutine?", "Which statement waits on several channel operations?"))
+
+	exam, err := h.SuggestExamQuestionV2(ctx, &suggest.SuggestExamQuestionRequest{
+		QuestionType:      validation.QuestionTypeMCQ,
+		Topics:            []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+		BloomDistribution: &suggest.BloomDistribution{Remember: 1, Apply: 1},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, exam.GetViolations())
+	assert.Contains(t, llm.prompts[0], "Write exactly this many questions at each Bloom level, across all question types:\n- REMEMBER: **1**\n- APPLY: **1**\n")
+	assert.NotContains(t, llm.prompts[0], "bloomDistribution:", "the request lists the levels in the type section only")
+
+	// Both questions came back at the REMEMBER level.
+	report := exam.GetBloomReport()
+	assert.True(t, proto.Equal(&suggest.BloomDistribution{Remember: 1, Apply: 1}, report.GetTarget()))
+	assert.True(t, proto.Equal(&suggest.BloomDistribution{Remember: 2}, report.GetActual()))
+	assert.InDelta(t, 0.5, report.GetMatch(), 0.001)
+
+	_, err = h.SuggestExamQuestionV2(ctx, &suggest.SuggestExamQuestionRequest{
+		Topics:            []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+		BloomDistribution: &suggest.BloomDistribution{Apply: 3},
+	})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/sandbox"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// maxShownOutput is how much of an expected or actual output a failing test shows in
+// the repair prompt.
+const maxShownOutput = 200
+
+// codeChecker runs the reference solution of every CODE question against its own test
+// cases in the sandbox and records the outcome on the question. Results are kept per
+// question so a repair round only runs the questions the model rewrote.
+type codeChecker struct {
+	sandbox sandbox.Runner
+	checked map[*suggest.SuggestExamQuestionResponseV2_Quetion][]validation.Violation
+}
+
+func (h *handler) newCodeChecker() *codeChecker {
+	return &codeChecker{sandbox: h.sandbox, checked: make(map[*suggest.SuggestExamQuestionResponseV2_Quetion][]validation.Violation)}
+}
+
+// check reports a failing_tests violation for every CODE question whose reference solution
+// does not compile or does not pass one of its tests, and an unverified violation for every
+// one that could not be run, such as when there is no sandbox or it can't run the language.
+func (c *codeChecker) check(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []validation.Violation {
+	if c == nil {
+		return nil
+	}
+	var violations []validation.Violation
+	for _, question := range questions {
+		code := question.GetDetail().GetCode()
+		if question.GetType() != validation.QuestionTypeCode || code == nil || strings.TrimSpace(code.GetReferenceSolution()) == "" || len(code.GetTestCases()) == 0 {
+			continue
+		}
+		if _, ok := c.checked[question]; !ok {
+			c.checked[question] = c.run(ctx, question, code)
+		}
+		violations = append(violations, c.checked[question]...)
+	}
+	return violations
+}
+
+func (c *codeChecker) run(ctx context.Context, question *suggest.SuggestExamQuestionResponseV2_Quetion, code *suggest.SuggestExamQuestionResponseV2_CodeDetail) []validation.Violation {
+	if c.sandbox == nil {
+		return unverifiedCode(question, "there is no sandbox to run it in")
+	}
+	tests := make([]sandbox.TestCase, 0, len(code.GetTestCases()))
+	for _, test := range code.GetTestCases() {
+		tests = append(tests, sandbox.TestCase{Input: test.GetInput(), ExpectedOutput: test.GetExpectedOutput()})
+	}
+
+	failing := func(format string, args ...interface{}) []validation.Violation {
+		message := fmt.Sprintf(format, args...)
+		question.CodeCheck = &suggest.SuggestExamQuestionResponseV2_CodeCheck{Reason: message}
+		return []validation.Violation{{
+			QuestionId: question.GetId(),
+			Field:      "detail.code.referenceSolution",
+			Code:       validation.CodeFailingTests,
+			Message:    message,
+		}}
+	}
+
+	results, err := c.sandbox.Run(ctx, code.GetLanguage(), code.GetReferenceSolution(), tests)
+	var compileErr *sandbox.CompileError
+	switch {
+	case stdErrors.As(err, &compileErr):
+		return failing("the reference solution does not compile: %s", truncate(compileErr.Output, maxShownOutput))
+	case err != nil:
+		log.Printf("[CodeQuestions] can't verify question %d, sandbox error: %v", question.GetId(), err)
+		return unverifiedCode(question, err.Error())
+	}
+
+	var problems []string
+	for i, result := range results {
+		if result.Passed {
+			continue
+		}
+		if result.Error != "" {
+			problems = append(problems, fmt.Sprintf("test %d: %s", i, truncate(result.Error, maxShownOutput)))
+		} else {
+			problems = append(problems, fmt.Sprintf("test %d: expected %q, got %q", i, truncate(tests[i].ExpectedOutput, maxShownOutput), truncate(result.Output, maxShownOutput)))
+		}
+	}
+	if len(problems) == 0 {
+		question.CodeCheck = &suggest.SuggestExamQuestionResponseV2_CodeCheck{Verified: true}
+		return nil
+	}
+	return failing("the reference solution fails %d of its %d test cases, fix the solution or the expected outputs (%s)", len(problems), len(tests), strings.Join(problems, "; "))
+}
+
+// unverifiedCode records that the reference solution of the question could not be run.
+// Rewriting the question doesn't help with that, so the violation is only reported.
+func unverifiedCode(question *suggest.SuggestExamQuestionResponseV2_Quetion, reason string) []validation.Violation {
+	question.CodeCheck = &suggest.SuggestExamQuestionResponseV2_CodeCheck{Reason: reason}
+	return codeCheckViolations([]*suggest.SuggestExamQuestionResponseV2_Quetion{question})
+}
+
+// codeCheckViolations reports an unverified violation for every CODE question the code
+// checker did not find passing all of its test cases.
+func codeCheckViolations(questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []validation.Violation {
+	var violations []validation.Violation
+	for _, question := range questions {
+		if question.GetType() != validation.QuestionTypeCode || question.GetCodeCheck().GetVerified() {
+			continue
+		}
+		reason := question.GetCodeCheck().GetReason()
+		if reason == "" {
+			reason = "it was not run"
+		}
+		violations = append(violations, validation.Violation{
+			QuestionId: question.GetId(),
+			Field:      "detail.code.referenceSolution",
+			Code:       validation.CodeUnverified,
+			Message:    "the reference solution could not be checked against the test cases: " + reason,
+		})
+	}
+	return violations
+}
+
+// dropFailingCode leaves out the CODE questions whose reference solution still fails its
+// tests after the repair, together with their violations. The violations about the exam as a
+// whole are worked out again for the questions that are left.
+func dropFailingCode(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, violations []validation.Violation, spec validation.ExamSpec) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, []validation.Violation) {
+	failing := make(map[int32]bool)
+	for _, violation := range violations {
+		if violation.Code == validation.CodeFailingTests {
+			failing[violation.QuestionId] = true
+		}
+	}
+	if len(failing) == 0 {
+		return questions, violations
+	}
+
+	var kept []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, question := range questions {
+		if failing[question.GetId()] {
+			log.Printf("[CodeQuestions] dropping question %d, its reference solution still fails", question.GetId())
+			continue
+		}
+		kept = append(kept, question)
+	}
+	var left []validation.Violation
+	for _, violation := range validation.ValidateExam(kept, spec) {
+		if violation.QuestionId == 0 {
+			left = append(left, violation)
+		}
+	}
+	for _, violation := range violations {
+		if violation.QuestionId != 0 && !failing[violation.QuestionId] {
+			left = append(left, violation)
+		}
+	}
+	return kept, left
+}
+
+func truncate(text string, max int) string {
+	if len(text) <= max {
+		return text
+	}
+	return text[:max] + "..."
+}
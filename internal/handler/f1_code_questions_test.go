@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/llmjson"
+	"darius/internal/sandbox"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockSandbox "runs" a solution by printing the sum of the numbers in the input, or their
+// difference when the solution contains a minus sign.
+type mockSandbox struct {
+	runs int
+}
+
+func (m *mockSandbox) Run(ctx context.Context, language, code string, tests []sandbox.TestCase) ([]sandbox.Result, error) {
+	m.runs++
+	if language != "python" {
+		return nil, sandbox.ErrUnsupportedLanguage
+	}
+	if strings.Contains(code, "syntax error") {
+		return nil, &sandbox.CompileError{Output: "SyntaxError: invalid syntax"}
+	}
+	results := make([]sandbox.Result, 0, len(tests))
+	for _, test := range tests {
+		var numbers []int
+		for _, field := range strings.Fields(test.Input) {
+			n, _ := strconv.Atoi(field)
+			numbers = append(numbers, n)
+		}
+		answer := numbers[0] + numbers[1]
+		if strings.Contains(code, "-") {
+			answer = numbers[0] - numbers[1]
+		}
+		output := strconv.Itoa(answer) + "\n"
+		results = append(results, sandbox.Result{Passed: strings.TrimSpace(output) == test.ExpectedOutput, Output: output})
+	}
+	return results, nil
+}
+
+func codeQuestionJSON(id int, solution string) string {
+	return `{"id": ` + strconv.Itoa(id) + `, "text": "Read two numbers and print their sum.", "type": "CODE", "topic": "Python", "level": "Junior", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "CODE", "code": {
+		"language": "python", "starterCode": "a, b = map(int, input().split())\n", "referenceSolution": "` + solution + `",
+		"testCases": [{"input": "1 2", "expectedOutput": "3"}, {"input": "5 5", "expectedOutput": "10", "hidden": true}, {"input": "0 7", "expectedOutput": "7", "hidden": true}]}}}`
+}
+
+func Test_generateExam_CodeQuestions(t *testing.T) {
+	llm := &mockLLMManager{responses: []string{
+		`{"questions": [` + codeQuestionJSON(1, "print(a - b)") + `]}`,
+		`{"questions": [` + codeQuestionJSON(1, "print(a + b)") + `]}`,
+	}}
+	runner := &mockSandbox{}
+	h := &handler{llmManager: llm, missfortune: mockMissfortune{}, sandbox: runner}
+
+	req := &suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeCode,
+		Topics:       []*suggest.Topic{{Name: "Python", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 1}}},
+	}
+	exam, err := h.generateExam(context.Background(), req, validation.ExamSpecFromRequest(req))
+	assert.NoError(t, err)
+	assert.Empty(t, exam.GetViolations())
+	assert.Len(t, llm.prompts, 2)
+	assert.Contains(t, llm.prompts[0], `"language" is "python"`)
+	assert.Contains(t, llm.prompts[1], `the reference solution fails 3 of its 3 test cases`)
+	assert.Contains(t, llm.prompts[1], "test 0: expected \"3\", got \"-1\\n\"")
+	assert.Equal(t, "print(a + b)", exam.GetQuestions()[0].GetDetail().GetCode().GetReferenceSolution())
+	assert.True(t, exam.GetQuestions()[0].GetCodeCheck().GetVerified())
+	// The final validation reuses the result of the repaired question.
+	assert.Equal(t, 2, runner.runs)
+}
+
+func Test_generateExam_DropsFailingCode(t *testing.T) {
+	llm := &mockLLMManager{responses: []string{
+		`{"questions": [` + codeQuestionJSON(1, "print(a - b)") + `, ` + strings.Replace(codeQuestionJSON(2, "print(a + b)"), "their sum", "the sum of both", 1) + `]}`,
+		`{"questions": [` + codeQuestionJSON(1, "print(a - b)") + `]}`,
+		`{"questions": [` + codeQuestionJSON(1, "print(b - a)") + `]}`,
+	}}
+	h := &handler{llmManager: llm, missfortune: mockMissfortune{}, sandbox: &mockSandbox{}}
+
+	req := &suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeCode,
+		Topics:       []*suggest.Topic{{Name: "Python", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+	}
+	exam, err := h.generateExam(context.Background(), req, validation.ExamSpecFromRequest(req))
+	assert.NoError(t, err)
+	assert.Len(t, llm.prompts, 1+maxRepairRounds)
+	// The question still failing after the repair is left out, and the exam reported short.
+	assert.Len(t, exam.GetQuestions(), 1)
+	assert.Equal(t, int32(2), exam.GetQuestions()[0].GetId())
+	assert.Equal(t, []*suggest.SuggestExamQuestionResponseV2_Violation{
+		{Field: "questions", Code: validation.CodeQuestionCount, Message: "expected 2 questions, got 1"},
+		{Field: "questions", Code: validation.CodeBreakdown, Message: "expected 2 questions on Python at the Junior level, got 1"},
+	}, exam.GetViolations())
+}
+
+func Test_codeChecker(t *testing.T) {
+	runner := &mockSandbox{}
+	checker := (&handler{sandbox: runner}).newCodeChecker()
+	parse := func(question string) *suggest.SuggestExamQuestionResponseV2_Quetion {
+		exam := &suggest.SuggestExamQuestionResponseV2{}
+		assert.NoError(t, llmjson.UnmarshalProto(`{"questions": [`+question+`]}`, exam))
+		return exam.GetQuestions()[0]
+	}
+
+	broken := parse(codeQuestionJSON(1, "syntax error"))
+	violations := checker.check(context.Background(), []*suggest.SuggestExamQuestionResponseV2_Quetion{broken, parse(codeQuestionJSON(2, "print(a + b)"))})
+	assert.Equal(t, []validation.Violation{{
+		QuestionId: 1,
+		Field:      "detail.code.referenceSolution",
+		Code:       validation.CodeFailingTests,
+		Message:    "the reference solution does not compile: SyntaxError: invalid syntax",
+	}}, violations)
+
+	assert.False(t, broken.GetCodeCheck().GetVerified())
+
+	// A language the sandbox can't run is reported unverified rather than passing.
+	other := parse(strings.Replace(codeQuestionJSON(3, "print(a - b)"), `"python"`, `"cobol"`, 1))
+	assert.Equal(t, []validation.Violation{{
+		QuestionId: 3,
+		Field:      "detail.code.referenceSolution",
+		Code:       validation.CodeUnverified,
+		Message:    "the reference solution could not be checked against the test cases: sandbox: unsupported language",
+	}}, checker.check(context.Background(), []*suggest.SuggestExamQuestionResponseV2_Quetion{other}))
+	assert.Equal(t, "sandbox: unsupported language", other.GetCodeCheck().GetReason())
+
+	// Without a sandbox nothing is run, and nothing is verified.
+	unrun := (&handler{}).newCodeChecker().check(context.Background(), []*suggest.SuggestExamQuestionResponseV2_Quetion{broken})
+	assert.Len(t, unrun, 1)
+	assert.Equal(t, validation.CodeUnverified, unrun[0].Code)
+	assert.Equal(t, 3, runner.runs)
+}
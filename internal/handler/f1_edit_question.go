@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/errors"
+	"darius/internal/llmjson"
+	"darius/internal/similarity"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const maxQuestionVariants = 10
+
+// RegenerateQuestion replaces one exam question with a new one on the same topic and level
+// that tests a different idea.
+func (h *handler) RegenerateQuestion(ctx context.Context, req *suggest.RegenerateQuestionRequest) (*suggest.QuestionEditResponse, error) {
+	if err := checkEditedQuestion(req.GetQuestion()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+
+	task := "Write one new question to replace the original: the same topic, level, question type and points, but testing a different idea. It must not repeat or paraphrase the original question."
+	references := append([]string{req.GetQuestion().GetText()}, req.GetContext().GetOtherQuestions()...)
+	questions, violations, err := h.editQuestion(ctx, constants.F1_REGENERATE_QUESTION, req.GetQuestion(), req.GetContext(), task, 1, req.GetRequestKey(), references)
+	if err != nil {
+		return nil, err
+	}
+	return &suggest.QuestionEditResponse{Question: questions[0], Violations: toProtoViolations(violations)}, nil
+}
+
+// RewriteQuestion rewrites one exam question following the instruction of the request.
+func (h *handler) RewriteQuestion(ctx context.Context, req *suggest.RewriteQuestionRequest) (*suggest.QuestionEditResponse, error) {
+	if err := checkEditedQuestion(req.GetQuestion()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	if strings.TrimSpace(req.GetInstruction()) == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("instruction is empty"), errors.ErrInvalidInput)
+	}
+
+	task := fmt.Sprintf("Rewrite the original question following this instruction: **%v**. Keep its question type, topic and everything else the instruction does not ask to change, and update the answer key so it matches the rewritten question.", req.GetInstruction())
+	questions, violations, err := h.editQuestion(ctx, constants.F1_REWRITE_QUESTION, req.GetQuestion(), req.GetContext(), task, 1, req.GetRequestKey(), req.GetContext().GetOtherQuestions())
+	if err != nil {
+		return nil, err
+	}
+	return &suggest.QuestionEditResponse{Question: questions[0], Violations: toProtoViolations(violations)}, nil
+}
+
+// VaryQuestion generates isomorphic variants of one exam question.
+func (h *handler) VaryQuestion(ctx context.Context, req *suggest.VaryQuestionRequest) (*suggest.SuggestExamQuestionResponseV2, error) {
+	if err := checkEditedQuestion(req.GetQuestion()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	count := int(req.GetCount())
+	if count < 1 || count > maxQuestionVariants {
+		return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("count %d is not between 1 and %d", count, maxQuestionVariants), errors.ErrInvalidInput)
+	}
+
+	task := fmt.Sprintf("Write %d isomorphic variants of the original question: each one tests exactly the same skill at the same difficulty, with the same question type and structure, but with different names, numbers, code or scenario, so that a candidate who has seen one variant gains nothing on another. No two variants may read alike and none may copy the original.", count)
+	questions, violations, err := h.editQuestion(ctx, constants.F1_VARY_QUESTION, req.GetQuestion(), req.GetContext(), task, count, req.GetRequestKey(), req.GetContext().GetOtherQuestions())
+	if err != nil {
+		return nil, err
+	}
+	return &suggest.SuggestExamQuestionResponseV2{Questions: questions, RequestKey: req.GetRequestKey(), Violations: toProtoViolations(violations)}, nil
+}
+
+// checkEditedQuestion rejects a question that can't be edited: one without text or with a
+// type that isn't one of the question types.
+func checkEditedQuestion(question *suggest.SuggestExamQuestionResponseV2_Quetion) error {
+	if strings.TrimSpace(question.GetText()) == "" {
+		return stdErrors.New("question has no text")
+	}
+	if !validation.IsQuestionType(question.GetType()) {
+		return fmt.Errorf("unknown question type %q", question.GetType())
+	}
+	return nil
+}
+
+// editQuestion charges the caller for entry and asks for count questions made from the
+// original one by task. The result goes through the same repair as generated exams and is
+// checked not to repeat any of the references. A single edited question keeps the id of
+// the original; every question keeps its testId.
+func (h *handler) editQuestion(ctx context.Context, entry string, original *suggest.SuggestExamQuestionResponseV2_Quetion, examContext *suggest.QuestionContext, task string, count int, requestKey string, references []string) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, []validation.Violation, error) {
+	chargeCode, err := h.checkCanCall(ctx, entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var generateOpts []llmManager.GenerateOption
+	if temperature, ok := creativityToTemperature(examContext.GetCreativity()); ok {
+		generateOpts = append(generateOpts, llmManager.WithTemperature(temperature))
+	}
+
+	spec := questionTypeSpec(original.GetType(), nil, examContext.GetCodeLanguage(), count)
+	spec.Language = examContext.GetLanguage()
+	prompt := questionEditPrompt(original, examContext, task, spec)
+
+	conversationId, llmResponse, err := h.llmManager.Generate(ctx, entry, prompt, requestKey, nil, generateOpts...)
+	if err != nil {
+		return nil, nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNetworkConnection)
+	}
+	edited := &suggest.SuggestExamQuestionResponseV2{}
+	if err := llmjson.UnmarshalProto(llmResponse, edited); err != nil {
+		log.Printf("[EditQuestion] error parsing %s response: %v", entry, err)
+		return nil, nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONParsing)
+	}
+	if count == 1 && len(edited.GetQuestions()) > 0 {
+		edited.GetQuestions()[0].Id = original.GetId()
+	}
+
+	questions, violations := h.repairExamQuestions(ctx, requestKey, conversationId, edited.GetQuestions(), spec, generateOpts...)
+	if len(questions) == 0 {
+		return nil, nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("no question generated"), errors.ErrLLMGeneration)
+	}
+	for _, question := range questions {
+		question.TestId = original.GetTestId()
+	}
+	violations = append(violations, h.repeatedQuestions(questions, references)...)
+
+	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+		log.Printf("[EditQuestion] Charge Code %s failed to charge for LLM call", chargeCode)
+		return nil, nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+	}
+	return questions, violations, nil
+}
+
+// repeatedQuestions flags the edited questions that repeat one of the references, the
+// other questions of the exam.
+func (h *handler) repeatedQuestions(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, references []string) []validation.Violation {
+	texts := make([]string, len(questions))
+	for i, question := range questions {
+		texts[i] = question.GetText()
+	}
+
+	var violations []validation.Violation
+	for _, duplicate := range similarity.Duplicates(texts, references, h.config.DuplicateThreshold) {
+		if !duplicate.Reference {
+			continue
+		}
+		violations = append(violations, validation.Violation{
+			QuestionId: questions[duplicate.Index].GetId(),
+			Field:      "text",
+			Code:       validation.CodeNearDuplicate,
+			Message:    fmt.Sprintf("question repeats an existing exam question: %q", references[duplicate.Of]),
+		})
+	}
+	return violations
+}
+
+func questionEditPrompt(original *suggest.SuggestExamQuestionResponseV2_Quetion, examContext *suggest.QuestionContext, task string, spec validation.ExamSpec) string {
+	var exam strings.Builder
+	if title := examContext.GetTitle(); title != "" {
+		fmt.Fprintf(&exam, "- Title: %v\n", title)
+	}
+	if description := examContext.GetDescription(); description != "" {
+		fmt.Fprintf(&exam, "- Description: %v\n", description)
+	}
+	if examContext.GetTopic() != "" || examContext.GetLevel() != "" {
+		fmt.Fprintf(&exam, "- Topic: **%v**, Level: **%v**\n", examContext.GetTopic(), examContext.GetLevel())
+	}
+	language := spec.Language
+	if language == "" {
+		language = "the language of the original question"
+	}
+	fmt.Fprintf(&exam, "- Every question is written in %v.\n", language)
+
+	others := ""
+	if len(examContext.GetOtherQuestions()) > 0 {
+		others = fmt.Sprintf("\nThese questions are already part of the exam. Do not repeat or paraphrase any of them:\n- %v\n", strings.Join(examContext.GetOtherQuestions(), "\n- "))
+	}
+
+	// The blind check of the key, the tag classification and the sandbox run are ours to
+	// make, they are not part of the question.
+	question := proto.Clone(original).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+	question.KeyCheck, question.TagCheck, question.CodeCheck = nil, nil, nil
+	questionJSON, _ := protojson.Marshal(question)
+
+	return fmt.Sprintf(`
+You are an expert exam question designer. You are editing a single question of an existing exam.
+
+📌 Exam:
+%v
+📥 Original question:
+%s
+
+✏️ Task:
+%v
+%v
+%v
+📤 Output Format:
+Return only a valid JSON object {"questions": [...]} containing exactly %v question(s) shaped like the original question. No notes, markdown, or trailing commas.
+`, exam.String(), questionJSON, task, others, questionTypeSection(spec), spec.QuestionCount)
+}
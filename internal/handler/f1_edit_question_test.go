@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const goKeywordExplanations = `"optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]`
+
+func editedQuestionJSON(id, text string, correct string) string {
+	return `{"id": ` + id + `, "text": "` + text + `", "type": "MCQ", "points": 2, "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": ` + correct + `, ` + goKeywordExplanations + `}}`
+}
+
+var editedQuestion = &suggest.SuggestExamQuestionResponseV2_Quetion{
+	Id:     7,
+	TestId: "test-1",
+	Text:   "Which keyword starts a goroutine?",
+	Type:   validation.QuestionTypeMCQ,
+	Points: 2,
+	Detail: &suggest.SuggestExamQuestionResponseV2_Detail{Type: validation.QuestionTypeMCQ, Options: []string{"go", "defer", "chan", "select"}},
+}
+
+var editContext = &suggest.QuestionContext{
+	Language:       "English",
+	Topic:          "Go",
+	Level:          "Junior",
+	OtherQuestions: []string{"Which statement waits on several channel operations?"},
+}
+
+func Test_RegenerateQuestion(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8}, `{"questions": [`+editedQuestionJSON("1", "Which keyword delays a call until the surrounding function returns?", "1")+`]}`)
+
+	resp, err := h.RegenerateQuestion(ctx, &suggest.RegenerateQuestionRequest{Question: editedQuestion, Context: editContext})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetViolations())
+	assert.Equal(t, int32(7), resp.GetQuestion().GetId())
+	assert.Equal(t, "test-1", resp.GetQuestion().GetTestId())
+	assert.Equal(t, int32(1), resp.GetQuestion().GetDetail().GetCorrectOption())
+
+	assert.Len(t, llm.prompts, 1)
+	assert.Contains(t, llm.prompts[0], "- Topic: **Go**, Level: **Junior**")
+	assert.Contains(t, llm.prompts[0], `"text":"Which keyword starts a goroutine?"`)
+	assert.Contains(t, llm.prompts[0], "Do not repeat or paraphrase any of them:\n- Which statement waits on several channel operations?")
+	assert.Contains(t, llm.prompts[0], "Generate all questions as MCQ questions.")
+}
+
+func Test_RegenerateQuestion_Repeated(t *testing.T) {
+	h, _, ctx := newTestHandler(Config{DuplicateThreshold: 0.8}, `{"questions": [`+editedQuestionJSON("1", "Which statement waits on several channel operations?", "3")+`]}`)
+
+	resp, err := h.RegenerateQuestion(ctx, &suggest.RegenerateQuestionRequest{Question: editedQuestion, Context: editContext})
+	assert.NoError(t, err)
+	assert.Equal(t, []*suggest.SuggestExamQuestionResponseV2_Violation{{
+		QuestionId: 7,
+		Field:      "text",
+		Code:       validation.CodeNearDuplicate,
+		Message:    `question repeats an existing exam question: "Which statement waits on several channel operations?"`,
+	}}, resp.GetViolations())
+}
+
+func Test_RewriteQuestion(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8}, `{"questions": [`+editedQuestionJSON("3", "Which Go keyword launches a function concurrently?", "0")+`]}`)
+
+	_, err := h.RewriteQuestion(ctx, &suggest.RewriteQuestionRequest{Question: editedQuestion, Context: editContext})
+	assert.Error(t, err)
+
+	resp, err := h.RewriteQuestion(ctx, &suggest.RewriteQuestionRequest{Question: editedQuestion, Context: editContext, Instruction: "make harder"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetViolations())
+	assert.Equal(t, int32(7), resp.GetQuestion().GetId())
+	assert.Contains(t, llm.prompts[0], "following this instruction: **make harder**")
+}
+
+func Test_VaryQuestion(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8}, `{"questions": [`+
+		editedQuestionJSON("1", "A worker pool needs each job to run concurrently. Which keyword do you put before the call?", "0")+`, `+
+		editedQuestionJSON("2", "Logging must not block the request handler. Which keyword makes the log call run on its own?", "0")+`]}`)
+
+	_, err := h.VaryQuestion(ctx, &suggest.VaryQuestionRequest{Question: editedQuestion, Context: editContext, Count: maxQuestionVariants + 1})
+	assert.Error(t, err)
+	_, err = h.VaryQuestion(ctx, &suggest.VaryQuestionRequest{Question: &suggest.SuggestExamQuestionResponseV2_Quetion{Text: "Why?", Type: "ESSAY"}, Count: 2})
+	assert.Error(t, err)
+
+	resp, err := h.VaryQuestion(ctx, &suggest.VaryQuestionRequest{Question: editedQuestion, Context: editContext, Count: 2})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetViolations())
+	assert.Len(t, resp.GetQuestions(), 2)
+	assert.Equal(t, []int32{1, 2}, []int32{resp.GetQuestions()[0].GetId(), resp.GetQuestions()[1].GetId()})
+	assert.Equal(t, "test-1", resp.GetQuestions()[1].GetTestId())
+	assert.Contains(t, llm.prompts[0], "Write 2 isomorphic variants")
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/errors"
+	"darius/internal/exporter"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// ExportExam writes an exam in a format learning management systems import, so trainers
+// don't have to copy the questions over by hand. Questions the format can't express are
+// left out of the file and reported as skipped.
+func (h *handler) ExportExam(ctx context.Context, req *suggest.ExportExamRequest) (*suggest.ExportExamResponse, error) {
+	format := strings.ToLower(strings.TrimSpace(req.GetFormat()))
+	if !exporter.Formats[format] {
+		return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("unknown export format %q", req.GetFormat()), errors.ErrInvalidInput)
+	}
+	questions := req.GetExam().GetQuestions()
+	if len(questions) == 0 {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("exam has no questions"), errors.ErrInvalidInput)
+	}
+
+	file, err := exporter.Export(format, strings.TrimSpace(req.GetTitle()), questions)
+	if err != nil {
+		log.Printf("[ExportExam] error exporting exam as %s: %v", format, err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrGeneral)
+	}
+
+	types := make(map[int32]string, len(questions))
+	for _, question := range questions {
+		types[question.GetId()] = question.GetType()
+	}
+	var skipped []validation.Violation
+	for _, id := range file.Skipped {
+		skipped = append(skipped, validation.Violation{
+			QuestionId: id,
+			Field:      "type",
+			Code:       validation.CodeUnsupportedType,
+			Message:    fmt.Sprintf("%s questions can't be exported as %s", types[id], format),
+		})
+	}
+
+	return &suggest.ExportExamResponse{
+		FileName:    file.Name,
+		ContentType: file.ContentType,
+		Content:     file.Content,
+		Skipped:     toProtoViolations(skipped),
+	}, nil
+}
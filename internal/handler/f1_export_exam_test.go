@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"darius/internal/errors"
+	"darius/internal/exporter"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ExportExam(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8})
+	exam := translationExam()
+	exam.Questions = append(exam.Questions, &suggest.SuggestExamQuestionResponseV2_Quetion{
+		Id:   3,
+		Text: "Order the steps of a graceful shutdown.",
+		Type: validation.QuestionTypeOrdering,
+		Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+			Type: validation.QuestionTypeOrdering,
+			Answer: &suggest.SuggestExamQuestionResponseV2_Detail_Ordering{Ordering: &suggest.SuggestExamQuestionResponseV2_OrderingDetail{
+				Items: []string{"Stop accepting requests", "Drain in-flight requests"},
+			}},
+		},
+	})
+
+	resp, err := h.ExportExam(ctx, &suggest.ExportExamRequest{Exam: exam, Format: " Aiken ", Title: "Go basics"})
+	assert.NoError(t, err)
+	assert.Empty(t, llm.prompts, "exporting doesn't call the LLM")
+	assert.Equal(t, "go-basics.aiken.txt", resp.GetFileName())
+	assert.True(t, strings.HasPrefix(string(resp.GetContent()), "Which keyword starts a goroutine?\nA. go\n"))
+	assert.Len(t, resp.GetSkipped(), 1)
+	assert.Equal(t, int32(3), resp.GetSkipped()[0].GetQuestionId())
+	assert.Equal(t, validation.CodeUnsupportedType, resp.GetSkipped()[0].GetCode())
+	assert.Equal(t, "ORDERING questions can't be exported as aiken", resp.GetSkipped()[0].GetMessage())
+
+	resp, err = h.ExportExam(ctx, &suggest.ExportExamRequest{Exam: exam, Format: exporter.FormatQTI})
+	assert.NoError(t, err)
+	assert.Equal(t, "exam.zip", resp.GetFileName())
+	assert.Equal(t, "application/zip", resp.GetContentType())
+	assert.Empty(t, resp.GetSkipped())
+
+	_, err = h.ExportExam(ctx, &suggest.ExportExamRequest{Exam: exam, Format: "docx"})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	_, err = h.ExportExam(ctx, &suggest.ExportExamRequest{Exam: &suggest.SuggestExamQuestionResponseV2{}, Format: exporter.FormatGIFT})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+}
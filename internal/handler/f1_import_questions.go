@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/errors"
+	"darius/internal/importer"
+	"darius/internal/similarity"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxPromptExemplars is how many exemplars the generation prompt shows.
+	maxPromptExemplars = 5
+	// maxPromptExclusions is how many excluded questions the generation prompt lists. The
+	// others are still checked against.
+	maxPromptExclusions = 50
+)
+
+// ImportQuestions reads the questions of a file written for a learning management system.
+// The questions can be saved to the question bank with SaveQuestions, or passed to exam
+// generation to have more questions generated like them, or none that overlap them.
+func (h *handler) ImportQuestions(ctx context.Context, req *suggest.ImportQuestionsRequest) (*suggest.ImportQuestionsResponse, error) {
+	format := strings.ToLower(strings.TrimSpace(req.GetFormat()))
+	if !importer.Formats[format] {
+		return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("unknown import format %q", req.GetFormat()), errors.ErrInvalidInput)
+	}
+	if strings.TrimSpace(req.GetContent()) == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("file is empty"), errors.ErrInvalidInput)
+	}
+
+	result, err := importer.Import(format, req.GetContent())
+	if err != nil {
+		log.Printf("[ImportQuestions] error reading %s file: %v", format, err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+
+	var violations []validation.Violation
+	for _, problem := range result.Problems {
+		violations = append(violations, validation.Violation{
+			Field:   "content",
+			Code:    validation.CodeUnreadable,
+			Message: fmt.Sprintf("question %d of the file was left out: %s", problem.Position, problem.Message),
+		})
+	}
+	return &suggest.ImportQuestionsResponse{
+		Questions:  result.Questions,
+		Violations: toProtoViolations(violations),
+	}, nil
+}
+
+// importedSection shows the exemplars of the request for the new questions to follow and
+// lists the questions they must not repeat. It is empty for requests without either.
+func importedSection(req *suggest.SuggestExamQuestionRequest) string {
+	var section strings.Builder
+	if exemplars := req.GetExemplars(); len(exemplars) > 0 {
+		section.WriteString(`
+🎨 Style Exemplars:
+The trainer already uses the questions below. Write the new questions in the same style: similar wording, length, tone and difficulty, and options built the same way. Do not copy or paraphrase them.
+`)
+		for _, exemplar := range exemplars[:min(len(exemplars), maxPromptExemplars)] {
+			shown := proto.Clone(exemplar).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+			shown.Id, shown.TestId, shown.KeyCheck, shown.TagCheck, shown.CodeCheck, shown.Citations = 0, "", nil, nil, nil, nil
+			content, err := protojson.Marshal(shown)
+			if err != nil {
+				log.Printf("[SuggestExamQuestion] error marshalling exemplar: %v", err)
+				continue
+			}
+			fmt.Fprintf(&section, "%s\n", content)
+		}
+	}
+	if excluded := req.GetExcludedQuestions(); len(excluded) > 0 {
+		section.WriteString(`
+🚫 Excluded Questions (Strict):
+These questions already exist. Do not repeat, paraphrase or overlap with any of them:
+`)
+		for _, question := range excluded[:min(len(excluded), maxPromptExclusions)] {
+			fmt.Fprintf(&section, "- %s\n", strings.Join(strings.Fields(question.GetText()), " "))
+		}
+	}
+	return section.String()
+}
+
+// exclusionChecker flags the questions that repeat one of the existing questions the exam
+// was asked not to, exemplars included.
+type exclusionChecker struct {
+	excluded  []string
+	threshold float64
+}
+
+// newExclusionChecker returns nil when the exam has no questions to avoid, or the duplicate
+// check is turned off.
+func (h *handler) newExclusionChecker(spec validation.ExamSpec) *exclusionChecker {
+	if len(spec.Excluded) == 0 || h.config.DuplicateThreshold <= 0 {
+		return nil
+	}
+	return &exclusionChecker{excluded: spec.Excluded, threshold: h.config.DuplicateThreshold}
+}
+
+func (c *exclusionChecker) check(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []validation.Violation {
+	if c == nil || len(questions) == 0 {
+		return nil
+	}
+	texts := make([]string, len(questions))
+	for i, question := range questions {
+		texts[i] = question.GetText()
+	}
+
+	var violations []validation.Violation
+	for _, duplicate := range similarity.Duplicates(texts, c.excluded, c.threshold) {
+		if !duplicate.Reference {
+			continue
+		}
+		violations = append(violations, validation.Violation{
+			QuestionId: questions[duplicate.Index].GetId(),
+			Field:      "text",
+			Code:       validation.CodeNearDuplicate,
+			Message:    fmt.Sprintf("question repeats an existing question it must not overlap with: %q", c.excluded[duplicate.Of]),
+		})
+	}
+	return violations
+}
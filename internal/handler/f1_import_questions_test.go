@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"darius/internal/errors"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const importedGIFT = `// points: 2
+::Q1::Which keyword starts a goroutine? {=go ~defer ~chan ~select}
+
+How many goroutines does a program start with? {#1}
+
+Explain when to use a mutex instead of a channel.{}
+`
+
+func Test_ImportQuestions(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8})
+
+	resp, err := h.ImportQuestions(ctx, &suggest.ImportQuestionsRequest{Format: " GIFT ", Content: importedGIFT})
+	assert.NoError(t, err)
+	assert.Empty(t, llm.prompts, "importing doesn't call the LLM")
+	assert.Len(t, resp.GetQuestions(), 2)
+	assert.Equal(t, int32(2), resp.GetQuestions()[0].GetPoints())
+	assert.Equal(t, []string{"go", "defer", "chan", "select"}, resp.GetQuestions()[0].GetDetail().GetOptions())
+	assert.Equal(t, int32(2), resp.GetQuestions()[1].GetId())
+	assert.Equal(t, validation.QuestionTypeLongAnswer, resp.GetQuestions()[1].GetType())
+
+	assert.Len(t, resp.GetViolations(), 1)
+	assert.Equal(t, validation.CodeUnreadable, resp.GetViolations()[0].GetCode())
+	assert.Equal(t, "question 2 of the file was left out: numerical questions can't be imported", resp.GetViolations()[0].GetMessage())
+
+	_, err = h.ImportQuestions(ctx, &suggest.ImportQuestionsRequest{Format: "qti", Content: importedGIFT})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	_, err = h.ImportQuestions(ctx, &suggest.ImportQuestionsRequest{Format: "csv", Content: " \n"})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	_, err = h.ImportQuestions(ctx, &suggest.ImportQuestionsRequest{Format: "csv", Content: "question,answer\nWhy?,Because\n"})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+}
+
+func Test_SuggestExamQuestionV2_ExemplarsAndExclusions(t *testing.T) {
+	// The first question repeats an excluded one, so it is repaired.
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8},
+		examResponse("Which keyword starts a goroutine?"),
+		examResponse("What happens when sending on a full buffered channel?"),
+	)
+	imported, err := h.ImportQuestions(ctx, &suggest.ImportQuestionsRequest{Format: "gift", Content: importedGIFT})
+	assert.NoError(t, err)
+
+	exam, err := h.SuggestExamQuestionV2(ctx, &suggest.SuggestExamQuestionRequest{
+		QuestionType:      validation.QuestionTypeMCQ,
+		Topics:            []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 1}}},
+		Exemplars:         imported.GetQuestions()[1:],
+		ExcludedQuestions: imported.GetQuestions()[:1],
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, exam.GetViolations())
+	assert.Equal(t, "What happens when sending on a full buffered channel?", exam.GetQuestions()[0].GetText())
+
+	assert.Len(t, llm.prompts, 2)
+	assert.Contains(t, llm.prompts[0], "🎨 Style Exemplars:")
+	assert.Contains(t, llm.prompts[0], "Explain when to use a mutex instead of a channel.")
+	assert.Contains(t, llm.prompts[0], "🚫 Excluded Questions (Strict):")
+	assert.Contains(t, llm.prompts[0], "\n- Which keyword starts a goroutine?\n")
+	assert.NotContains(t, llm.prompts[0], "exemplars:", "the request lists them in their own sections only")
+	assert.Contains(t, llm.prompts[1], `Question id 1: question repeats an existing question it must not overlap with: "Which keyword starts a goroutine?"`)
+}
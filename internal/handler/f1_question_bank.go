@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"context"
+	ctxdata "darius/ctx"
+	"darius/internal/errors"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultQuestionPageSize = 20
+	maxQuestionPageSize     = 100
+	maxQuestionTagLength    = 64
+)
+
+var questionStatuses = map[string]bool{
+	models.QuestionStatusDraft:    true,
+	models.QuestionStatusApproved: true,
+	models.QuestionStatusRejected: true,
+}
+
+// SaveQuestions stores questions in the question bank as drafts of the calling user.
+func (h *handler) SaveQuestions(ctx context.Context, req *suggest.SaveQuestionsRequest) (*suggest.SaveQuestionsResponse, error) {
+	if len(req.GetQuestions()) == 0 {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("no question to save"), errors.ErrInvalidInput)
+	}
+	authorId, _ := ctxdata.GetUserIdFromContext(ctx)
+	if authorId == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("user id is missing"), errors.ErrInvalidInput)
+	}
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+
+	rows := make([]models.Question, len(req.GetQuestions()))
+	for i, question := range req.GetQuestions() {
+		if err := checkEditedQuestion(question); err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("question %d: %w", question.GetId(), err), errors.ErrInvalidInput)
+		}
+		content, err := protojson.Marshal(question)
+		if err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONParsing)
+		}
+		rows[i] = models.Question{
+			Text:       question.GetText(),
+			Type:       question.GetType(),
+			Topic:      req.GetTopic(),
+			Level:      req.GetLevel(),
+			Language:   req.GetLanguage(),
+			Points:     question.GetPoints(),
+			Content:    string(content),
+			RequestKey: req.GetRequestKey(),
+			AuthorId:   authorId,
+			Status:     models.QuestionStatusDraft,
+		}
+		for _, tag := range tags {
+			rows[i].Tags = append(rows[i].Tags, models.QuestionTag{Tag: tag})
+		}
+	}
+
+	if err := h.questionBank.SaveQuestions(ctx, rows); err != nil {
+		log.Printf("[SaveQuestions] error saving questions: %v", err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrDatabaseConnection)
+	}
+
+	saved := make([]*suggest.BankQuestion, len(rows))
+	for i, row := range rows {
+		if saved[i], err = toBankQuestion(row); err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONUnmarshalling)
+		}
+	}
+	return &suggest.SaveQuestionsResponse{Questions: saved}, nil
+}
+
+// SearchQuestions returns one page of the bank questions matching the request. Reviewers
+// search the questions of every author, everyone else only their own.
+func (h *handler) SearchQuestions(ctx context.Context, req *suggest.SearchQuestionsRequest) (*suggest.SearchQuestionsResponse, error) {
+	userId, _ := ctxdata.GetUserIdFromContext(ctx)
+	if userId == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("user id is missing"), errors.ErrInvalidInput)
+	}
+	authorId := req.GetAuthorId()
+	if ctxdata.GetUserRoleFromContext(ctx) != ctxdata.RoleReviewer {
+		if authorId != "" && authorId != userId {
+			return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("user %q can only search their own questions", userId), errors.ErrInvalidInput)
+		}
+		authorId = userId
+	}
+	if status := req.GetStatus(); status != "" && !questionStatuses[status] {
+		return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("unknown status %q", status), errors.ErrInvalidInput)
+	}
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	page, pageSize := req.GetPage(), req.GetPageSize()
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultQuestionPageSize
+	}
+	if pageSize > maxQuestionPageSize {
+		pageSize = maxQuestionPageSize
+	}
+
+	rows, total, err := h.questionBank.SearchQuestions(ctx, models.QuestionFilter{
+		Query:    strings.TrimSpace(req.GetQuery()),
+		Topic:    req.GetTopic(),
+		Level:    req.GetLevel(),
+		Type:     req.GetType(),
+		Language: req.GetLanguage(),
+		Status:   req.GetStatus(),
+		AuthorId: authorId,
+		Tags:     tags,
+		Offset:   int(page-1) * int(pageSize),
+		Limit:    int(pageSize),
+	})
+	if err != nil {
+		log.Printf("[SearchQuestions] error searching questions: %v", err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrDatabaseConnection)
+	}
+
+	questions := make([]*suggest.BankQuestion, len(rows))
+	for i, row := range rows {
+		if questions[i], err = toBankQuestion(row); err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONUnmarshalling)
+		}
+	}
+	return &suggest.SearchQuestionsResponse{
+		Questions: questions,
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
+	}, nil
+}
+
+// UpdateQuestionStatus moves a bank question to another review status. Only its author and
+// reviewers may; to anyone else the question is not found.
+func (h *handler) UpdateQuestionStatus(ctx context.Context, req *suggest.UpdateQuestionStatusRequest) (*suggest.BankQuestion, error) {
+	if req.GetId() == 0 || !questionStatuses[req.GetStatus()] {
+		return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("invalid id %d or status %q", req.GetId(), req.GetStatus()), errors.ErrInvalidInput)
+	}
+	userId, _ := ctxdata.GetUserIdFromContext(ctx)
+	if userId == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("user id is missing"), errors.ErrInvalidInput)
+	}
+	if ctxdata.GetUserRoleFromContext(ctx) != ctxdata.RoleReviewer {
+		row, err := h.questionBank.GetQuestion(ctx, uint(req.GetId()))
+		if err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, err.Error())
+		}
+		if row.AuthorId != userId {
+			log.Printf("[UpdateQuestionStatus] user %q asked for question %d of another user", userId, row.ID)
+			return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("question %d not found", row.ID), errors.ErrNotFound)
+		}
+	}
+
+	row, err := h.questionBank.UpdateQuestionStatus(ctx, uint(req.GetId()), req.GetStatus())
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, err.Error())
+	}
+	question, err := toBankQuestion(*row)
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONUnmarshalling)
+	}
+	return question, nil
+}
+
+// normalizeTags lowercases and trims the tags and drops empty and repeated ones.
+func normalizeTags(tags []string) ([]string, error) {
+	var normalized []string
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(tag) > maxQuestionTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxQuestionTagLength)
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+func toBankQuestion(row models.Question) (*suggest.BankQuestion, error) {
+	question := &suggest.SuggestExamQuestionResponseV2_Quetion{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(row.Content), question); err != nil {
+		log.Printf("[QuestionBank] error parsing question %d: %v", row.ID, err)
+		return nil, err
+	}
+	tags := make([]string, len(row.Tags))
+	for i, tag := range row.Tags {
+		tags[i] = tag.Tag
+	}
+	return &suggest.BankQuestion{
+		Id:         uint64(row.ID),
+		Question:   question,
+		Topic:      row.Topic,
+		Level:      row.Level,
+		Language:   row.Language,
+		Tags:       tags,
+		RequestKey: row.RequestKey,
+		AuthorId:   row.AuthorId,
+		Status:     row.Status,
+		CreatedAt:  timestamppb.New(row.CreatedAt),
+		UpdatedAt:  timestamppb.New(row.UpdatedAt),
+	}, nil
+}
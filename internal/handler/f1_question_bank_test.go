@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"context"
+	ctxdata "darius/ctx"
+	"darius/internal/errors"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockQuestionBank keeps the questions in memory and filters them by metadata, tags and
+// exposure.
+type mockQuestionBank struct {
+	questions []models.Question
+	filter    models.QuestionFilter
+	seen      map[string][]uint
+}
+
+func (m *mockQuestionBank) SaveQuestions(ctx context.Context, questions []models.Question) error {
+	for i := range questions {
+		questions[i].ID = uint(len(m.questions) + 1)
+		m.questions = append(m.questions, questions[i])
+	}
+	return nil
+}
+
+func (m *mockQuestionBank) SearchQuestions(ctx context.Context, filter models.QuestionFilter) ([]models.Question, int64, error) {
+	m.filter = filter
+	var matching []models.Question
+	for _, question := range m.questions {
+		tags := make(map[string]bool)
+		for _, tag := range question.Tags {
+			tags[tag.Tag] = true
+		}
+		ok := (filter.Status == "" || question.Status == filter.Status) &&
+			(filter.Topic == "" || question.Topic == filter.Topic) &&
+			(filter.Level == "" || question.Level == filter.Level) &&
+			(filter.Type == "" || question.Type == filter.Type) &&
+			(filter.AuthorId == "" || question.AuthorId == filter.AuthorId)
+		for _, id := range m.seen[filter.UnseenBy] {
+			ok = ok && id != question.ID
+		}
+		for _, tag := range filter.Tags {
+			ok = ok && tags[tag]
+		}
+		if ok {
+			matching = append(matching, question)
+		}
+	}
+	total := int64(len(matching))
+	if filter.Offset >= len(matching) {
+		return nil, total, nil
+	}
+	matching = matching[filter.Offset:]
+	if len(matching) > filter.Limit {
+		matching = matching[:filter.Limit]
+	}
+	return matching, total, nil
+}
+
+func (m *mockQuestionBank) GetQuestion(ctx context.Context, id uint) (*models.Question, error) {
+	for i := range m.questions {
+		if m.questions[i].ID == id {
+			return &m.questions[i], nil
+		}
+	}
+	return nil, errors.Error(errors.ErrNotFound)
+}
+
+func (m *mockQuestionBank) UpdateQuestionStatus(ctx context.Context, id uint, status string) (*models.Question, error) {
+	for i := range m.questions {
+		if m.questions[i].ID == id {
+			m.questions[i].Status = status
+			return &m.questions[i], nil
+		}
+	}
+	return nil, errors.Error(errors.ErrNotFound)
+}
+
+func (m *mockQuestionBank) RecordExposures(ctx context.Context, candidatePool string, ids []uint) error {
+	if m.seen == nil {
+		m.seen = make(map[string][]uint)
+	}
+	m.seen[candidatePool] = append(m.seen[candidatePool], ids...)
+	return nil
+}
+
+func Test_QuestionBank(t *testing.T) {
+	bank := &mockQuestionBank{}
+	h := &handler{questionBank: bank}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "123"))
+
+	saved, err := h.SaveQuestions(ctx, &suggest.SaveQuestionsRequest{
+		Questions:  []*suggest.SuggestExamQuestionResponseV2_Quetion{editedQuestion, {Id: 8, Text: "Explain channels.", Type: "LONG_ANSWER", Points: 3}},
+		Topic:      "Go",
+		Level:      "Junior",
+		Language:   "English",
+		Tags:       []string{" Concurrency ", "go", "GO", ""},
+		RequestKey: "req-1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, saved.GetQuestions(), 2)
+	first := saved.GetQuestions()[0]
+	assert.Equal(t, uint64(1), first.GetId())
+	assert.Equal(t, []string{"concurrency", "go"}, first.GetTags())
+	assert.Equal(t, models.QuestionStatusDraft, first.GetStatus())
+	assert.Equal(t, "123", first.GetAuthorId())
+	assert.Equal(t, "req-1", first.GetRequestKey())
+	assert.Equal(t, editedQuestion.GetDetail().GetOptions(), first.GetQuestion().GetDetail().GetOptions())
+	assert.Equal(t, "MCQ", bank.questions[0].Type)
+	assert.Equal(t, int32(3), bank.questions[1].Points)
+
+	approved, err := h.UpdateQuestionStatus(ctx, &suggest.UpdateQuestionStatusRequest{Id: 2, Status: models.QuestionStatusApproved})
+	assert.NoError(t, err)
+	assert.Equal(t, models.QuestionStatusApproved, approved.GetStatus())
+	assert.Equal(t, "Explain channels.", approved.GetQuestion().GetText())
+
+	found, err := h.SearchQuestions(ctx, &suggest.SearchQuestionsRequest{Query: " channels ", Status: models.QuestionStatusApproved, Tags: []string{"Go"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), found.GetTotal())
+	assert.Equal(t, uint64(2), found.GetQuestions()[0].GetId())
+	assert.Equal(t, int32(1), found.GetPage())
+	assert.Equal(t, int32(defaultQuestionPageSize), found.GetPageSize())
+	assert.Equal(t, "channels", bank.filter.Query)
+	assert.Equal(t, []string{"go"}, bank.filter.Tags)
+	assert.Equal(t, "123", bank.filter.AuthorId)
+
+	paged, err := h.SearchQuestions(ctx, &suggest.SearchQuestionsRequest{Page: 2, PageSize: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), paged.GetTotal())
+	assert.Len(t, paged.GetQuestions(), 1)
+	assert.Equal(t, uint64(2), paged.GetQuestions()[0].GetId())
+	assert.Equal(t, 1, bank.filter.Offset)
+
+	_, err = h.SearchQuestions(ctx, &suggest.SearchQuestionsRequest{PageSize: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, maxQuestionPageSize, bank.filter.Limit)
+}
+
+func Test_QuestionBank_InvalidInput(t *testing.T) {
+	bank := &mockQuestionBank{}
+	h := &handler{questionBank: bank}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "123"))
+
+	_, err := h.SaveQuestions(context.Background(), &suggest.SaveQuestionsRequest{Questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{editedQuestion}})
+	assert.Error(t, err, "the author is required")
+	_, err = h.SaveQuestions(ctx, &suggest.SaveQuestionsRequest{Questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{{Text: "What?", Type: "ESSAY"}}})
+	assert.Error(t, err)
+	_, err = h.SearchQuestions(ctx, &suggest.SearchQuestionsRequest{Status: "published"})
+	assert.Error(t, err)
+	_, err = h.UpdateQuestionStatus(ctx, &suggest.UpdateQuestionStatusRequest{Id: 1, Status: "published"})
+	assert.Error(t, err)
+	_, err = h.UpdateQuestionStatus(ctx, &suggest.UpdateQuestionStatusRequest{Id: 9, Status: models.QuestionStatusApproved})
+	assert.EqualError(t, err, errors.ErrNotFound)
+	assert.Empty(t, bank.questions)
+}
+
+func Test_QuestionBank_OtherAuthors(t *testing.T) {
+	bank := &mockQuestionBank{questions: []models.Question{{ID: 1, Text: "Explain channels.", Content: "{}", AuthorId: "123", Status: models.QuestionStatusDraft}}}
+	h := &handler{questionBank: bank}
+	other := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "456"))
+	reviewer := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "456", "x-user-role", ctxdata.RoleReviewer))
+
+	// Someone else's question is not found, and stays a draft.
+	_, err := h.UpdateQuestionStatus(other, &suggest.UpdateQuestionStatusRequest{Id: 1, Status: models.QuestionStatusApproved})
+	assert.EqualError(t, err, errors.ErrNotFound)
+	assert.Equal(t, models.QuestionStatusDraft, bank.questions[0].Status)
+
+	found, err := h.SearchQuestions(other, &suggest.SearchQuestionsRequest{})
+	assert.NoError(t, err)
+	assert.Zero(t, found.GetTotal())
+	assert.Equal(t, "456", bank.filter.AuthorId)
+	_, err = h.SearchQuestions(other, &suggest.SearchQuestionsRequest{AuthorId: "123"})
+	assert.Error(t, err)
+
+	// Reviewers see and review every author's questions.
+	found, err = h.SearchQuestions(reviewer, &suggest.SearchQuestionsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), found.GetTotal())
+	found, err = h.SearchQuestions(reviewer, &suggest.SearchQuestionsRequest{AuthorId: "123"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), found.GetTotal())
+	approved, err := h.UpdateQuestionStatus(reviewer, &suggest.UpdateQuestionStatusRequest{Id: 1, Status: models.QuestionStatusApproved})
+	assert.NoError(t, err)
+	assert.Equal(t, models.QuestionStatusApproved, approved.GetStatus())
+
+	_, err = h.SearchQuestions(context.Background(), &suggest.SearchQuestionsRequest{})
+	assert.Error(t, err, "the caller is required")
+}
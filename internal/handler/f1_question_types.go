@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"darius/internal/validation"
+	"fmt"
+	"strings"
+)
+
+// questionTypeRules describes how each question type is written and what its "detail"
+// looks like in the output JSON.
+var questionTypeRules = map[string]string{
+	validation.QuestionTypeMCQ: `**MCQ** (multiple choice, one correct answer): exactly 4 distinct, plausible options; "correctOption" is the index (0–3) of the correct one. "optionExplanations" has one entry per option, in the same order: "explanation" says why that option is correct or wrong, and every wrong option has a short "misconception" tag naming the mistake that makes it tempting (leave it empty for the correct option).
+  "detail": {"type": "MCQ", "options": ["A", "B", "C", "D"], "correctOption": 2, "optionExplanations": [{"explanation": "Why A is wrong", "misconception": "off-by-one"}, {"explanation": "Why B is wrong", "misconception": "confuses-stack-and-queue"}, {"explanation": "Why C is correct", "misconception": ""}, {"explanation": "Why D is wrong", "misconception": "ignores-nil-case"}]}`,
+	validation.QuestionTypeLongAnswer: `**LONG_ANSWER** (essay): requires reasoning, explanation or comparison; "correctAnswer" is a complete expected answer, "imageLinks" may be empty.
+  "detail": {"type": "LONG_ANSWER", "imageLinks": [], "extraText": "Instructions here", "correctAnswer": "Expected answer here"}`,
+	validation.QuestionTypeTrueFalse: `**TRUE_FALSE**: the question text is a single statement that is clearly true or clearly false; "correctAnswer" says which.
+  "detail": {"type": "TRUE_FALSE", "trueFalse": {"correctAnswer": false}}`,
+	validation.QuestionTypeMultiSelect: `**MULTI_SELECT** (several correct answers): at least 4 distinct options, "correctOptions" lists the index of every correct one; the text says to select all that apply.
+  "detail": {"type": "MULTI_SELECT", "multiSelect": {"options": ["A", "B", "C", "D", "E"], "correctOptions": [0, 3]}}`,
+	validation.QuestionTypeFillInBlank: `**FILL_IN_BLANK**: the question text contains exactly one blank written as ___ ; "acceptedAnswers" lists every answer that fills it correctly.
+  "detail": {"type": "FILL_IN_BLANK", "fillInBlank": {"acceptedAnswers": ["answer", "alternative spelling"], "caseSensitive": false}}`,
+	validation.QuestionTypeMatching: `**MATCHING**: at least 3 pairs to match; no left side or right side appears twice; give the pairs matched correctly, they are shuffled for candidates.
+  "detail": {"type": "MATCHING", "matching": {"pairs": [{"left": "Term", "right": "Its definition"}, ...]}}`,
+	validation.QuestionTypeOrdering: `**ORDERING**: at least 3 distinct items to put in order; give the items in their correct order, they are shuffled for candidates.
+  "detail": {"type": "ORDERING", "ordering": {"items": ["First step", "Second step", "Third step"]}}`,
+	validation.QuestionTypeCode: `**CODE** (programming task): the question text is the full problem statement, including the input and output format. The program reads its input from stdin and prints its answer to stdout. Give starter code for candidates, a complete reference solution, and test cases: at least 1 visible example and at least 2 hidden ones covering edge cases. Every "expectedOutput" must be exactly what the reference solution prints for that "input"; solutions are run against their tests and rejected if any fails.
+  "detail": {"type": "CODE", "code": {"language": "python", "starterCode": "def solve():\n    pass\n", "referenceSolution": "...", "testCases": [{"input": "1 2\n", "expectedOutput": "3\n", "hidden": false}, {"input": "-5 5\n", "expectedOutput": "0\n", "hidden": true}, ...]}}`,
+}
+
+// tagRules asks for the cognitive level and solve time of every question.
+const tagRules = `
+Every question is also tagged with:
+- "bloomLevel": the level of Bloom's taxonomy the question tests, one of REMEMBER (recall facts and terms), UNDERSTAND (explain ideas or concepts), APPLY (use knowledge in a new, concrete situation), ANALYZE (break a problem into parts and relate them), EVALUATE (judge and justify a choice) or CREATE (design or produce something new). Tag the thinking the question really requires, not the topic's difficulty.
+- "estimatedMinutes": about how many minutes a prepared candidate needs to answer it, e.g. 1.5.
+`
+
+// questionTypeSection tells the model which question types to write, how many of each
+// when the spec has type counts, and the rules and JSON shape of every one of them. It also
+// asks for the tags of every question, and how many of each Bloom level when the spec has
+// Bloom counts.
+func questionTypeSection(spec validation.ExamSpec) string {
+	types := spec.AllowedTypes()
+
+	var section strings.Builder
+	section.WriteString("📌 Question Types:\n")
+	switch {
+	case len(spec.TypeCounts) > 0:
+		section.WriteString("Generate exactly this many questions of each type:\n")
+		for _, questionType := range types {
+			fmt.Fprintf(&section, "- %v: **%v**\n", questionType, spec.TypeCounts[questionType])
+		}
+	case len(types) == 1:
+		fmt.Fprintf(&section, "Generate all questions as %v questions.\n", types[0])
+	default:
+		fmt.Fprintf(&section, "Generate a balanced mix of %v questions.\n", strings.Join(types, " and "))
+	}
+
+	section.WriteString("\nEvery question sets \"type\" and \"detail.type\" to its type and follows the rules of that type:\n")
+	for _, questionType := range types {
+		fmt.Fprintf(&section, "- %v\n", questionTypeRules[questionType])
+	}
+	if language := spec.CodeLanguage; language != "" {
+		fmt.Fprintf(&section, "\nCODE questions are written in **%v**: \"language\" is %q and the starter code and reference solution are %v programs.\n", language, language, language)
+	}
+
+	section.WriteString(tagRules)
+	if len(spec.BloomCounts) > 0 {
+		section.WriteString("Write exactly this many questions at each Bloom level, across all question types:\n")
+		for _, level := range validation.BloomLevels {
+			if n := spec.BloomCounts[level]; n > 0 {
+				fmt.Fprintf(&section, "- %v: **%v**\n", level, n)
+			}
+		}
+	}
+	return section.String()
+}
+
+// questionTypeSpec reads the question type, ratio and code language of a request into a
+// spec for questionTypeSection.
+func questionTypeSpec(questionType string, ratio map[string]int32, codeLanguage string, count int) validation.ExamSpec {
+	spec := validation.ExamSpec{QuestionCount: count, QuestionType: questionType}
+	if questionType == "" || questionType == validation.QuestionTypeMixed {
+		spec.TypeCounts = validation.TypeCounts(ratio, count)
+	}
+	if spec.Allows(validation.QuestionTypeCode) {
+		spec.CodeLanguage = codeLanguage
+		if spec.CodeLanguage == "" {
+			spec.CodeLanguage = validation.DefaultCodeLanguage
+		}
+	}
+	return spec
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_questionTypeSection(t *testing.T) {
+	section := questionTypeSection(questionTypeSpec("MIXED", map[string]int32{"MCQ": 3, "TRUE_FALSE": 1}, "", 8))
+	assert.Contains(t, section, "- MCQ: **6**\n- TRUE_FALSE: **2**\n")
+	assert.Contains(t, section, `"trueFalse": {"correctAnswer": false}`)
+	assert.Contains(t, section, `"optionExplanations": [{"explanation": "Why A is wrong", "misconception": "off-by-one"}`)
+	assert.NotContains(t, section, "LONG_ANSWER")
+
+	section = questionTypeSection(questionTypeSpec("ORDERING", nil, "", 5))
+	assert.Contains(t, section, "Generate all questions as ORDERING questions.")
+	assert.NotContains(t, section, "**MCQ**")
+
+	section = questionTypeSection(questionTypeSpec("MIXED", nil, "", 5))
+	assert.Contains(t, section, "balanced mix of MCQ and LONG_ANSWER questions")
+}
+
+func Test_generateExam_TypedDetails(t *testing.T) {
+	llm := &mockLLMManager{responses: []string{"```json\n" + `{"questions": [
+  {"id": 1, "text": "Which keyword starts a goroutine?", "type": "MCQ", "topic": "Go", "level": "Junior", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": 0, "optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]}},
+  {"id": 2, "text": "A nil map can be read from in Go.", "type": "TRUE_FALSE", "topic": "Go", "level": "Junior", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "TRUE_FALSE", "trueFalse": {"correctAnswer": true}}},
+  {"id": 3, "text": "Put the steps of a TCP handshake in order.", "type": "ORDERING", "topic": "Go", "level": "Junior", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "ORDERING", "ordering": {"items": ["SYN", "SYN-ACK", "ACK"]}}},
+]}` + "\n```"}}
+	h := &handler{llmManager: llm, missfortune: mockMissfortune{}}
+
+	req := &suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeMixed,
+		TypeRatio:    map[string]int32{"MCQ": 1, "TRUE_FALSE": 1, "ORDERING": 1},
+		Topics:       []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 3}}},
+	}
+	exam, err := h.generateExam(context.Background(), req, validation.ExamSpecFromRequest(req))
+	assert.NoError(t, err)
+	assert.Empty(t, exam.GetViolations())
+	assert.Len(t, llm.prompts, 1)
+	assert.Contains(t, llm.prompts[0], "- ORDERING: **1**")
+
+	assert.True(t, exam.GetQuestions()[1].GetDetail().GetTrueFalse().GetCorrectAnswer())
+	assert.Equal(t, []string{"SYN", "SYN-ACK", "ACK"}, exam.GetQuestions()[2].GetDetail().GetOrdering().GetItems())
+}
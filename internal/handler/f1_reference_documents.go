@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	ctxdata "darius/ctx"
+	"darius/internal/errors"
+	"darius/internal/retrieval"
+	databaseService "darius/internal/services/repo"
+	"darius/internal/validation"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// UploadDocument splits course material into passages and stores them, so exams can be
+// generated from the material by listing the document in their documentIds.
+func (h *handler) UploadDocument(ctx context.Context, req *suggest.UploadDocumentRequest) (*suggest.UploadDocumentResponse, error) {
+	authorId, _ := ctxdata.GetUserIdFromContext(ctx)
+	if authorId == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("user id is missing"), errors.ErrInvalidInput)
+	}
+	format := strings.ToLower(strings.TrimSpace(req.GetFormat()))
+	if !retrieval.Formats[format] {
+		return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("unknown document format %q", req.GetFormat()), errors.ErrInvalidInput)
+	}
+
+	config := h.config.References
+	chunks, err := retrieval.Split(format, req.GetContent(), config.ChunkWords, config.ChunkOverlap)
+	if err != nil {
+		log.Printf("[UploadDocument] error splitting document: %v", err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrGeneral)
+	}
+	if len(chunks) == 0 {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("document has no text"), errors.ErrInvalidInput)
+	}
+
+	document := &models.Document{
+		Title:    strings.TrimSpace(req.GetTitle()),
+		Format:   format,
+		AuthorId: authorId,
+		Chunks:   make([]models.DocumentChunk, len(chunks)),
+	}
+	for i, chunk := range chunks {
+		document.Chunks[i] = models.DocumentChunk{Position: i, Section: chunk.Section, Page: chunk.Page, Text: chunk.Text}
+	}
+	if err := h.documents.SaveDocument(ctx, document); err != nil {
+		log.Printf("[UploadDocument] error saving document: %v", err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrDatabaseConnection)
+	}
+
+	return &suggest.UploadDocumentResponse{
+		DocumentId: uint64(document.ID),
+		Title:      document.Title,
+		ChunkCount: int32(len(document.Chunks)),
+	}, nil
+}
+
+// checkDocuments makes sure the documents an exam is grounded in exist and were uploaded by
+// the caller. Someone else's documents are reported as not found.
+func (h *handler) checkDocuments(ctx context.Context, documentIds []uint64) error {
+	if len(documentIds) == 0 {
+		return nil
+	}
+	documents, err := h.documents.GetDocuments(ctx, toUintIds(documentIds))
+	if err != nil {
+		log.Printf("[SuggestExamQuestion] error getting documents %v: %v", documentIds, err)
+		if err.Error() == errors.ErrNotFound {
+			return h.handleErrorWithStatusCode(ctx, err, errors.ErrNotFound)
+		}
+		return h.handleErrorWithStatusCode(ctx, err, errors.ErrDatabaseConnection)
+	}
+	userId, _ := ctxdata.GetUserIdFromContext(ctx)
+	for _, document := range documents {
+		if userId == "" || document.AuthorId != userId {
+			log.Printf("[SuggestExamQuestion] user %q asked for document %d of another user", userId, document.ID)
+			return h.handleErrorWithStatusCode(ctx, fmt.Errorf("document %d not found", document.ID), errors.ErrNotFound)
+		}
+	}
+	return nil
+}
+
+// referenceSection retrieves the passages of the request's documents that are most
+// relevant to each of its topics, and asks for questions based on them that cite them. It
+// is empty for requests without documents. The documents must have passed checkDocuments.
+func (h *handler) referenceSection(ctx context.Context, req *suggest.SuggestExamQuestionRequest) string {
+	if len(req.GetDocumentIds()) == 0 {
+		return ""
+	}
+	var queries []string
+	for _, topic := range req.GetTopics() {
+		queries = append(queries, topic.GetName())
+	}
+	if len(queries) == 0 {
+		queries = append(queries, req.GetTitle()+" "+req.GetDescription())
+	}
+
+	var passages []models.DocumentChunk
+	seen := make(map[uint]bool)
+	for _, query := range queries {
+		chunks, err := h.documents.SearchChunks(ctx, toUintIds(req.GetDocumentIds()), query, h.config.References.PassagesPerTopic)
+		if err != nil {
+			log.Printf("[SuggestExamQuestion] error retrieving passages for %q: %v", query, err)
+			continue
+		}
+		for _, chunk := range chunks {
+			if !seen[chunk.ID] {
+				seen[chunk.ID] = true
+				passages = append(passages, chunk)
+			}
+		}
+	}
+	if len(passages) == 0 {
+		return ""
+	}
+
+	var section strings.Builder
+	section.WriteString(`
+📚 Reference Material (Strict):
+The exam is built from the trainer's own course material. Base every question on the passages below and do not ask about anything they do not cover. List the passages each question relies on in "citations", with the "chunkId" of the passage and a short "quote" copied word for word from it:
+"citations": [{"chunkId": 12, "quote": "A buffered channel only blocks when it is full."}]
+`)
+	for _, passage := range passages {
+		fmt.Fprintf(&section, "\n[chunkId %d]", passage.ID)
+		if passage.Section != "" {
+			fmt.Fprintf(&section, " %s", passage.Section)
+		}
+		if passage.Page > 0 {
+			fmt.Fprintf(&section, " (page %d)", passage.Page)
+		}
+		fmt.Fprintf(&section, "\n%s\n", passage.Text)
+	}
+	return section.String()
+}
+
+// citationChecker makes sure every question of an exam grounded in documents cites one of
+// their passages, quoting it faithfully. Citations that don't hold up are dropped, the
+// others are completed with where the passage comes from.
+type citationChecker struct {
+	documents   databaseService.DocumentService
+	documentIds map[uint64]bool
+}
+
+// newCitationChecker returns nil when the exam is not grounded in documents.
+func (h *handler) newCitationChecker(spec validation.ExamSpec) *citationChecker {
+	if len(spec.DocumentIds) == 0 {
+		return nil
+	}
+	checker := &citationChecker{documents: h.documents, documentIds: make(map[uint64]bool, len(spec.DocumentIds))}
+	for _, id := range spec.DocumentIds {
+		checker.documentIds[id] = true
+	}
+	return checker
+}
+
+// check reports a citation violation for every question left without a valid citation.
+// When the passages can't be looked up the questions are left as they are.
+func (c *citationChecker) check(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []validation.Violation {
+	if c == nil || len(questions) == 0 {
+		return nil
+	}
+	var chunkIds []uint
+	for _, question := range questions {
+		for _, citation := range question.GetCitations() {
+			chunkIds = append(chunkIds, uint(citation.GetChunkId()))
+		}
+	}
+	chunks, err := c.documents.GetChunks(ctx, chunkIds)
+	if err != nil {
+		log.Printf("[SuggestExamQuestion] error getting cited passages: %v", err)
+		return nil
+	}
+	passages := make(map[uint64]models.DocumentChunk, len(chunks))
+	for _, chunk := range chunks {
+		if c.documentIds[uint64(chunk.DocumentID)] {
+			passages[uint64(chunk.ID)] = chunk
+		}
+	}
+
+	var violations []validation.Violation
+	for _, question := range questions {
+		var citations []*suggest.SuggestExamQuestionResponseV2_Citation
+		for _, citation := range question.GetCitations() {
+			passage, ok := passages[citation.GetChunkId()]
+			if !ok || !containsQuote(passage.Text, citation.GetQuote()) {
+				continue
+			}
+			citation.DocumentId = uint64(passage.DocumentID)
+			citation.Section = passage.Section
+			citation.Page = int32(passage.Page)
+			citations = append(citations, citation)
+		}
+		question.Citations = citations
+		if len(citations) == 0 {
+			violations = append(violations, validation.Violation{
+				QuestionId: question.GetId(),
+				Field:      "citations",
+				Code:       validation.CodeCitation,
+				Message:    "cite at least one of the reference passages by its chunkId, quoting it word for word",
+			})
+		}
+	}
+	return violations
+}
+
+// containsQuote reports whether the quote is part of the passage, ignoring case and
+// spacing. An empty quote proves nothing and is rejected.
+func containsQuote(passage, quote string) bool {
+	normalize := func(text string) string {
+		return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	}
+	quote = strings.Trim(normalize(quote), `"'“”.… `)
+	return quote != "" && strings.Contains(normalize(passage), quote)
+}
+
+func toUintIds(ids []uint64) []uint {
+	converted := make([]uint, len(ids))
+	for i, id := range ids {
+		converted[i] = uint(id)
+	}
+	return converted
+}
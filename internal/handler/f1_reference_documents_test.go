@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/errors"
+	"darius/internal/retrieval"
+	"darius/internal/validation"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockDocuments keeps the documents in memory and ranks their chunks like the real store.
+type mockDocuments struct {
+	documents []models.Document
+}
+
+func (m *mockDocuments) SaveDocument(ctx context.Context, document *models.Document) error {
+	document.ID = uint(len(m.documents) + 1)
+	for i := range document.Chunks {
+		document.Chunks[i].ID = uint(len(m.chunks()) + i + 1)
+		document.Chunks[i].DocumentID = document.ID
+	}
+	m.documents = append(m.documents, *document)
+	return nil
+}
+
+func (m *mockDocuments) GetDocuments(ctx context.Context, ids []uint) ([]models.Document, error) {
+	var found []models.Document
+	for _, id := range ids {
+		if int(id) > len(m.documents) || id == 0 {
+			return nil, errors.Error(errors.ErrNotFound)
+		}
+		found = append(found, m.documents[id-1])
+	}
+	return found, nil
+}
+
+func (m *mockDocuments) SearchChunks(ctx context.Context, documentIds []uint, query string, limit int) ([]models.DocumentChunk, error) {
+	var chunks []models.DocumentChunk
+	var texts []string
+	for _, id := range documentIds {
+		for _, chunk := range m.documents[id-1].Chunks {
+			chunks = append(chunks, chunk)
+			texts = append(texts, chunk.Section+"\n"+chunk.Text)
+		}
+	}
+	var found []models.DocumentChunk
+	for _, match := range retrieval.NewIndex(texts).Search(query, limit) {
+		found = append(found, chunks[match.Index])
+	}
+	return found, nil
+}
+
+func (m *mockDocuments) GetChunks(ctx context.Context, ids []uint) ([]models.DocumentChunk, error) {
+	var found []models.DocumentChunk
+	for _, chunk := range m.chunks() {
+		for _, id := range ids {
+			if chunk.ID == id {
+				found = append(found, chunk)
+			}
+		}
+	}
+	return found, nil
+}
+
+func (m *mockDocuments) chunks() []models.DocumentChunk {
+	var chunks []models.DocumentChunk
+	for _, document := range m.documents {
+		chunks = append(chunks, document.Chunks...)
+	}
+	return chunks
+}
+
+const courseMaterial = `# Concurrency
+
+## Channels
+
+A buffered channel only blocks when it is full.
+
+## Mutexes
+
+A mutex guards shared memory from concurrent writes.
+`
+
+// documentConfig cuts documents into small chunks and retrieves one passage per topic.
+var documentConfig = Config{References: ReferenceConfig{ChunkWords: 100, ChunkOverlap: 10, PassagesPerTopic: 1}}
+
+// citedExamResponse is examResponse with a single question on buffered channels citing the
+// passage.
+func citedExamResponse(text string, chunkId uint64, quote string) string {
+	exam := &suggest.SuggestExamQuestionResponseV2{}
+	json.Unmarshal([]byte(examResponse(text)), exam)
+	exam.Questions[0].Topic = "Buffered channels"
+	exam.Questions[0].Citations = []*suggest.SuggestExamQuestionResponseV2_Citation{{ChunkId: chunkId, Quote: quote}}
+	response, _ := json.Marshal(exam)
+	return string(response)
+}
+
+func Test_UploadDocument(t *testing.T) {
+	h, _, ctx := newTestHandler(documentConfig)
+	h.documents = &mockDocuments{}
+
+	resp, err := h.UploadDocument(ctx, &suggest.UploadDocumentRequest{Title: " Go course ", Format: "Markdown", Content: courseMaterial})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.GetDocumentId())
+	assert.Equal(t, "Go course", resp.GetTitle())
+	assert.Equal(t, int32(2), resp.GetChunkCount())
+
+	document := h.documents.(*mockDocuments).documents[0]
+	assert.Equal(t, "123", document.AuthorId)
+	assert.Equal(t, retrieval.FormatMarkdown, document.Format)
+	assert.Equal(t, "Concurrency > Mutexes", document.Chunks[1].Section)
+	assert.Equal(t, 1, document.Chunks[1].Position)
+
+	_, err = h.UploadDocument(ctx, &suggest.UploadDocumentRequest{Format: "docx", Content: courseMaterial})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	_, err = h.UploadDocument(ctx, &suggest.UploadDocumentRequest{Format: "text", Content: " \n\n "})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	_, err = h.UploadDocument(context.Background(), &suggest.UploadDocumentRequest{Format: "text", Content: courseMaterial})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+}
+
+func Test_SuggestExamQuestionV2_Grounded(t *testing.T) {
+	// The first question cites a document the exam is not grounded in, so it is repaired.
+	h, llm, ctx := newTestHandler(documentConfig,
+		citedExamResponse("When does sending on a buffered channel block?", 3, "A mutex guards shared memory"),
+		citedExamResponse("When does sending on a buffered channel block?", 1, "only blocks when it is full"),
+	)
+	h.documents = &mockDocuments{}
+	_, err := h.UploadDocument(ctx, &suggest.UploadDocumentRequest{Format: "markdown", Content: courseMaterial})
+	assert.NoError(t, err)
+	_, err = h.UploadDocument(ctx, &suggest.UploadDocumentRequest{Format: "text", Content: "A mutex guards shared memory."})
+	assert.NoError(t, err)
+
+	exam, err := h.SuggestExamQuestionV2(ctx, &suggest.SuggestExamQuestionRequest{
+		QuestionType: validation.QuestionTypeMCQ,
+		Topics:       []*suggest.Topic{{Name: "Buffered channels", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 1}}},
+		DocumentIds:  []uint64{1},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, exam.GetViolations())
+
+	assert.Len(t, llm.prompts, 2)
+	assert.Contains(t, llm.prompts[0], "[chunkId 1] Concurrency > Channels\nA buffered channel only blocks when it is full.\n")
+	assert.NotContains(t, llm.prompts[0], "[chunkId 2]", "only the best passage per topic is shown")
+	assert.Contains(t, llm.prompts[1], "Question id 1: cite at least one of the reference passages")
+
+	citations := exam.GetQuestions()[0].GetCitations()
+	assert.Len(t, citations, 1)
+	assert.Equal(t, uint64(1), citations[0].GetDocumentId())
+	assert.Equal(t, uint64(1), citations[0].GetChunkId())
+	assert.Equal(t, "Concurrency > Channels", citations[0].GetSection())
+
+	_, err = h.SuggestExamQuestionV2(ctx, &suggest.SuggestExamQuestionRequest{QuestionType: validation.QuestionTypeMCQ, DocumentIds: []uint64{7}})
+	assert.EqualError(t, err, errors.ErrNotFound)
+
+	// Another user can't ground an exam in the documents.
+	other := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "456"))
+	_, err = h.SuggestExamQuestionV2(other, &suggest.SuggestExamQuestionRequest{QuestionType: validation.QuestionTypeMCQ, DocumentIds: []uint64{1}})
+	assert.EqualError(t, err, errors.ErrNotFound)
+	assert.Len(t, llm.prompts, 2)
+}
+
+func Test_containsQuote(t *testing.T) {
+	passage := "A buffered channel only blocks when it is full."
+	assert.True(t, containsQuote(passage, "“only  BLOCKS when it is full…”"))
+	assert.False(t, containsQuote(passage, "blocks when it is empty"))
+	assert.False(t, containsQuote(passage, ""))
+	assert.False(t, containsQuote(passage, ` "..." `))
+}
@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const maxRepairRounds = 2
+
+// repairExamQuestions validates the generated questions, running the reference solutions of
+// coding questions in the sandbox, blind solving the MCQs and classifying the tags of every
+// question, and asks the model to fix only the offending ones, on the conversation that
+// produced them. Every call is reported under its own key derived from requestKey. It
+// returns the repaired questions and whatever violations are left.
+// Doubtful answer keys and tags are only reported, not repaired, unless their config asks
+// to regenerate them; code that could not be run is only reported.
+func (h *handler) repairExamQuestions(ctx context.Context, requestKey string, conversationId *uint64, questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec validation.ExamSpec, opts ...llmManager.GenerateOption) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, []validation.Violation) {
+	questions = renumberDuplicateIds(questions)
+	if spec.QuestionCount > 0 && len(questions) > spec.QuestionCount {
+		log.Printf("[RepairExam] dropping %d extra questions", len(questions)-spec.QuestionCount)
+		questions = questions[:spec.QuestionCount]
+	}
+
+	codeChecker, keyVerifier, citationChecker, exclusionChecker, tagClassifier := h.newCodeChecker(), h.newKeyVerifier(requestKey), h.newCitationChecker(spec), h.newExclusionChecker(spec), h.newTagClassifier(requestKey)
+	checkers := []questionChecker{codeChecker, citationChecker, exclusionChecker}
+	if h.config.AnswerKey.Regenerate {
+		checkers = append(checkers, keyVerifier)
+	}
+	if h.config.TagCheck.Regenerate {
+		checkers = append(checkers, tagClassifier)
+	}
+	for round := 0; round < maxRepairRounds; round++ {
+		// Code that could not be run is only reported, rewriting it doesn't help.
+		violations := withoutCode(h.validateExam(ctx, questions, spec, checkers...), validation.CodeUnverified)
+		if len(violations) == 0 {
+			break
+		}
+		log.Printf("[RepairExam] round %d, %d violations", round+1, len(violations))
+
+		missing := 0
+		if spec.QuestionCount > len(questions) {
+			missing = spec.QuestionCount - len(questions)
+		}
+		prompt := examRepairPrompt(questions, violations, spec, missing)
+
+		convId, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_EXAM, prompt, subRequestKey(requestKey, "repair.%d", round+1), conversationId, opts...)
+		if err != nil {
+			log.Printf("[RepairExam] error generating repair: %v", err)
+			break
+		}
+		conversationId = convId
+
+		repaired := &suggest.SuggestExamQuestionResponseV2{}
+		if err := llmjson.UnmarshalProto(llmResponse, repaired); err != nil {
+			log.Printf("[RepairExam] error parsing repair: %v", err)
+			continue
+		}
+
+		questions = mergeRepairedQuestions(questions, repaired.GetQuestions(), violations, missing)
+	}
+
+	return questions, h.validateExam(ctx, questions, spec, codeChecker, keyVerifier, citationChecker, exclusionChecker, tagClassifier)
+}
+
+// subRequestKey is the key a follow-up LLM call of a request is reported under. Reports are
+// unique per key, so the key is derived from the request key, or random when there is none.
+func subRequestKey(requestKey string, format string, args ...interface{}) string {
+	if requestKey == "" {
+		requestKey = uuid.New().String()
+	}
+	return requestKey + "#" + fmt.Sprintf(format, args...)
+}
+
+// questionChecker is a check too slow to repeat on every repair round, such as running
+// code or asking another model. It remembers its outcome per question.
+type questionChecker interface {
+	check(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []validation.Violation
+}
+
+// validateExam checks the questions against the spec and against each other, flagging the
+// later of two questions that are too alike so it gets rewritten. Questions that pass are
+// then handed to the checkers.
+func (h *handler) validateExam(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec validation.ExamSpec, checkers ...questionChecker) []validation.Violation {
+	violations := append(validation.ValidateExam(questions, spec), validation.ValidateDuplicates(questions, h.config.DuplicateThreshold)...)
+	var passing []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, question := range questions {
+		if !hasViolation(violations, question.GetId()) {
+			passing = append(passing, question)
+		}
+	}
+	for _, checker := range checkers {
+		violations = append(violations, checker.check(ctx, passing)...)
+	}
+	return violations
+}
+
+func hasViolation(violations []validation.Violation, questionId int32) bool {
+	for _, violation := range violations {
+		if violation.QuestionId == questionId {
+			return true
+		}
+	}
+	return false
+}
+
+// reportedOnly reports whether a question left with the violation after repair is still
+// kept: doubtful answer keys and tags, and code that could not be run, are only reported.
+func reportedOnly(violation validation.Violation) bool {
+	switch violation.Code {
+	case validation.CodeAnswerKey, validation.CodeTagMismatch, validation.CodeUnverified:
+		return true
+	}
+	return false
+}
+
+// dropBrokenQuestions leaves out the questions that still break a rule after repair, and
+// returns the kept questions with the violations left about them and the exam.
+func dropBrokenQuestions(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, violations []validation.Violation) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, []validation.Violation) {
+	broken := make(map[int32]bool)
+	for _, violation := range violations {
+		if violation.QuestionId != 0 && !reportedOnly(violation) {
+			broken[violation.QuestionId] = true
+		}
+	}
+	var kept []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, question := range questions {
+		if broken[question.GetId()] {
+			log.Printf("[RepairExam] dropping question %d, it still breaks the rules", question.GetId())
+			continue
+		}
+		kept = append(kept, question)
+	}
+	var left []validation.Violation
+	for _, violation := range violations {
+		if !broken[violation.QuestionId] {
+			left = append(left, violation)
+		}
+	}
+	return kept, left
+}
+
+// withoutCode returns the violations that don't have the given code.
+func withoutCode(violations []validation.Violation, code string) []validation.Violation {
+	var kept []validation.Violation
+	for _, violation := range violations {
+		if violation.Code != code {
+			kept = append(kept, violation)
+		}
+	}
+	return kept
+}
+
+// mergeRepairedQuestions swaps in the repaired versions of offending questions, matched by
+// id, and appends up to missing new questions. Anything else the model sent is ignored.
+func mergeRepairedQuestions(questions, repaired []*suggest.SuggestExamQuestionResponseV2_Quetion, violations []validation.Violation, missing int) []*suggest.SuggestExamQuestionResponseV2_Quetion {
+	offending := make(map[int32]bool)
+	for _, violation := range violations {
+		if violation.QuestionId != 0 {
+			offending[violation.QuestionId] = true
+		}
+	}
+
+	byId := make(map[int32]int, len(questions))
+	var maxId int32
+	for i, question := range questions {
+		byId[question.GetId()] = i
+		if question.GetId() > maxId {
+			maxId = question.GetId()
+		}
+	}
+
+	merged := append([]*suggest.SuggestExamQuestionResponseV2_Quetion(nil), questions...)
+	for _, question := range repaired {
+		if i, ok := byId[question.GetId()]; ok {
+			if offending[question.GetId()] {
+				merged[i] = question
+				delete(offending, question.GetId())
+			}
+			continue
+		}
+		if missing > 0 {
+			maxId++
+			question.Id = maxId
+			merged = append(merged, question)
+			missing--
+		}
+	}
+	return merged
+}
+
+// renumberDuplicateIds gives every question a sequential id when ids collide, so repairs
+// can be matched back to a single question.
+func renumberDuplicateIds(questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []*suggest.SuggestExamQuestionResponseV2_Quetion {
+	seen := make(map[int32]bool, len(questions))
+	for _, question := range questions {
+		if seen[question.GetId()] {
+			for i, question := range questions {
+				question.Id = int32(i + 1)
+			}
+			return questions
+		}
+		seen[question.GetId()] = true
+	}
+	return questions
+}
+
+func examRepairPrompt(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, violations []validation.Violation, spec validation.ExamSpec, missing int) string {
+	problems := make(map[int32][]string)
+	for _, violation := range violations {
+		if violation.QuestionId != 0 {
+			problems[violation.QuestionId] = append(problems[violation.QuestionId], violation.Message)
+		}
+	}
+
+	var offending []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, question := range questions {
+		if _, ok := problems[question.GetId()]; ok {
+			offending = append(offending, question)
+		}
+	}
+	offendingJSON, _ := protojson.Marshal(&suggest.SuggestExamQuestionResponseV2{Questions: offending})
+
+	var problemList strings.Builder
+	for _, question := range offending {
+		fmt.Fprintf(&problemList, "- Question id %d: %s\n", question.GetId(), strings.Join(problems[question.GetId()], "; "))
+	}
+
+	extra, andNew := "", ""
+	if missing > 0 {
+		extra = fmt.Sprintf("\nThe exam is also missing **%d questions**. Add exactly %d new questions that follow the original requirements and do not repeat any existing question. Give them ids that are not used yet.\n", missing, missing)
+		if missingTypes := validation.MissingTypes(questions, spec); len(missingTypes) > 0 {
+			var types []string
+			for _, questionType := range validation.QuestionTypes {
+				if missingTypes[questionType] > 0 {
+					types = append(types, fmt.Sprintf("%d %s", missingTypes[questionType], questionType))
+				}
+			}
+			extra += fmt.Sprintf("The new questions are needed of these types, questions rewritten to another type above count towards them: %s.\n", strings.Join(types, ", "))
+		}
+		if missingCells := validation.MissingCells(questions, spec); len(missingCells) > 0 {
+			var cells []string
+			for _, cell := range missingCells {
+				cells = append(cells, fmt.Sprintf("%d on %s", cell.Count, cell))
+			}
+			extra += fmt.Sprintf("The new questions are needed on these topics and levels, labelled with them in \"topic\" and \"level\": %s.\n", strings.Join(cells, ", "))
+		}
+		andNew = " and the new questions"
+	}
+	language := spec.Language
+	if language == "" {
+		language = "the language of the original request"
+	}
+
+	return fmt.Sprintf(`
+Some of the exam questions you generated break the required rules. Fix **only** the questions listed below and keep everything that is already correct about them.
+
+📌 Problems:
+%v
+📥 Questions to fix:
+%s
+%v
+🔁 Rules every question must follow:
+- No question repeats or paraphrases another question of the exam.
+- Every question is written in %v.
+
+%v
+📤 Output Format:
+Return only a valid JSON object {"questions": [...]} containing the fixed questions with their **original ids**%v. No notes, markdown, or trailing commas.
+`, problemList.String(), offendingJSON, extra, language, questionTypeSection(spec), andNew)
+}
+
+func toProtoViolations(violations []validation.Violation) []*suggest.SuggestExamQuestionResponseV2_Violation {
+	var result []*suggest.SuggestExamQuestionResponseV2_Violation
+	for _, violation := range violations {
+		result = append(result, &suggest.SuggestExamQuestionResponseV2_Violation{
+			QuestionId: violation.QuestionId,
+			Field:      violation.Field,
+			Code:       violation.Code,
+			Message:    violation.Message,
+		})
+	}
+	return result
+}
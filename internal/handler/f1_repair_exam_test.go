@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	llm_grpc "darius/internal/services/llm-grpc"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	arceus "darius/pkg/proto/deps/arceus"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type mockLLMManager struct {
+	mu        sync.Mutex
+	responses []string
+	// respond, when set, answers every prompt instead of responses.
+	respond     func(prompt string) string
+	prompts     []string
+	requestKeys []string
+}
+
+func (m *mockLLMManager) Generate(ctx context.Context, entry, prompt, requestKey string, conversationId *uint64, opts ...llmManager.GenerateOption) (*uint64, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prompts = append(m.prompts, prompt)
+	m.requestKeys = append(m.requestKeys, requestKey)
+	id := uint64(len(m.prompts))
+	if m.respond != nil {
+		return &id, m.respond(prompt), nil
+	}
+	response := m.responses[0]
+	m.responses = m.responses[1:]
+	return &id, response, nil
+}
+
+func (m *mockLLMManager) GenerateStream(ctx context.Context, entry, prompt, requestKey string, conversationId *uint64, onChunk func(string) error, opts ...llmManager.GenerateOption) (*uint64, string, error) {
+	conversationId, response, err := m.Generate(ctx, entry, prompt, requestKey, conversationId, opts...)
+	if err == nil {
+		err = onChunk(response)
+	}
+	return conversationId, response, err
+}
+
+func (m *mockLLMManager) GetByRequestKey(ctx context.Context, requestKey string) (string, error) {
+	return "", nil
+}
+
+func Test_repairExamQuestions(t *testing.T) {
+	llm := &mockLLMManager{responses: []string{
+		`{"questions": [
+			{"id": 2, "text": "Which keyword starts a goroutine?", "type": "MCQ", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": 0, "optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]}},
+			{"id": 7, "text": "Which keyword defers a call until return?", "type": "MCQ", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": 1, "optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]}},
+			{"id": 1, "text": "This one was fine and must be ignored", "type": "MCQ", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["a", "b", "c", "d"], "correctOption": 0, "optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]}}
+		]}`,
+	}}
+	h := &handler{llmManager: llm}
+
+	questions := []*suggest.SuggestExamQuestionResponseV2_Quetion{
+		{Id: 1, Text: "Which type is used to send values between goroutines?", Type: "MCQ", BloomLevel: "REMEMBER", EstimatedMinutes: 1, Detail: &suggest.SuggestExamQuestionResponseV2_Detail{Type: "MCQ", Options: []string{"chan", "map", "slice", "struct"}, OptionExplanations: []*suggest.SuggestExamQuestionResponseV2_OptionExplanation{
+			{Explanation: "Channels carry values between goroutines"},
+			{Explanation: "A map is not safe for concurrent use", Misconception: "maps-are-synchronized"},
+			{Explanation: "A slice shares memory rather than sending values", Misconception: "sharing-is-communicating"},
+			{Explanation: "A struct only groups fields", Misconception: "structs-are-channels"},
+		}}},
+		{Id: 2, Text: "Which keyword starts a goroutine?", Type: "MCQ", BloomLevel: "REMEMBER", EstimatedMinutes: 1, Detail: &suggest.SuggestExamQuestionResponseV2_Detail{Type: "MCQ", Options: []string{"go", "go", "chan"}, CorrectOption: 5}},
+	}
+	spec := validation.ExamSpec{QuestionCount: 3, QuestionType: "MCQ", Language: "English"}
+
+	repaired, violations := h.repairExamQuestions(context.Background(), "", nil, questions, spec)
+
+	assert.Empty(t, violations)
+	assert.Len(t, llm.prompts, 1)
+	assert.Contains(t, llm.prompts[0], "Question id 2")
+	assert.NotContains(t, llm.prompts[0], "Question id 1:")
+	assert.Contains(t, llm.prompts[0], "missing **1 questions**")
+
+	assert.Len(t, repaired, 3)
+	assert.Equal(t, "Which type is used to send values between goroutines?", repaired[0].GetText())
+	assert.Equal(t, []string{"go", "defer", "chan", "select"}, repaired[1].GetDetail().GetOptions())
+	assert.Equal(t, int32(3), repaired[2].GetId())
+}
+
+func Test_repairExamQuestions_ReportsWhatIsLeft(t *testing.T) {
+	llm := &mockLLMManager{responses: []string{`not json`, `{"questions": []}`}}
+	h := &handler{llmManager: llm}
+
+	questions := []*suggest.SuggestExamQuestionResponseV2_Quetion{
+		{Id: 1, Text: "Which keyword starts a goroutine?", Type: "MCQ", BloomLevel: "REMEMBER", EstimatedMinutes: 1, Detail: &suggest.SuggestExamQuestionResponseV2_Detail{Type: "LONG_ANSWER", Options: []string{"go", "defer", "chan", "select"}, OptionExplanations: []*suggest.SuggestExamQuestionResponseV2_OptionExplanation{
+			{Explanation: "go starts a goroutine"},
+			{Explanation: "defer delays a call", Misconception: "defer-is-async"},
+			{Explanation: "chan is a type", Misconception: "chan-is-a-statement"},
+			{Explanation: "select waits on channels", Misconception: "select-is-a-loop"},
+		}}},
+	}
+
+	_, violations := h.repairExamQuestions(context.Background(), "", nil, questions, validation.ExamSpec{QuestionCount: 1})
+
+	assert.Len(t, llm.prompts, maxRepairRounds)
+	assert.Equal(t, []*suggest.SuggestExamQuestionResponseV2_Violation{
+		{QuestionId: 1, Field: "detail.type", Code: validation.CodeTypeMismatch, Message: `detail.type "LONG_ANSWER" does not match type "MCQ"`},
+	}, toProtoViolations(violations))
+}
+
+// fakeLLMService answers every prompt with respond.
+type fakeLLMService struct {
+	respond func(prompt string) string
+}
+
+func (s *fakeLLMService) Generate(ctx context.Context, provider string, req *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+	return &arceus.GenerateTextResponse{Content: s.respond(req.GetContent()), ConversationId: 1}, nil
+}
+
+func (s *fakeLLMService) GenerateStream(ctx context.Context, provider string, req *arceus.GenerateTextRequest, onChunk func(string) error) (*arceus.GenerateTextResponse, error) {
+	resp, _ := s.Generate(ctx, provider, req)
+	return resp, onChunk(resp.GetContent())
+}
+
+func (s *fakeLLMService) DefaultProvider() string {
+	return llm_grpc.ProviderArceus
+}
+
+// uniqueReports rejects a second report under the same request key, like the unique index
+// on llm_call_reports.
+type uniqueReports struct {
+	keys []string
+}
+
+func (r *uniqueReports) CreateLLMCallReport(ctx context.Context, entry, res, resp, requestKey, provider, model string, amount float64) error {
+	for _, key := range r.keys {
+		if key == requestKey {
+			return fmt.Errorf("duplicate entry %q for key 'idx_llm_call_reports_request_key'", requestKey)
+		}
+	}
+	r.keys = append(r.keys, requestKey)
+	return nil
+}
+
+func (r *uniqueReports) GetByRequestKey(ctx context.Context, requestKey string) (string, error) {
+	return "", nil
+}
+
+func Test_repairExamQuestions_UniqueRequestKeys(t *testing.T) {
+	broken := `{"questions": [{"id": 1, "text": "Which keyword starts a goroutine?", "type": "MCQ", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "go"]}}]}`
+	fixed := examResponse("Which keyword starts a goroutine?")
+	repairs := 0
+	reports := &uniqueReports{}
+	h := &handler{llmManager: llmManager.NewManager(&fakeLLMService{respond: func(prompt string) string {
+		// The first repair of every exam is still broken, the second one fixes it.
+		repairs++
+		if repairs%2 == 1 {
+			return broken
+		}
+		return fixed
+	}}, reports)}
+
+	for _, requestKey := range []string{"key", "", ""} {
+		exam := &suggest.SuggestExamQuestionResponseV2{}
+		assert.NoError(t, protojson.Unmarshal([]byte(broken), exam))
+
+		_, violations := h.repairExamQuestions(context.Background(), requestKey, nil, exam.GetQuestions(), validation.ExamSpec{QuestionCount: 1})
+		assert.Empty(t, violations, requestKey)
+	}
+	assert.Len(t, reports.keys, 6)
+	assert.Equal(t, []string{"key#repair.1", "key#repair.2"}, reports.keys[:2])
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/errors"
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"log"
+)
+
+func (h *handler) StreamExamQuestions(req *suggest.SuggestExamQuestionRequest, stream suggest.SuggestService_StreamExamQuestionsServer) error {
+	ctx := stream.Context()
+	if req.GetQuestionType() == "" {
+		req.QuestionType = "MIXED" //default question type
+	}
+	if err := validation.CheckQuestionType(req.GetQuestionType(), req.GetTypeRatio()); err != nil {
+		return h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	if err := h.checkDocuments(ctx, req.GetDocumentIds()); err != nil {
+		return err
+	}
+
+	spec := validation.ExamSpecFromRequest(req)
+	if err := validation.CheckBloomDistribution(req.GetBloomDistribution(), spec.QuestionCount); err != nil {
+		return h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+
+	chargeCode, err := h.checkCanCall(ctx, constants.F1_SUGGEST_EXAM)
+	if err != nil {
+		return err
+	}
+
+	var generateOpts []llmManager.GenerateOption
+	if temperature, ok := creativityToTemperature(req.GetCreativity()); ok {
+		generateOpts = append(generateOpts, llmManager.WithTemperature(temperature))
+	}
+
+	prompt := h.examQuestionPrompt(ctx, req)
+
+	// Questions are checked while the model keeps writing the next ones, so a slow check
+	// doesn't hold up the LLM stream.
+	generateCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	parsed := make(chan *suggest.SuggestExamQuestionResponseV2_Quetion, streamQueueSize)
+	sent := 0
+	done := make(chan error, 1)
+	go func() {
+		var sendErr error
+		sent, sendErr = h.sendCheckedQuestions(generateCtx, req.GetRequestKey(), chargeCode, spec, parsed, stream, generateOpts...)
+		if sendErr != nil {
+			cancel()
+		}
+		done <- sendErr
+	}()
+
+	parser := &questionStreamParser{}
+	_, _, err = h.llmManager.GenerateStream(generateCtx, constants.F1_SUGGEST_EXAM, prompt, req.GetRequestKey(), nil, func(chunk string) error {
+		for _, raw := range parser.Write(chunk) {
+			question := &suggest.SuggestExamQuestionResponseV2_Quetion{}
+			if err := llmjson.UnmarshalProto(string(raw), question); err != nil {
+				log.Printf("[StreamExamQuestions] skipping question that failed to unmarshal: %v", err)
+				continue
+			}
+			select {
+			case parsed <- question:
+			case <-generateCtx.Done():
+				return generateCtx.Err()
+			}
+		}
+		return nil
+	}, generateOpts...)
+	close(parsed)
+	if sendErr := <-done; sendErr != nil {
+		err = sendErr
+	}
+	if err != nil {
+		log.Printf("[StreamExamQuestions] error after %d questions: %v", sent, err)
+		if err.Error() == errors.ErrChargingFailed {
+			return h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+		}
+		return h.handleErrorWithStatusCode(ctx, err, errors.ErrNetworkConnection)
+	}
+	if sent == 0 {
+		return h.handleErrorWithStatusCode(ctx, fmt.Errorf("no streamed question passed the checks"), errors.ErrJSONParsing)
+	}
+	return nil
+}
+
+// streamQueueSize is how many parsed questions may wait for their checks.
+const streamQueueSize = 16
+
+// sendCheckedQuestions runs every streamed question through the checks of a whole exam,
+// repairing it on its own, and sends it once it passes. Questions that still break a rule,
+// repeat an excluded or already sent question, or would go over the requested count, type
+// counts, Bloom distribution or breakdown are left out. Doubtful answer keys, tags and code that could not be run don't hold a question
+// back; they are recorded in its keyCheck, tagCheck and codeCheck.
+// The LLM call is charged before the first question is sent, so a failed charge gives
+// nothing away.
+func (h *handler) sendCheckedQuestions(ctx context.Context, requestKey, chargeCode string, spec validation.ExamSpec, parsed <-chan *suggest.SuggestExamQuestionResponseV2_Quetion, stream suggest.SuggestService_StreamExamQuestionsServer, opts ...llmManager.GenerateOption) (int, error) {
+	// The counts are about the exam as a whole and can't be checked one question at a time.
+	// They are kept by dropping every question whose share of the exam is already full.
+	questionSpec := spec
+	questionSpec.QuestionCount, questionSpec.Cells, questionSpec.TypeCounts, questionSpec.BloomCounts = 0, nil, nil, nil
+	questionSpec.Excluded = append([]string(nil), spec.Excluded...)
+
+	var sent []*suggest.SuggestExamQuestionResponseV2_Quetion
+	received := 0
+	for question := range parsed {
+		received++
+		if spec.QuestionCount > 0 && len(sent) >= spec.QuestionCount {
+			log.Printf("[StreamExamQuestions] dropping question %d, the exam is complete", question.GetId())
+			continue
+		}
+		if bucket := validation.FullBucket(sent, question, spec); bucket != "" {
+			log.Printf("[StreamExamQuestions] dropping question %d, the exam has all its %s", question.GetId(), bucket)
+			continue
+		}
+
+		questions, violations := h.repairExamQuestions(ctx, subRequestKey(requestKey, "question.%d", received), nil, []*suggest.SuggestExamQuestionResponseV2_Quetion{question}, questionSpec, opts...)
+		questions, violations = dropFailingCode(questions, violations, questionSpec)
+		if questions, _ = dropBrokenQuestions(questions, violations); len(questions) == 0 {
+			log.Printf("[StreamExamQuestions] dropping question %d, it still breaks the rules: %v", question.GetId(), violations)
+			continue
+		}
+
+		// The repair may have changed the type or level of the question.
+		if bucket := validation.FullBucket(sent, questions[0], spec); bucket != "" {
+			log.Printf("[StreamExamQuestions] dropping question %d, the exam has all its %s", question.GetId(), bucket)
+			continue
+		}
+
+		if len(sent) == 0 && !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+			log.Printf("[StreamExamQuestions] Charge Code %s failed to charge for LLM call", chargeCode)
+			return 0, errors.Error(errors.ErrChargingFailed)
+		}
+		if err := stream.Send(questions[0]); err != nil {
+			return len(sent), err
+		}
+		sent = append(sent, questions[0])
+		questionSpec.Excluded = append(questionSpec.Excluded, questions[0].GetText())
+	}
+	return len(sent), nil
+}
+
+// questionStreamParser picks complete question objects out of a JSON document that
+// arrives in pieces. It expects either {"questions": [{...}, ...]} or a bare [{...}, ...]
+// and ignores anything the model writes around it, such as markdown fences.
+type questionStreamParser struct {
+	buf      []byte
+	pos      int
+	stack    []byte
+	inString bool
+	escaped  bool
+	start    int
+}
+
+// Write feeds the next piece of output and returns the questions it completed.
+func (p *questionStreamParser) Write(chunk string) [][]byte {
+	p.buf = append(p.buf, chunk...)
+
+	var questions [][]byte
+	for ; p.pos < len(p.buf); p.pos++ {
+		c := p.buf[p.pos]
+		if p.inString {
+			switch {
+			case p.escaped:
+				p.escaped = false
+			case c == '\\':
+				p.escaped = true
+			case c == '"':
+				p.inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			// Text outside of any JSON value (fences, prose) is not a string worth tracking.
+			p.inString = len(p.stack) > 0
+		case '{', '[':
+			if c == '{' && p.isQuestionSlot() {
+				p.start = p.pos
+			}
+			p.stack = append(p.stack, c)
+		case '}', ']':
+			if len(p.stack) == 0 {
+				continue
+			}
+			p.stack = p.stack[:len(p.stack)-1]
+			if c == '}' && p.isQuestionSlot() {
+				questions = append(questions, append([]byte(nil), p.buf[p.start:p.pos+1]...))
+			}
+		}
+	}
+	return questions
+}
+
+// isQuestionSlot reports whether an object opened at the current depth is an element of
+// the questions array.
+func (p *questionStreamParser) isQuestionSlot() bool {
+	depth := len(p.stack)
+	return depth > 0 && depth <= 2 && p.stack[depth-1] == '['
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/errors"
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type mockQuestionStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*suggest.SuggestExamQuestionResponseV2_Quetion
+}
+
+func (s *mockQuestionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockQuestionStream) Send(question *suggest.SuggestExamQuestionResponseV2_Quetion) error {
+	s.sent = append(s.sent, question)
+	return nil
+}
+
+func Test_questionStreamParser(t *testing.T) {
+	output := "```json\n{\"questions\": [{\"id\": 1, \"text\": \"What does } mean in \\\"Go\\\"?\", \"detail\": {\"options\": [\"{\", \"]\"]}}, {\"id\": 2, \"text\": \"Second\"}]}\n```"
+
+	// Feed the output a few bytes at a time, the way a model streams it.
+	parser := &questionStreamParser{}
+	var questions []string
+	for i := 0; i < len(output); i += 7 {
+		end := i + 7
+		if end > len(output) {
+			end = len(output)
+		}
+		for _, question := range parser.Write(output[i:end]) {
+			questions = append(questions, string(question))
+		}
+	}
+
+	assert.Equal(t, []string{
+		`{"id": 1, "text": "What does } mean in \"Go\"?", "detail": {"options": ["{", "]"]}}`,
+		`{"id": 2, "text": "Second"}`,
+	}, questions)
+}
+
+func Test_questionStreamParser_BareArray(t *testing.T) {
+	parser := &questionStreamParser{}
+	assert.Equal(t, [][]byte{[]byte(`{"id": 1}`)}, parser.Write(`[{"id": 1}, {"id"`))
+	assert.Equal(t, [][]byte{[]byte(`{"id": 2}`)}, parser.Write(`: 2}]`))
+}
+
+func Test_StreamExamQuestions_ChecksQuestions(t *testing.T) {
+	// The second question has a duplicate option, the third repeats an excluded question.
+	exam := &suggest.SuggestExamQuestionResponseV2{}
+	assert.NoError(t, llmjson.UnmarshalProto(examResponse(
+		"Which statement about slices in Go holds?",
+		"Which keyword starts a goroutine?",
+		"Which statement about maps in Go holds?",
+	), exam))
+	exam.Questions[1].Detail.Options[1] = exam.Questions[1].Detail.Options[0]
+	response, _ := protojson.Marshal(exam)
+
+	llm := &mockLLMManager{respond: func(prompt string) string {
+		switch {
+		case strings.Contains(prompt, "Question id 2"):
+			return examResponse("", "Which keyword starts a goroutine in Go?")
+		case strings.Contains(prompt, "Question id 3"):
+			return examResponse("", "", "Which statement about channels in Go holds?")
+		}
+		return string(response)
+	}}
+	h := &handler{
+		llmManager:  llm,
+		missfortune: mockMissfortune{},
+		bulbasaur:   &mockBulbasaur{checkCallingLLMResult: "charge", chargeCallingLLMResult: true},
+		config:      Config{DuplicateThreshold: 0.8},
+	}
+	stream := &mockQuestionStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "123"))}
+
+	err := h.StreamExamQuestions(&suggest.SuggestExamQuestionRequest{
+		Language:          "English",
+		QuestionType:      validation.QuestionTypeMCQ,
+		RequestKey:        "key",
+		Topics:            []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 3}}},
+		ExcludedQuestions: []*suggest.SuggestExamQuestionResponseV2_Quetion{{Text: "Which statement about maps in Go holds?"}},
+	}, stream)
+	assert.NoError(t, err)
+
+	var texts []string
+	for _, question := range stream.sent {
+		texts = append(texts, question.GetText())
+	}
+	assert.Equal(t, []string{
+		"Which statement about slices in Go holds?",
+		"Which keyword starts a goroutine in Go?",
+		"Which statement about channels in Go holds?",
+	}, texts)
+	assert.Equal(t, []string{"key", "key#question.2#repair.1", "key#question.3#repair.1"}, llm.requestKeys)
+}
+
+func Test_StreamExamQuestions_KeepsShares(t *testing.T) {
+	// The second question is on a cell that is already full, the third one at a Bloom level
+	// that is already full.
+	exam := &suggest.SuggestExamQuestionResponseV2{}
+	assert.NoError(t, llmjson.UnmarshalProto(examResponse(
+		"Which keyword starts a goroutine?",
+		"Which keyword defers a call until return?",
+		"Which isolation level prevents phantom reads?",
+		"How would you index a table for range queries?",
+	), exam))
+	exam.Questions[1].BloomLevel = validation.BloomApply
+	exam.Questions[2].Topic, exam.Questions[2].Level = "SQL", "Senior"
+	exam.Questions[3].Topic, exam.Questions[3].Level, exam.Questions[3].BloomLevel = "SQL", "Senior", validation.BloomApply
+	response, _ := protojson.Marshal(exam)
+
+	h, _, ctx := newTestHandler(Config{}, string(response))
+	stream := &mockQuestionStream{ctx: ctx}
+
+	err := h.StreamExamQuestions(&suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeMCQ,
+		RequestKey:   "key",
+		Topics: []*suggest.Topic{
+			{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 1}},
+			{Name: "SQL", DifficultyDistribution: &suggest.DifficultyDistribution{Senior: 1}},
+		},
+		BloomDistribution: &suggest.BloomDistribution{Remember: 1, Apply: 1},
+	}, stream)
+	assert.NoError(t, err)
+
+	var texts []string
+	for _, question := range stream.sent {
+		texts = append(texts, question.GetText())
+	}
+	assert.Equal(t, []string{"Which keyword starts a goroutine?", "How would you index a table for range queries?"}, texts)
+
+	// A Bloom distribution that doesn't add up to the exam is rejected before any call.
+	err = h.StreamExamQuestions(&suggest.SuggestExamQuestionRequest{
+		Language:          "English",
+		QuestionType:      validation.QuestionTypeMCQ,
+		Topics:            []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 1}}},
+		BloomDistribution: &suggest.BloomDistribution{Remember: 3},
+	}, stream)
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+}
+
+func Test_StreamExamQuestions_ChargesBeforeSending(t *testing.T) {
+	h, _, ctx := newTestHandler(Config{}, examResponse("Which keyword starts a goroutine?"))
+	h.bulbasaur = &mockBulbasaur{checkCallingLLMResult: "charge"}
+	stream := &mockQuestionStream{ctx: ctx}
+
+	err := h.StreamExamQuestions(&suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeMCQ,
+		RequestKey:   "key",
+		Topics:       []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 1}}},
+	}, stream)
+	assert.EqualError(t, err, errors.ErrChargingFailed)
+	assert.Empty(t, stream.sent)
+}
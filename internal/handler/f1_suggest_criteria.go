@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/errors"
+	"darius/internal/llmjson"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// minCriteriaOptions is the fewest options a suggested criterion may come with.
+const minCriteriaOptions = 2
+
+// SuggestCriteriaParseFunc implements ParseFunction for SuggestCriteriaResponse. Criteria
+// the user already chose are dropped from the suggestions.
+type SuggestCriteriaParseFunc struct {
+	chosen []*suggest.CriteriaEleRequest
+}
+
+func (p SuggestCriteriaParseFunc) Parse(input string) (interface{}, error) {
+	parsed := &suggest.SuggestCriteriaResponse{}
+	if err := llmjson.UnmarshalProto(input, parsed); err != nil {
+		log.Printf("[SuggestCriteria] error parsing response: %v", err)
+		return nil, err
+	}
+
+	chosen := make(map[string]bool, len(p.chosen))
+	for _, criteria := range p.chosen {
+		chosen[normalizeCriteria(criteria.GetCriteria())] = true
+	}
+	var criteriaList []*suggest.CriteriaEleResponse
+	for _, criteria := range parsed.GetCriteriaList() {
+		name := normalizeCriteria(criteria.GetCriteria())
+		if name == "" || chosen[name] {
+			continue
+		}
+		chosen[name] = true
+		criteria.Criteria = strings.TrimSpace(criteria.GetCriteria())
+		criteria.OptionList = distinctOptions(criteria.GetOptionList())
+		if len(criteria.GetOptionList()) >= minCriteriaOptions {
+			criteriaList = append(criteriaList, criteria)
+		}
+	}
+	if len(criteriaList) == 0 {
+		return nil, fmt.Errorf("no new criterion with at least %d distinct options, do not repeat the chosen criteria", minCriteriaOptions)
+	}
+	return &suggest.SuggestCriteriaResponse{CriteriaList: criteriaList}, nil
+}
+
+// SuggestOptionsParseFunc implements ParseFunction for the options of a single criterion.
+type SuggestOptionsParseFunc struct {
+	criteria string
+}
+
+func (p SuggestOptionsParseFunc) Parse(input string) (interface{}, error) {
+	parsed := &suggest.CriteriaEleResponse{}
+	if err := llmjson.UnmarshalProto(input, parsed); err != nil {
+		log.Printf("[SuggestOptions] error parsing response: %v", err)
+		return nil, err
+	}
+	options := distinctOptions(parsed.GetOptionList())
+	if len(options) < minCriteriaOptions {
+		return nil, fmt.Errorf("expected at least %d distinct options, got %d", minCriteriaOptions, len(options))
+	}
+	return &suggest.CriteriaEleResponse{Criteria: p.criteria, OptionList: options}, nil
+}
+
+// SuggestCriteria suggests the criteria, each with options to choose from, that would tell
+// the most about the test the user is describing, besides the criteria already chosen.
+func (h *handler) SuggestCriteria(ctx context.Context, req *suggest.SuggestCriteriaRequest) (*suggest.SuggestCriteriaResponse, error) {
+	if err := checkGeneralInfo(req.GetGeneralInfo()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+
+	chargeCode, err := h.checkCanCall(ctx, constants.F1_SUGGEST_CRITERIA)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := suggestCriteriaPrompt(req.GetGeneralInfo(), req.GetCriteriaList())
+	result, err := h.retryCallLLM(ctx, constants.F1_SUGGEST_CRITERIA, prompt, SuggestCriteriaParseFunc{chosen: req.GetCriteriaList()})
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, retryErrorType(err))
+	}
+
+	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+		log.Printf("[SuggestCriteria] Charge Code %s failed to charge for LLM call", chargeCode)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+	}
+	return result.(*suggest.SuggestCriteriaResponse), nil
+}
+
+// SuggestOptions suggests the options of a new criterion, consistent with the options the
+// user chose for the other criteria.
+func (h *handler) SuggestOptions(ctx context.Context, req *suggest.SuggestOptionsRequest) (*suggest.SuggestOptionsResponse, error) {
+	if err := checkGeneralInfo(req.GetGeneralInfo()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	newCriteria := strings.TrimSpace(req.GetNewCriteria())
+	if newCriteria == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("newCriteria is empty"), errors.ErrInvalidInput)
+	}
+
+	chargeCode, err := h.checkCanCall(ctx, constants.F1_SUGGEST_OPTIONS)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := suggestOptionsPrompt(req.GetGeneralInfo(), req.GetCriteriaList(), newCriteria)
+	result, err := h.retryCallLLM(ctx, constants.F1_SUGGEST_OPTIONS, prompt, SuggestOptionsParseFunc{criteria: newCriteria})
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, retryErrorType(err))
+	}
+
+	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+		log.Printf("[SuggestOptions] Charge Code %s failed to charge for LLM call", chargeCode)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+	}
+	return &suggest.SuggestOptionsResponse{CriteriaList: result.(*suggest.CriteriaEleResponse)}, nil
+}
+
+func checkGeneralInfo(info *suggest.GeneralInfo) error {
+	if info == nil {
+		return stdErrors.New("generalInfo is missing")
+	}
+	if strings.TrimSpace(info.GetTitle()) == "" && strings.TrimSpace(info.GetDescription()) == "" {
+		return stdErrors.New("generalInfo has neither a title nor a description")
+	}
+	return nil
+}
+
+func normalizeCriteria(criteria string) string {
+	return strings.ToLower(strings.TrimRight(strings.TrimSpace(criteria), ":"))
+}
+
+// distinctOptions trims the options and drops empty and repeated ones.
+func distinctOptions(options []string) []string {
+	var distinct []string
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[strings.ToLower(option)] {
+			continue
+		}
+		seen[strings.ToLower(option)] = true
+		distinct = append(distinct, option)
+	}
+	return distinct
+}
+
+func generalInfoText(info *suggest.GeneralInfo) string {
+	var text strings.Builder
+	fmt.Fprintf(&text, "- Title: %v\n", info.GetTitle())
+	if info.GetDescription() != "" {
+		fmt.Fprintf(&text, "- Description: %v\n", info.GetDescription())
+	}
+	if info.GetDuration() != "" {
+		fmt.Fprintf(&text, "- Duration: %v\n", info.GetDuration())
+	}
+	if info.GetDifficulty() != "" {
+		fmt.Fprintf(&text, "- Difficulty: %v\n", info.GetDifficulty())
+	}
+	if info.GetMaxNumberOfQuestions() > 0 {
+		fmt.Fprintf(&text, "- At most %d questions\n", info.GetMaxNumberOfQuestions())
+	}
+	return text.String()
+}
+
+func chosenCriteriaText(criteriaList []*suggest.CriteriaEleRequest) string {
+	if len(criteriaList) == 0 {
+		return "(none chosen yet)\n"
+	}
+	var text strings.Builder
+	for _, criteria := range criteriaList {
+		fmt.Fprintf(&text, "- %v: %v\n", criteria.GetCriteria(), criteria.GetChosenOption())
+	}
+	return text.String()
+}
+
+func suggestCriteriaPrompt(info *suggest.GeneralInfo, criteriaList []*suggest.CriteriaEleRequest) string {
+	return fmt.Sprintf(`
+You are an expert in designing tests and assessments. A user is describing the test they want generated, one criterion at a time. Suggest the next criteria to ask them about, each with the options they can choose from.
+
+📥 Test:
+%v
+📌 Criteria already chosen, with the user's choice:
+%v
+🧠 Your Task:
+1. Understand the context, purpose and constraints of the test from its description and the choices made so far.
+2. Suggest 3 to 5 new criteria that would tell the most about the questions to generate, such as the subject areas, the skills tested, the question formats or the level of the candidates.
+3. Do not repeat, rephrase or contradict a criterion that is already chosen.
+4. Give every criterion 3 to 5 short, distinct options that are consistent with the choices already made.
+5. Write the criteria and options in the language of the test description.
+
+📤 Output Format:
+Return only a valid JSON object, with no notes, markdown, or trailing commas:
+{
+  "criteriaList": [
+    {"criteria": "Subject area", "optionList": ["Computer Networks", "Operating Systems", "Databases"]},
+    {"criteria": "Question format", "optionList": ["Multiple choice", "True/False", "Essay"]}
+  ]
+}
+`, generalInfoText(info), chosenCriteriaText(criteriaList))
+}
+
+func suggestOptionsPrompt(info *suggest.GeneralInfo, criteriaList []*suggest.CriteriaEleRequest, newCriteria string) string {
+	return fmt.Sprintf(`
+You are an expert in designing tests and assessments. A user is describing the test they want generated, one criterion at a time. They just added the criterion **%v**; suggest the options they can choose from for it.
+
+📥 Test:
+%v
+📌 Criteria already chosen, with the user's choice:
+%v
+🧠 Your Task:
+1. Understand the context, purpose and constraints of the test from its description and the choices made so far.
+2. Suggest 3 to 6 short, distinct options for **%v**.
+3. Every option must be consistent with the choices already made: an option that contradicts one of them, such as an advanced topic for a beginner test or a format the test does not use, must not be suggested.
+4. Write the options in the language of the test description.
+
+📤 Output Format:
+Return only a valid JSON object, with no notes, markdown, or trailing commas:
+{"criteria": "%v", "optionList": ["First option", "Second option", "Third option"]}
+`, newCriteria, generalInfoText(info), chosenCriteriaText(criteriaList), newCriteria, newCriteria)
+}
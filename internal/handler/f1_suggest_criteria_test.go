@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"darius/internal/errors"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var criteriaInfo = &suggest.GeneralInfo{Title: "Backend developer screening", Difficulty: "Beginner", Duration: "30 minutes"}
+
+func Test_SuggestCriteria(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{}, `{"criteriaList": [
+		{"criteria": "Difficulty level:", "optionList": ["Beginner", "Advanced"]},
+		{"criteria": "Subject area", "optionList": ["Databases", " databases ", "HTTP", ""]},
+		{"criteria": "Question format", "optionList": ["Multiple choice"]}
+	]}`)
+
+	resp, err := h.SuggestCriteria(ctx, &suggest.SuggestCriteriaRequest{
+		GeneralInfo:  criteriaInfo,
+		CriteriaList: []*suggest.CriteriaEleRequest{{Criteria: "Difficulty level", ChosenOption: "Beginner"}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetCriteriaList(), 1)
+	assert.Equal(t, "Subject area", resp.GetCriteriaList()[0].GetCriteria())
+	assert.Equal(t, []string{"Databases", "HTTP"}, resp.GetCriteriaList()[0].GetOptionList())
+
+	assert.Len(t, llm.prompts, 1)
+	assert.Contains(t, llm.prompts[0], "- Title: Backend developer screening\n")
+	assert.Contains(t, llm.prompts[0], "- Difficulty level: Beginner\n")
+}
+
+func Test_SuggestCriteria_Retry(t *testing.T) {
+	// Only chosen criteria the first time, so the model is asked again.
+	h, llm, ctx := newTestHandler(Config{},
+		`{"criteriaList": [{"criteria": "Difficulty level", "optionList": ["Beginner", "Advanced"]}]}`,
+		`{"criteriaList": [{"criteria": "Subject area", "optionList": ["Databases", "HTTP"]}]}`,
+	)
+
+	resp, err := h.SuggestCriteria(ctx, &suggest.SuggestCriteriaRequest{
+		GeneralInfo:  criteriaInfo,
+		CriteriaList: []*suggest.CriteriaEleRequest{{Criteria: "Difficulty level", ChosenOption: "Beginner"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Subject area", resp.GetCriteriaList()[0].GetCriteria())
+	assert.Len(t, llm.prompts, 2)
+	assert.Contains(t, llm.prompts[1], "do not repeat the chosen criteria")
+}
+
+func Test_SuggestOptions(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{}, `{"criteria": "Topics", "optionList": ["SQL basics", "REST APIs", "REST APIs"]}`)
+
+	resp, err := h.SuggestOptions(ctx, &suggest.SuggestOptionsRequest{
+		GeneralInfo:  criteriaInfo,
+		CriteriaList: []*suggest.CriteriaEleRequest{{Criteria: "Language", ChosenOption: "Go"}},
+		NewCriteria:  " Subject area ",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Subject area", resp.GetCriteriaList().GetCriteria())
+	assert.Equal(t, []string{"SQL basics", "REST APIs"}, resp.GetCriteriaList().GetOptionList())
+
+	assert.Contains(t, llm.prompts[0], "They just added the criterion **Subject area**")
+	assert.Contains(t, llm.prompts[0], "- Language: Go\n")
+}
+
+func Test_SuggestCriteria_InvalidInput(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{})
+
+	_, err := h.SuggestCriteria(ctx, &suggest.SuggestCriteriaRequest{})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	_, err = h.SuggestOptions(ctx, &suggest.SuggestOptionsRequest{GeneralInfo: criteriaInfo})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	_, err = h.SuggestOptions(ctx, &suggest.SuggestOptionsRequest{GeneralInfo: &suggest.GeneralInfo{}, NewCriteria: "Topics"})
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	assert.Empty(t, llm.prompts)
+}
@@ -6,11 +6,15 @@ import (
 	"darius/internal/constants"
 	"darius/internal/converters"
 	"darius/internal/errors"
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
 	"darius/pkg/proto/suggest"
-	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // handleErrorWithStatusCode sets the appropriate HTTP status code and returns the error
@@ -24,11 +28,88 @@ func (h *handler) SuggestExamQuestionV2(ctx context.Context, req *suggest.Sugges
 	if req.GetQuestionType() == "" || len(req.GetQuestionType()) == 0 {
 		req.QuestionType = "MIXED" //default question type
 	}
+	if err := validation.CheckQuestionType(req.GetQuestionType(), req.GetTypeRatio()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	if err := h.checkDocuments(ctx, req.GetDocumentIds()); err != nil {
+		return nil, err
+	}
+	spec := validation.ExamSpecFromRequest(req)
+	if err := validation.CheckBloomDistribution(req.GetBloomDistribution(), spec.QuestionCount); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
 
 	chargeCode, err := h.checkCanCall(ctx, constants.F1_SUGGEST_EXAM)
 	if err != nil {
 		return nil, err
 	}
+
+	var generateOpts []llmManager.GenerateOption
+	if temperature, ok := creativityToTemperature(req.GetCreativity()); ok {
+		generateOpts = append(generateOpts, llmManager.WithTemperature(temperature))
+	}
+
+	var exam *suggest.SuggestExamQuestionResponseV2
+	if threshold := h.config.ExamChunking.Threshold; threshold > 0 && spec.QuestionCount > threshold {
+		questions, err := h.generateExamInChunks(ctx, req, spec, generateOpts...)
+		if err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNetworkConnection)
+		}
+		violations := append(validation.ValidateExam(questions, spec), codeCheckViolations(questions)...)
+		if h.config.AnswerKey.Enabled {
+			violations = append(violations, answerKeyViolations(questions, h.config.AnswerKey.MinConfidence)...)
+		}
+		if h.config.TagCheck.Enabled {
+			violations = append(violations, tagViolations(questions, h.config.TagCheck.MinutesRatio)...)
+		}
+		exam = &suggest.SuggestExamQuestionResponseV2{
+			Questions:  questions,
+			Violations: toProtoViolations(violations),
+		}
+	} else if exam, err = h.generateExam(ctx, req, spec, generateOpts...); err != nil {
+		return nil, err
+	}
+	exam.BloomReport = validation.NewBloomReport(exam.GetQuestions(), spec.BloomCounts)
+
+	// Charge the user for the LLM call
+	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+		log.Printf("[SuggestExamQuestion] Charge Code %s failed to charge for LLM call", chargeCode)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+	}
+
+	return exam, nil
+}
+
+// generateExam generates the whole exam with a single prompt and repairs it. CODE questions
+// whose reference solution still fails are left out.
+func (h *handler) generateExam(ctx context.Context, req *suggest.SuggestExamQuestionRequest, spec validation.ExamSpec, generateOpts ...llmManager.GenerateOption) (*suggest.SuggestExamQuestionResponseV2, error) {
+	prompt := h.examQuestionPrompt(ctx, req)
+
+	conversationId, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_EXAM, prompt, req.GetRequestKey(), nil, generateOpts...)
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNetworkConnection)
+	}
+	// Convert the parsed response to the expected format
+	var exam = &suggest.SuggestExamQuestionResponseV2{}
+	if err := llmjson.UnmarshalProto(llmResponse, exam); err != nil {
+		log.Printf("[SuggestExamQuestion] error parsing response: %v", err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, err.Error())
+	}
+
+	questions, violations := h.repairExamQuestions(ctx, req.GetRequestKey(), conversationId, exam.GetQuestions(), spec, generateOpts...)
+	questions, violations = dropFailingCode(questions, violations, spec)
+	exam.Questions = questions
+	exam.Violations = toProtoViolations(violations)
+	return exam, nil
+}
+
+// examQuestionPrompt builds the exam generation prompt from the Missfortune question
+// content, falling back to a prompt listing the requested topic/level breakdown. Exams
+// grounded in documents get the passages relevant to their topics, and exams with existing
+// questions the exemplars to follow and the questions not to repeat. The request is left
+// as it is.
+func (h *handler) examQuestionPrompt(ctx context.Context, req *suggest.SuggestExamQuestionRequest) string {
+	references, imported := h.referenceSection(ctx, req), importedSection(req)
 	log.Printf("[MFT] req: %+v", converters.ConvertExamRequestToMissfortuneRequest(ctx, req))
 	questionsContents, err := h.missfortune.GetExamQuestionContent(ctx, converters.ConvertExamRequestToMissfortuneRequest(ctx, req))
 	prompt := ""
@@ -64,9 +145,18 @@ func (h *handler) SuggestExamQuestionV2(ctx context.Context, req *suggest.Sugges
 				questionCount += int(topic.GetDifficultyDistribution().GetExpert())
 			}
 		}
-		req.Topics = nil // Clear topics to avoid duplication in the prompt
+		typeSpec := questionTypeSpec(req.GetQuestionType(), req.GetTypeRatio(), req.GetCodeLanguage(), questionCount)
+		typeSpec.BloomCounts = validation.BloomCounts(req.GetBloomDistribution())
+		typeSection := questionTypeSection(typeSpec)
+		// The requirements are printed from a copy, the caller still needs the request.
+		// Exemplars, exclusions and the Bloom distribution are listed in their own sections,
+		// topics in the breakdown, and creativity is applied as the sampling temperature.
+		requirements := proto.Clone(req).(*suggest.SuggestExamQuestionRequest)
+		requirements.Exemplars, requirements.ExcludedQuestions, requirements.BloomDistribution = nil, nil, nil
+		requirements.Topics = nil
+		requirements.Creativity = 0
 		prompt = fmt.Sprintf(`
-You are an expert exam question designer. Your task is to generate exactly **%v diverse and high-quality exam questions** based on the structured requirements below. Each question must be either a multiple-choice question (MCQ) or a long-answer (essay-style) question.
+You are an expert exam question designer. Your task is to generate exactly **%v diverse and high-quality exam questions** based on the structured requirements below. Each question must be of one of the question types described below.
 
 ---
 
@@ -76,16 +166,18 @@ You are an expert exam question designer. Your task is to generate exactly **%v
 
 ---
 
+%v
+---
+
 🧠 Reasoning Steps (Quality Assurance):
-1. Generate a distinct and relevant idea for each question based on its topic and level that match with the question type.
-	- If questionType is "MCQ", generate all questions as Multiple Choice Questions (MCQ).
-	- If questionType is "LONG_ANSWER", generate all questions as Essay-style questions (LONG_ANSWER).
-	- If questionType is "MIXED", generate a balanced mix of MCQ and LONG_ANSWER questions.
+1. Generate a distinct and relevant idea for each question based on its topic and level that match with the question type. Set "topic" and "level" of every question to the topic and level of its row of the breakdown, spelled exactly as in the breakdown.
 2. Ensure all %v questions are unique in wording and intent (no duplication).
 3. For MCQs:
    - Provide exactly 4 options.
    - All options must be grammatically aligned, factually plausible, and **clearly distinct** from one another.
    - One option must be clearly correct, indicated by "correctOption" (index 0–3).
+   - Explain every option in "optionExplanations", in the same order as "options": why it is correct, or why it is wrong.
+   - Tag every wrong option with the misconception it targets in "misconception", a short kebab-case tag such as "off-by-one"; leave it empty for the correct option.
 4. For Long Answer:
    - Require deep reasoning, explanation, or comparison.
    - Include a clear, complete expected answer ("correctAnswer").
@@ -94,16 +186,17 @@ You are an expert exam question designer. Your task is to generate exactly **%v
 ---
 
 🔁 Final Validation (Self-Verification):
-- Confirm that exactly %v questions are generated, matching the exact breakdown.
+- Confirm that exactly %v questions are generated, matching the exact breakdown, each labelled with its topic and level.
 - Confirm that **no two questions are identical or overlapping** in content.
 - Confirm that all questions are relevant to the specified topics and levels.
 - Confirm that all questions must match the specified question type.
+- Confirm that every MCQ option has an explanation and every wrong option a misconception tag.
 - Confirm that output is valid JSON, with no notes, markdown, or trailing commas.
 
 ---
 
 📤 Output Format:
-Return only a valid JSON object structured like this:
+Return only a valid JSON object structured like this, with every "detail" shaped as described for its question type:
 
 {
   "questions": [
@@ -113,10 +206,20 @@ Return only a valid JSON object structured like this:
       "text": "Question text here",
       "points": 2,
       "type": "MCQ",
+      "topic": "Topic name",
+      "level": "Junior",
+      "bloomLevel": "APPLY",
+      "estimatedMinutes": 1.5,
       "detail": {
         "type": "MCQ",
         "options": ["A", "B", "C", "D"],
-        "correctOption": 2
+        "correctOption": 2,
+        "optionExplanations": [
+          {"explanation": "Why A is wrong", "misconception": "off-by-one"},
+          {"explanation": "Why B is wrong", "misconception": "confuses-stack-and-queue"},
+          {"explanation": "Why C is correct", "misconception": ""},
+          {"explanation": "Why D is wrong", "misconception": "ignores-nil-case"}
+        ]
       }
     },
     {
@@ -125,6 +228,10 @@ Return only a valid JSON object structured like this:
       "text": "Question text here",
       "points": 5,
       "type": "LONG_ANSWER",
+      "topic": "Topic name",
+      "level": "Senior",
+      "bloomLevel": "EVALUATE",
+      "estimatedMinutes": 8,
       "detail": {
         "type": "LONG_ANSWER",
         "imageLinks": [],
@@ -136,34 +243,21 @@ Return only a valid JSON object structured like this:
   ]
 }
 Now, generate questions base on the following requirements: %v
-	`, questionCount, instruction, questionCount, questionCount, questionCount, req)
+	`, questionCount, instruction, questionCount, typeSection, questionCount, questionCount, requirements)
 	} else {
-		prompt = generateOptionsPrompt(questionsContents)
+		prompt = generateOptionsPrompt(questionsContents, questionTypeSection(validation.ExamSpecFromRequest(req)))
 	}
 
-	_, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_EXAM, prompt, req.GetRequestKey(), nil)
-	if err != nil {
-		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNetworkConnection)
-	}
-	parsedResponse, err := sanitizeJSON(llmResponse)
-	if err != nil {
-		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONParsing)
-	}
-	// Convert the parsed response to the expected format
-	var exam = &suggest.SuggestExamQuestionResponseV2{}
-	err = json.Unmarshal([]byte(parsedResponse), &exam)
-	if err != nil {
-		log.Printf("[SuggestExamQuestion] error unmarshalling JSON: %v", err)
-		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONUnmarshalling)
-	}
+	return prompt + references + imported
+}
 
-	// Charge the user for the LLM call
-	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
-		log.Printf("[SuggestExamQuestion] Charge Code %s failed to charge for LLM call", chargeCode)
-		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+// creativityToTemperature maps the 1–10 creativity scale of the request onto a sampling
+// temperature between 0.2 and 1.0. Values outside the scale keep the routed default.
+func creativityToTemperature(creativity int32) (float32, bool) {
+	if creativity < 1 || creativity > 10 {
+		return 0, false
 	}
-
-	return exam, nil
+	return 0.2 + float32(creativity-1)*0.8/9, true
 }
 
 func (h *handler) checkCanCall(ctx context.Context, llmCaller string) (string, error) {
@@ -3,11 +3,14 @@ package handler
 import (
 	"context"
 	"darius/internal/errors"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 type mockBulbasaur struct {
@@ -145,3 +148,39 @@ func Test_handleErrorWithStatusCode(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "Network connection error")
 }
+
+func Test_creativityToTemperature(t *testing.T) {
+	tests := []struct {
+		creativity  int32
+		temperature float32
+		ok          bool
+	}{
+		{creativity: 0, ok: false},
+		{creativity: 11, ok: false},
+		{creativity: 1, temperature: 0.2, ok: true},
+		{creativity: 10, temperature: 1.0, ok: true},
+	}
+
+	for _, tt := range tests {
+		temperature, ok := creativityToTemperature(tt.creativity)
+		assert.Equal(t, tt.ok, ok)
+		assert.InDelta(t, tt.temperature, temperature, 0.0001)
+	}
+}
+
+func Test_examQuestionPrompt_KeepsRequest(t *testing.T) {
+	h, _, ctx := newTestHandler(Config{})
+	req := &suggest.SuggestExamQuestionRequest{
+		Language:          "English",
+		QuestionType:      validation.QuestionTypeMCQ,
+		Creativity:        7,
+		Topics:            []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+		BloomDistribution: &suggest.BloomDistribution{Remember: 2},
+		ExcludedQuestions: []*suggest.SuggestExamQuestionResponseV2_Quetion{{Text: "Which keyword starts a goroutine?"}},
+	}
+	original := proto.Clone(req)
+
+	prompt := h.examQuestionPrompt(ctx, req)
+	assert.Contains(t, prompt, "- Topic: **Go**, Level: **Junior**, Quantity: **2**")
+	assert.True(t, proto.Equal(original, req))
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"darius/internal/constants"
 	"darius/internal/errors"
+	"darius/internal/llmjson"
+	"darius/internal/similarity"
 	"darius/pkg/proto/suggest"
-	"encoding/json"
 	"fmt"
+	"log"
+
+	"github.com/google/uuid"
 )
 
 func (h *handler) SuggestOutlines(ctx context.Context, req *suggest.SuggestOutlinesRequest) (*suggest.SuggestOutlinesResponse, error) {
@@ -37,19 +41,33 @@ Output format:
     "Third new outline idea (optional)"
   ]
 }`, req.GetTitle(), req.GetDescription(), req.GetDifficulty(), req.GetTags(), req.GetOutlines())
-	_, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_OUTLINES, prompt, "", nil)
+	// The request carries no key, its calls are reported under one made up for it.
+	requestKey := uuid.New().String()
+	_, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_OUTLINES, prompt, requestKey, nil)
 	if err != nil {
 		return nil, errors.Error(errors.ErrNetworkConnection)
 	}
-	parsedResponse, err := sanitizeJSON(llmResponse)
-	if err != nil {
-		return nil, errors.Error(errors.ErrJSONParsing)
-	}
 	// Convert the parsed response to the expected format
 	var outlines = &suggest.SuggestOutlinesResponse{}
-	err = json.Unmarshal([]byte(parsedResponse), &outlines)
-	if err != nil {
-		return nil, errors.Error(errors.ErrJSONUnmarshalling)
+	if err := llmjson.Unmarshal(llmResponse, outlines); err != nil {
+		return nil, err
+	}
+
+	suggested := outlines.GetOutlines()
+	outlines.Outlines = similarity.Filter(suggested, req.GetOutlines(), h.config.DuplicateThreshold)
+	if len(outlines.GetOutlines()) == 0 && len(suggested) > 0 {
+		// Everything repeated an existing outline, ask once more with the rejects listed.
+		log.Printf("[SuggestOutlines] all %d suggested outlines repeat existing ones, retrying", len(suggested))
+		retryPrompt := prompt + fmt.Sprintf("\n\nThese outlines were already suggested and are too close to the existing ones, do not suggest them or anything like them again:\n%v", suggested)
+		_, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_OUTLINES, retryPrompt, subRequestKey(requestKey, "retry"), nil)
+		if err != nil {
+			return nil, errors.Error(errors.ErrNetworkConnection)
+		}
+		retried := &suggest.SuggestOutlinesResponse{}
+		if err := llmjson.Unmarshal(llmResponse, retried); err != nil {
+			return nil, err
+		}
+		outlines.Outlines = similarity.Filter(retried.GetOutlines(), append(append([]string(nil), req.GetOutlines()...), suggested...), h.config.DuplicateThreshold)
 	}
 
 	return outlines, nil
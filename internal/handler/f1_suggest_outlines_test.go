@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SuggestOutlines_DropsDuplicates(t *testing.T) {
+	llm := &mockLLMManager{responses: []string{
+		`{"outlines": ["Channels and goroutines", "Error handling patterns", "Patterns for handling errors"]}`,
+	}}
+	h := &handler{llmManager: llm, config: Config{DuplicateThreshold: 0.8}}
+
+	resp, err := h.SuggestOutlines(context.Background(), &suggest.SuggestOutlinesRequest{
+		Title:    "Go backend",
+		Outlines: []string{"Goroutines and channels"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Error handling patterns"}, resp.GetOutlines())
+	assert.Len(t, llm.prompts, 1)
+}
+
+func Test_SuggestOutlines_RetriesWhenAllRepeat(t *testing.T) {
+	llm := &mockLLMManager{responses: []string{
+		`{"outlines": ["Channels and goroutines"]}`,
+		`{"outlines": ["The goroutine and the channel", "Profiling with pprof"]}`,
+	}}
+	h := &handler{llmManager: llm, config: Config{DuplicateThreshold: 0.8}}
+
+	resp, err := h.SuggestOutlines(context.Background(), &suggest.SuggestOutlinesRequest{
+		Title:    "Go backend",
+		Outlines: []string{"Goroutines and channels"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Profiling with pprof"}, resp.GetOutlines())
+	assert.Contains(t, llm.prompts[1], "Channels and goroutines")
+	assert.NotEmpty(t, llm.requestKeys[0])
+	assert.Equal(t, llm.requestKeys[0]+"#retry", llm.requestKeys[1])
+}
@@ -2,27 +2,29 @@ package handler
 
 import (
 	"context"
-	ctxdata "darius/ctx"
 	"darius/internal/constants"
 	"darius/internal/converters"
 	"darius/internal/errors"
-	llm "darius/internal/services/llm"
+	"darius/internal/llmjson"
+	"darius/internal/similarity"
+	"darius/internal/validation"
+	jobManager "darius/managers/job"
+	"darius/models"
 	"darius/pkg/proto/suggest"
-	"encoding/json"
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
-	"unicode"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
-func generateOptionsPrompt(questionsContent interface{}) string {
+func generateOptionsPrompt(questionsContent interface{}, typeSection string) string {
 	return fmt.Sprintf(`
 	You are an expert in designing high-quality standardized multiple-choice exam content.
-	 You will receive a list of questions, your task is define the type of questions are MCQ (Multiple Choice Questions) and LONG_ANSWER (Essay-style questions) based on the provided content.
-	With MCQ questions, your task is to generate **exactly 4 answer options**, with LONG_ANSWER questions, your task is to provide a detailed answer with illustrative image links if applicable.
+	 You will receive a list of questions, your task is define the type of each question, from the question types described below, based on the provided content.
+	With MCQ questions, your task is to generate **exactly 4 answer options**, with LONG_ANSWER questions, your task is to provide a detailed answer with illustrative image links if applicable, with the other types, your task is to write the answer key described for that type.
 	---
 	📥 Input Format:
 	You will receive a JSON object with the following structure:
@@ -45,12 +47,17 @@ func generateOptionsPrompt(questionsContent interface{}) string {
 	   - For MCQ questions, create **exactly 4 unique options**:
 	   		+ Ensure one option is the **correct answer** and the other three are **plausible but incorrect**.
 			+ Ensure the correct answer is placed at a random index (from 0 to 3), and record that index in the "correctOption" field.
+			+ Explain every option in "optionExplanations", in the same order as the options: why it is correct, or why it is wrong.
+			+ Tag every wrong option with the misconception that makes it tempting in "misconception", a short kebab-case tag such as "off-by-one"; leave it empty for the correct option.
 	   - For LONG_ANSWER questions, provide a detailed answer and illustrative image links if applicable.
 	4. **Ensure diversity** in the options:
 	   - Options must be **grammatically and semantically consistent** with the question.
 	5. Use the "points" field to reflect question difficulty (e.g., Easy = 1–3, Medium = 4–6, Hard = 7–10).
 	
 	---
+
+	%v
+	---
 	
 	📤 Output Format:
 	Respond with a valid **strict JSON object** that adheres to the following Protobuf-compatible schema:
@@ -62,10 +69,18 @@ func generateOptionsPrompt(questionsContent interface{}) string {
       "text": "Question text here",
       "points": 2,
       "type": "MCQ",
+      "bloomLevel": "APPLY",
+      "estimatedMinutes": 1.5,
       "detail": {
         "type": "MCQ",
         "options": ["A", "B", "C", "D"],
-        "correctOption": 2
+        "correctOption": 2,
+        "optionExplanations": [
+          {"explanation": "Why A is wrong", "misconception": "off-by-one"},
+          {"explanation": "Why B is wrong", "misconception": "confuses-stack-and-queue"},
+          {"explanation": "Why C is correct", "misconception": ""},
+          {"explanation": "Why D is wrong", "misconception": "ignores-nil-case"}
+        ]
       }
     },
     {
@@ -74,6 +89,8 @@ func generateOptionsPrompt(questionsContent interface{}) string {
       "text": "Question text here",
       "points": 5,
       "type": "LONG_ANSWER",
+      "bloomLevel": "EVALUATE",
+      "estimatedMinutes": 8,
       "detail": {
         "type": "LONG_ANSWER",
         "imageLinks": [],
@@ -89,50 +106,69 @@ func generateOptionsPrompt(questionsContent interface{}) string {
 	🔁 Final Validation (Self-Verification):
 - Confirm that **no two questions are identical or overlapping** in content.
 - Confirm that all MCQs have 4 distinct options with only one correct.
+- Confirm that every MCQ option has an explanation and every wrong option a misconception tag.
 - Confirm that output is valid JSON, with no notes, markdown, or trailing commas.
 
 	Now, based on the following input, generate the answer options:
 	%v
-		`, questionsContent)
+		`, typeSection, questionsContent)
 }
 
 func (h *handler) SuggestQuestions(ctx context.Context, req *suggest.SuggestQuestionsRequest) (*suggest.SuggestExamQuestionResponseV2, error) {
 	if req.GetQuestionType() == "" || len(req.GetQuestionType()) == 0 {
 		req.QuestionType = "MIXED" //default question type
 	}
+	if err := validation.CheckQuestionType(req.GetQuestionType(), req.GetTypeRatio()); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
 
 	if req.GetRequestKey() != "" && len(req.GetRequestKey()) != 0 {
-
-		respStr, err := h.llmManager.GetByRequestKey(ctx, req.GetRequestKey())
+		job, err := h.jobManager.Get(ctx, req.GetRequestKey())
 		if err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, err.Error())
+		}
+		// The questions and the error of a job are as private as the job itself.
+		if err := checkJobOwner(ctx, job); err != nil {
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNotFound)
+		}
+		if job.Status == models.JobStatusFailed {
+			return nil, h.handleErrorWithStatusCode(ctx, err, job.ErrorMessage)
+		}
+		if job.Status != models.JobStatusSucceeded {
 			return &suggest.SuggestExamQuestionResponseV2{
 				RequestKey: req.GetRequestKey(),
 				Questions:  nil,
 			}, nil
 		}
 
-		input := respStr
-
-		jsonStr, err := extractJSONQuestions(input)
-		if err != nil {
-			fmt.Println("[SuggestQuestions] error extract Json questions:", err)
-			return nil, errors.Error(errors.ErrJSONParsing)
+		resp := &suggest.SuggestExamQuestionResponseV2{}
+		if err := protojson.Unmarshal([]byte(job.Result), resp); err != nil {
+			log.Printf("[SuggestQuestions] error unmarshalling result of %s: %v", req.GetRequestKey(), err)
+			return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONUnmarshalling)
 		}
+		return resp, nil
+	}
 
-		questionListResp, err := parseQuestions(jsonStr)
-		if err != nil {
-			fmt.Println("[SuggestQuestions] error parse questions", err)
-			return nil, errors.Error(errors.ErrJSONUnmarshalling)
-		}
-
-		questionListResp.RequestKey = req.GetRequestKey()
-
-		return questionListResp, nil
+	var enqueueOpts []jobManager.EnqueueOption
+	if req.GetCallbackUrl() != "" {
+		// The job manager rejects callback urls that aren't public http(s) urls.
+		enqueueOpts = append(enqueueOpts, jobManager.WithCallback(req.GetCallbackUrl(), req.GetCallbackSecret()))
+		// The callback lives on the job, keep the secret out of the stored payload.
+		req.CallbackUrl = ""
+		req.CallbackSecret = ""
 	}
 
 	req.RequestKey = uuid.New().String()
-	clonedCtx := ctxdata.CloneContextWithValues(ctx)
-	go h.f1_generate(clonedCtx, req)
+	payload, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrInvalidInput)
+	}
+	if _, err := h.jobManager.Enqueue(ctx, constants.F1_SUGGEST_QUESTIONS, req.GetRequestKey(), string(payload), enqueueOpts...); err != nil {
+		if code := err.Error(); code == errors.ErrInvalidInput || code == errors.ErrGeneral {
+			return nil, h.handleErrorWithStatusCode(ctx, err, code)
+		}
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrDatabaseConnection)
+	}
 
 	return &suggest.SuggestExamQuestionResponseV2{
 		RequestKey: req.GetRequestKey(),
@@ -140,30 +176,110 @@ func (h *handler) SuggestQuestions(ctx context.Context, req *suggest.SuggestQues
 	}, nil
 }
 
-func (h *handler) f1_generate(ctx context.Context, req *suggest.SuggestQuestionsRequest) error {
+// RunSuggestQuestionsJob is the job handler behind SuggestQuestions. The questions are kept
+// on the job for SuggestQuestions to return.
+func (h *handler) RunSuggestQuestionsJob(ctx context.Context, job *models.Job) error {
+	req := &suggest.SuggestQuestionsRequest{}
+	if err := protojson.Unmarshal([]byte(job.Payload), req); err != nil {
+		log.Printf("[SuggestQuestions] error unmarshalling job payload: %v", err)
+		return errors.Error(errors.ErrInvalidInput)
+	}
 
-	chargeCode, err := h.checkCanCall(ctx, constants.F1_SUGGEST_QUESTIONS)
+	// Reports are unique per key, so a retried job asks again under keys of its own attempt.
+	requestKey := req.GetRequestKey()
+	if job.Attempts > 1 {
+		requestKey = subRequestKey(requestKey, "attempt.%d", job.Attempts)
+	}
+	questions, err := h.f1_generate(ctx, req, requestKey)
 	if err != nil {
 		return err
 	}
 
+	result, err := protojson.Marshal(&suggest.SuggestExamQuestionResponseV2{
+		RequestKey: req.GetRequestKey(),
+		Questions:  questions,
+	})
+	if err != nil {
+		log.Printf("[SuggestQuestions] error marshalling questions of %s: %v", req.GetRequestKey(), err)
+		return errors.Error(errors.ErrGeneral)
+	}
+	job.Result = string(result)
+	return nil
+}
+
+func (h *handler) f1_generate(ctx context.Context, req *suggest.SuggestQuestionsRequest, requestKey string) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+
+	chargeCode, err := h.checkCanCall(ctx, constants.F1_SUGGEST_QUESTIONS)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Printf("[MFT] req: %+v", converters.ConvertSuggestQuestionRequestToMissFortuneRequest(ctx, req))
 
+	spec := questionTypeSpec(req.GetQuestionType(), req.GetTypeRatio(), "", int(req.GetNumberOfQuestions()))
+	spec.Language = req.GetLanguage()
+	spec.Excluded = req.GetExcludedQuestions()
+	typeSection := questionTypeSection(spec)
 	questionsContents, err := h.missfortune.GetExamQuestionContent(ctx, converters.ConvertSuggestQuestionRequestToMissFortuneRequest(ctx, req))
 	prompt := ""
 	if err != nil {
 		log.Printf("[SuggestQuestions] error getting exam question content: %v", err)
 
-		prompt = fmt.Sprintf(`
+		prompt = suggestQuestionsPrompt(req, typeSection)
+	} else {
+		prompt = generateOptionsPrompt(questionsContents, typeSection)
+	}
+	prompt += excludedSection(spec.Excluded)
+	questions, err := h.generateSuggestedQuestions(ctx, prompt, requestKey, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	questions = h.topUpSuggestedQuestions(ctx, req, requestKey, questions)
+	if n := int(req.GetNumberOfQuestions()); n > 0 && len(questions) > n {
+		questions = questions[:n]
+	}
+	for i, question := range questions {
+		question.Id = int32(i + 1)
+	}
+
+	if err := h.chargeSuggestQuestions(ctx, chargeCode); err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
+
+// generateSuggestedQuestions asks for one batch of questions and runs it through validation
+// and repair, like SuggestExamQuestionV2 does. Questions that are still broken after repair,
+// or that repeat one of the excluded questions of the spec, are dropped.
+func (h *handler) generateSuggestedQuestions(ctx context.Context, prompt, requestKey string, spec validation.ExamSpec) ([]*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	conversationId, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_SUGGEST_QUESTIONS, prompt, requestKey, nil)
+	if err != nil {
+		return nil, errors.Error(errors.ErrNetworkConnection)
+	}
+
+	batch := &suggest.SuggestExamQuestionResponseV2{}
+	if err := llmjson.UnmarshalProto(llmResponse, batch); err != nil {
+		log.Printf("[SuggestQuestions] error parsing questions of %s: %v", requestKey, err)
+		return nil, errors.Error(errors.ErrJSONParsing)
+	}
+
+	questions, violations := h.repairExamQuestions(ctx, requestKey, conversationId, batch.GetQuestions(), spec)
+	questions, _ = dropBrokenQuestions(questions, violations)
+	return h.dropDuplicateQuestions(questions, spec.Excluded), nil
+}
+
+// suggestQuestionsPrompt asks for questions from the metadata of the request alone, for
+// when missfortune has no question content.
+func suggestQuestionsPrompt(req *suggest.SuggestQuestionsRequest, typeSection string) string {
+	return fmt.Sprintf(`
 	You are an expert exam question designer. Your task is to generate diverse, non-redundant, high-quality set of exam questions based on the structured input below.
 	
 	Input Metadata: %v
 
+	%v
 	Generation Process (Chain-of-Thought Required):
-1. Carefully analyze the title, description, tags, question type and outlines to understand the full context and intended coverage:
-	- If questionType is "MCQ", generate all questions as Multiple Choice Questions (MCQ).
-	- If questionType is "LONG_ANSWER", generate all questions as Essay-style questions (LONG_ANSWER).
-	- If questionType is "MIXED", generate a balanced mix of MCQ and LONG_ANSWER questions.
+1. Carefully analyze the title, description, tags, question type and outlines to understand the full context and intended coverage.
 2. Brainstorm a diverse pool of possible questions aligned with the specified difficulty level, questionType and key topics.
 3. Filter questions to ensure:
    - Questions language must matches the input language.
@@ -175,6 +291,7 @@ func (h *handler) f1_generate(ctx context.Context, req *suggest.SuggestQuestions
    - Generate **exactly 4 unique options**.
    - Ensure only **1 correct answer** is clearly identifiable (correctOption index: 0–3).
    - Ensure all options are **plausible**, **grammatically consistent**, and **non-overlapping in meaning**.
+   - Explain every option in "optionExplanations", in the same order as the options, and tag every wrong option with the misconception that makes it tempting in "misconception", a short kebab-case tag such as "off-by-one".
 5. For LONG_ANSWER:
    - Provide at least one illustrative image link if applicable.
    - Include clear instructions and an ideal sample answer.
@@ -186,7 +303,7 @@ func (h *handler) f1_generate(ctx context.Context, req *suggest.SuggestQuestions
    - Output is a valid JSON object (no markdown, no explanations).
 
 	Output Format:
-	Return a single **valid JSON object** with the following format:
+	Return a single **valid JSON object** with the following format, with every "detail" shaped as described for its question type:
 {
   "questions": [
     {
@@ -195,10 +312,18 @@ func (h *handler) f1_generate(ctx context.Context, req *suggest.SuggestQuestions
       "text": "Question text goes here",
       "points": 5,
       "type": "MCQ",
+      "bloomLevel": "APPLY",
+      "estimatedMinutes": 1.5,
       "detail": {
         "type": "MCQ",
         "options": ["Option A", "Option B", "Option C", "Option D"],
-        "correctOption": 2
+        "correctOption": 2,
+        "optionExplanations": [
+          {"explanation": "Why A is wrong", "misconception": "off-by-one"},
+          {"explanation": "Why B is wrong", "misconception": "confuses-stack-and-queue"},
+          {"explanation": "Why C is correct", "misconception": ""},
+          {"explanation": "Why D is wrong", "misconception": "ignores-nil-case"}
+        ]
       }
     },
     {
@@ -207,6 +332,8 @@ func (h *handler) f1_generate(ctx context.Context, req *suggest.SuggestQuestions
       "text": "Question text goes here",
       "points": 10,
       "type": "LONG_ANSWER",
+      "bloomLevel": "EVALUATE",
+      "estimatedMinutes": 8,
       "detail": {
         "type": "LONG_ANSWER",
         "imageLinks": ["https://example.com/image1.png"],
@@ -227,184 +354,83 @@ func (h *handler) f1_generate(ctx context.Context, req *suggest.SuggestQuestions
 	- All outputs must be raw JSON without any explanations or comments.
 
 	Now, generate the questions based on the provided metadata.
-			`, req)
-	} else {
-		prompt = generateOptionsPrompt(questionsContents)
-	}
-	_, _, err = h.llmManager.Generate(ctx, constants.F1_SUGGEST_QUESTIONS, prompt, req.GetRequestKey(), nil)
-	if err != nil {
-		return errors.Error(errors.ErrNetworkConnection)
-	}
-
-	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
-		log.Printf("[SuggestQuestions] Charge Code %s failed to charge for LLM call", chargeCode)
-		return errors.Error(errors.ErrChargingFailed)
-	}
-
-	return nil
+			`, req, typeSection)
 }
 
-func (h *handler) SuggestOptions(ctx context.Context, req *suggest.SuggestOptionsRequest) (*suggest.SuggestOptionsResponse, error) {
-
-	return &suggest.SuggestOptionsResponse{
-		CriteriaList: &suggest.CriteriaEleResponse{
-			Criteria:   "criteria1",
-			OptionList: []string{"option1", "option2", "option3"},
-		},
-	}, nil
-}
-
-func (h *handler) SuggestCriteria(ctx context.Context, req *suggest.SuggestCriteriaRequest) (*suggest.SuggestCriteriaResponse, error) {
-	generalInfo := req.GetGeneralInfo()
-	if generalInfo == nil {
-		log.Println("generalInfo is nil")
-		return nil, nil
-	}
-
-	criteriaList := req.GetCriteriaList()
-	if criteriaList == nil {
-		return &suggest.SuggestCriteriaResponse{
-			CriteriaList: []*suggest.CriteriaEleResponse{
-				{
-					Criteria: "Test Subject Area",
-					OptionList: []string{
-						"Computer Networks",
-						"Hardware",
-						"Software Development"},
-				},
-				{
-					Criteria:   "Difficulty Level:",
-					OptionList: []string{"Beginner", "Intermediate", "Advanced"},
-				},
-				{
-					Criteria:   "Test Format:",
-					OptionList: []string{"Multiple Choice", "True/False", "Essay"},
-				},
-				{
-					Criteria:   "Test Duration:",
-					OptionList: []string{"30 minutes", "60 minutes", "90 minutes"},
-				},
-			},
-		}, nil
+// excludedSection lists questions the generated ones must not repeat.
+func excludedSection(excluded []string) string {
+	if len(excluded) == 0 {
+		return ""
 	}
+	return fmt.Sprintf("\nThese questions already exist. Do not repeat or paraphrase any of them:\n- %v\n", strings.Join(excluded, "\n- "))
+}
 
-	prompt := fmt.Sprintf(`
-You are an expert in designing tests and assessments. Your task is to analyze the provided input, which includes general information about the test and a list of criteria with the user's chosen options. Based on this input, suggest additional criteria and options that will help the user provide more detailed information for generating test questions. Follow these steps:
-1. Input Provided by the User:
-   - General Information:
-     %v
-   - Criteria List:
-     %v
-2. Your Task:
-   - Review the general information about the test to understand its context, purpose, and constraints.
-   - Analyze the list of criteria and the user's chosen options for each criterion.
-   - Suggest additional criteria and options that will help the user provide more detailed information for generating test questions. Ensure the suggestions are relevant to the test's context and align with the user's chosen options.
-   - Provide the output in the specified JSON format.
-
-3. Output Format:
-   [
-     {
-       criteria: '[Suggested Criterion 1]',
-       optionList: [
-         "[Suggested Option 1 for Criterion 1]",
-         "[Suggested Option 2 for Criterion 1]",
-       ]
-     },
-     {
-       criteria: '[Suggested Criterion 2]',
-       optionList: [
-         "[Suggested Option 1 for Criterion 2]",
-         "[Suggested Option 2 for Criterion 2]",
-       ]
-     },
-     ...
-   ]
-Now, based on the user's input, generate the output in the specified format.
-`, generalInfo, criteriaList)
-
-	llmResponse, err := h.llmService.Generate(ctx, &llm.LLMRequest{
-		Content: prompt,
-	})
+// topUpSuggestedQuestions asks again for the questions dropped as broken or duplicates, like
+// fillChunks does for exams, until the request has as many as it asked for or the rounds
+// run out. Every top-up is reported under its own key derived from requestKey and goes
+// through the same validation and repair as the first batch. A failed top-up leaves the
+// questions short.
+func (h *handler) topUpSuggestedQuestions(ctx context.Context, req *suggest.SuggestQuestionsRequest, requestKey string, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []*suggest.SuggestExamQuestionResponseV2_Quetion {
+	for round := 1; round <= maxTopUpRounds; round++ {
+		missing := int(req.GetNumberOfQuestions()) - len(questions)
+		if missing <= 0 {
+			break
+		}
+		log.Printf("[SuggestQuestions] top-up round %d for %d questions", round, missing)
 
-	input := llmResponse.Content
+		topUp := proto.Clone(req).(*suggest.SuggestQuestionsRequest)
+		topUp.NumberOfQuestions = int32(missing)
+		topUp.ExcludedQuestions = nil
 
-	jsonStr, err := extractJSONQuestions(input)
-	if err != nil {
-		fmt.Println("Lỗi:", err)
-		return nil, err
-	}
+		spec := questionTypeSpec(req.GetQuestionType(), req.GetTypeRatio(), "", missing)
+		spec.Language = req.GetLanguage()
+		spec.Excluded = append([]string(nil), req.GetExcludedQuestions()...)
+		for _, question := range questions {
+			spec.Excluded = append(spec.Excluded, question.GetText())
+		}
+		prompt := suggestQuestionsPrompt(topUp, questionTypeSection(spec)) + excludedSection(spec.Excluded)
 
-	// Parse JSON
-	criteriaResp, err := parseCriterias(jsonStr)
-	if err != nil {
-		fmt.Println("Lỗi:", err)
-		return nil, err
+		batch, err := h.generateSuggestedQuestions(ctx, prompt, subRequestKey(requestKey, "topup.%d", round), spec)
+		if err != nil {
+			log.Printf("[SuggestQuestions] error topping up %s: %v", req.GetRequestKey(), err)
+			break
+		}
+		questions = append(questions, batch...)
 	}
-
-	return &suggest.SuggestCriteriaResponse{
-		CriteriaList: criteriaResp,
-	}, nil
+	return questions
 }
 
-func extractJSONQuestions(input string) (string, error) {
-	start := strings.Index(input, "{")
-	end := strings.LastIndex(input, "}")
-	if start == -1 || end == -1 || start > end {
-		return "", errors.Error(errors.ErrJSONParsing)
+// dropDuplicateQuestions removes generated questions that repeat an earlier one or one of
+// the excluded questions.
+func (h *handler) dropDuplicateQuestions(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, excluded []string) []*suggest.SuggestExamQuestionResponseV2_Quetion {
+	texts := make([]string, len(questions))
+	for i, question := range questions {
+		texts[i] = question.GetText()
 	}
-	jsonStr := input[start : end+1]
-
-	return jsonStr, nil
-}
-
-func parseQuestions(jsonStr string) (*suggest.SuggestExamQuestionResponseV2, error) {
-	var questions *suggest.SuggestExamQuestionResponseV2
-	err := json.Unmarshal([]byte(jsonStr), &questions)
-	if err != nil {
-		return nil, errors.Error(errors.ErrJSONUnmarshalling)
+	duplicates := similarity.Duplicates(texts, excluded, h.config.DuplicateThreshold)
+	if len(duplicates) == 0 {
+		return questions
 	}
-	return questions, nil
-}
 
-func parseCriterias(jsonStr string) ([]*suggest.CriteriaEleResponse, error) {
-	var criterias []*suggest.CriteriaEleResponse
-	err := json.Unmarshal([]byte(jsonStr), &criterias)
-	if err != nil {
-		fmt.Println("parseCriterias: Lỗi giải mã JSON:", err)
-		return nil, fmt.Errorf("lỗi giải mã JSON: %v", err)
+	dropped := make(map[int]bool, len(duplicates))
+	for _, duplicate := range duplicates {
+		dropped[duplicate.Index] = true
 	}
-	return criterias, nil
-}
-
-func sanitizeJSON(jsonStr string) (string, error) {
-	reComment := regexp.MustCompile(`(?m)^\s*//.*$`)
-	cleaned := reComment.ReplaceAllString(jsonStr, "")
-
-	var builder strings.Builder
-	for _, r := range cleaned {
-		if r < 0x20 && r != '\n' && r != '\r' && r != '\t' {
-			continue
+	var unique []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for i, question := range questions {
+		if !dropped[i] {
+			unique = append(unique, question)
 		}
-		if !unicode.IsPrint(r) && r != '\n' && r != '\r' && r != '\t' {
-			continue
-		}
-		builder.WriteRune(r)
-	}
-	sanitized := builder.String()
-
-	if json.Valid([]byte(sanitized)) {
-		return sanitized, nil
 	}
+	log.Printf("[SuggestQuestions] dropped %d duplicate questions", len(duplicates))
+	return unique
+}
 
-	start := strings.IndexAny(sanitized, "{[")
-	end := strings.LastIndexAny(sanitized, "}]")
-	if start != -1 && end != -1 && end > start {
-		candidate := sanitized[start : end+1]
-		if json.Valid([]byte(candidate)) {
-			return candidate, nil
-		}
+func (h *handler) chargeSuggestQuestions(ctx context.Context, chargeCode string) error {
+	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+		log.Printf("[SuggestQuestions] Charge Code %s failed to charge for LLM call", chargeCode)
+		return errors.Error(errors.ErrChargingFailed)
 	}
 
-	fmt.Println("[SuggestQuestions] sanitizeJSON: Chuỗi JSON chứa ký tự không thể vệ sinh")
-	return "", errors.Error(errors.ErrJSONParsing)
+	return nil
 }
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/errors"
+	llmManager "darius/managers/llm"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// storingLLMManager keeps every answer under its request key, like the call reports do.
+type storingLLMManager struct {
+	mockLLMManager
+	answers map[string]string
+}
+
+func (m *storingLLMManager) Generate(ctx context.Context, entry, prompt, requestKey string, conversationId *uint64, opts ...llmManager.GenerateOption) (*uint64, string, error) {
+	id, answer, err := m.mockLLMManager.Generate(ctx, entry, prompt, requestKey, conversationId, opts...)
+	if err == nil {
+		m.answers[requestKey] = answer
+	}
+	return id, answer, err
+}
+
+func (m *storingLLMManager) GetByRequestKey(ctx context.Context, requestKey string) (string, error) {
+	if answer, ok := m.answers[requestKey]; ok {
+		return answer, nil
+	}
+	return "", errors.Error(errors.ErrNotFound)
+}
+
+func Test_SuggestQuestions_TopsUpDuplicates(t *testing.T) {
+	llm := &storingLLMManager{answers: map[string]string{}, mockLLMManager: mockLLMManager{responses: []string{
+		examResponse("Which keyword starts a goroutine?", "Explain how goroutines communicate over channels", "Explain how goroutines communicate over channels."),
+		`{"questions": []}`,
+		`{"questions": []}`,
+		examResponse("What does a buffered channel do when it is full?", "Which keyword starts a goroutine?"),
+		examResponse("This one was fine and must be ignored", "How do you stop a ticker?"),
+	}}}
+	req := &suggest.SuggestQuestionsRequest{
+		Title:             "Go concurrency",
+		Language:          "English",
+		QuestionType:      "MCQ",
+		Outlines:          []string{"Explain how goroutines communicate over channels"},
+		NumberOfQuestions: 3,
+		ExcludedQuestions: []string{"Which keyword starts a goroutine?"},
+		RequestKey:        "req-1",
+	}
+	payload, _ := protojson.Marshal(req)
+	job := &models.Job{RequestKey: "req-1", UserId: "123", Status: models.JobStatusSucceeded, Payload: string(payload)}
+	h := &handler{
+		llmManager:  llm,
+		missfortune: mockMissfortune{},
+		bulbasaur:   &mockBulbasaur{checkCallingLLMResult: "charge", chargeCallingLLMResult: true},
+		jobManager:  &mockJobManager{jobs: map[string]*models.Job{"req-1": job}},
+		config:      Config{DuplicateThreshold: 0.8},
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "123"))
+
+	assert.NoError(t, h.RunSuggestQuestionsJob(ctx, job))
+	// The excluded question and the repeat are dropped once the repairs fail and asked for
+	// again, while the question that matches its outline is kept. The top-up is repaired
+	// like the first batch.
+	assert.Equal(t, []string{"req-1", "req-1#repair.1", "req-1#repair.2", "req-1#topup.1", "req-1#topup.1#repair.1"}, llm.requestKeys)
+	assert.Contains(t, llm.prompts[0], "Do not repeat or paraphrase any of them:\n- Which keyword starts a goroutine?")
+	assert.Contains(t, llm.prompts[3], "- Which keyword starts a goroutine?\n- Explain how goroutines communicate over channels\n")
+
+	resp, err := h.SuggestQuestions(ctx, &suggest.SuggestQuestionsRequest{RequestKey: "req-1"})
+	assert.NoError(t, err)
+	var texts []string
+	var ids []int32
+	for _, question := range resp.GetQuestions() {
+		texts = append(texts, question.GetText())
+		ids = append(ids, question.GetId())
+	}
+	assert.Equal(t, []string{
+		"Explain how goroutines communicate over channels",
+		"What does a buffered channel do when it is full?",
+		"How do you stop a ticker?",
+	}, texts)
+	assert.Equal(t, []int32{1, 2, 3}, ids)
+
+	// Someone else polling the request key learns nothing about it.
+	other := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "456"))
+	_, err = h.SuggestQuestions(other, &suggest.SuggestQuestionsRequest{RequestKey: "req-1"})
+	assert.EqualError(t, err, errors.ErrNotFound)
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/errors"
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// TranslateExam translates the text of an exam into the target language. Questions whose
+// translation breaks the rules are translated again on the same conversation. A question
+// that is still left out or has its structure changed comes back untranslated, since its
+// answer key can't be trusted, and the violations say why.
+func (h *handler) TranslateExam(ctx context.Context, req *suggest.TranslateExamRequest) (*suggest.SuggestExamQuestionResponseV2, error) {
+	original := req.GetExam().GetQuestions()
+	if len(original) == 0 || strings.TrimSpace(req.GetTargetLanguage()) == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, stdErrors.New("exam or target language is empty"), errors.ErrInvalidInput)
+	}
+	// Translations are matched back to the questions by id.
+	ids := make(map[int32]bool, len(original))
+	for _, question := range original {
+		if ids[question.GetId()] {
+			return nil, h.handleErrorWithStatusCode(ctx, fmt.Errorf("question id %d is used twice", question.GetId()), errors.ErrInvalidInput)
+		}
+		ids[question.GetId()] = true
+	}
+
+	chargeCode, err := h.checkCanCall(ctx, constants.F1_TRANSLATE_EXAM)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := validation.TranslationSpec{Language: req.GetTargetLanguage(), Glossary: req.GetGlossary()}
+	conversationId, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_TRANSLATE_EXAM, translateExamPrompt(original, spec), req.GetRequestKey(), nil)
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNetworkConnection)
+	}
+	parsed := &suggest.SuggestExamQuestionResponseV2{}
+	if err := llmjson.UnmarshalProto(llmResponse, parsed); err != nil {
+		log.Printf("[TranslateExam] error parsing response: %v", err)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrJSONParsing)
+	}
+	translated := alignTranslation(original, parsed.GetQuestions(), nil)
+
+	violations := validation.ValidateTranslation(original, translated, spec)
+	for round := 0; round < maxRepairRounds && len(violations) > 0; round++ {
+		log.Printf("[TranslateExam] round %d, %d violations", round+1, len(violations))
+		convId, llmResponse, err := h.llmManager.Generate(ctx, constants.F1_TRANSLATE_EXAM, translationRepairPrompt(original, violations, spec), subRequestKey(req.GetRequestKey(), "repair.%d", round+1), conversationId)
+		if err != nil {
+			log.Printf("[TranslateExam] error generating repair: %v", err)
+			break
+		}
+		conversationId = convId
+
+		repaired := &suggest.SuggestExamQuestionResponseV2{}
+		if err := llmjson.UnmarshalProto(llmResponse, repaired); err != nil {
+			log.Printf("[TranslateExam] error parsing repair: %v", err)
+			continue
+		}
+		translated = alignTranslation(original, repaired.GetQuestions(), translated)
+		violations = validation.ValidateTranslation(original, translated, spec)
+	}
+
+	broken := make(map[int32]bool)
+	for _, violation := range violations {
+		if violation.Code == validation.CodeStructure {
+			broken[violation.QuestionId] = true
+		}
+	}
+	for i, question := range translated {
+		if question == nil || broken[question.GetId()] {
+			translated[i] = proto.Clone(original[i]).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+			continue
+		}
+		question.TestId, question.KeyCheck, question.TagCheck, question.CodeCheck = original[i].GetTestId(), original[i].GetKeyCheck(), original[i].GetTagCheck(), original[i].GetCodeCheck()
+	}
+
+	if !h.bulbasaur.ChargeCallingLLM(ctx, chargeCode) {
+		log.Printf("[TranslateExam] Charge Code %s failed to charge for LLM call", chargeCode)
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrChargingFailed)
+	}
+
+	return &suggest.SuggestExamQuestionResponseV2{
+		Questions:  translated,
+		RequestKey: req.GetRequestKey(),
+		Violations: toProtoViolations(violations),
+	}, nil
+}
+
+// alignTranslation lines the translated questions up with the original ones by id, over
+// the previous alignment. Questions with an id the exam doesn't have are ignored.
+func alignTranslation(original, questions, previous []*suggest.SuggestExamQuestionResponseV2_Quetion) []*suggest.SuggestExamQuestionResponseV2_Quetion {
+	aligned := make([]*suggest.SuggestExamQuestionResponseV2_Quetion, len(original))
+	copy(aligned, previous)
+	byId := make(map[int32]int, len(original))
+	for i, question := range original {
+		byId[question.GetId()] = i
+	}
+	for _, question := range questions {
+		if i, ok := byId[question.GetId()]; ok {
+			aligned[i] = question
+		}
+	}
+	return aligned
+}
+
+// translationQuestionsJSON marshals the questions for a prompt, without the key, tag and code
+// checks.
+func translationQuestionsJSON(questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []byte {
+	clean := make([]*suggest.SuggestExamQuestionResponseV2_Quetion, len(questions))
+	for i, question := range questions {
+		clean[i] = proto.Clone(question).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+		clean[i].KeyCheck, clean[i].TagCheck, clean[i].CodeCheck = nil, nil, nil
+	}
+	questionsJSON, _ := protojson.Marshal(&suggest.SuggestExamQuestionResponseV2{Questions: clean})
+	return questionsJSON
+}
+
+func translationRules(spec validation.TranslationSpec) string {
+	glossary := ""
+	if len(spec.Glossary) > 0 {
+		glossary = fmt.Sprintf("- Keep these terms exactly as written, untranslated: %v.\n", strings.Join(spec.Glossary, ", "))
+	}
+	return fmt.Sprintf(`- Translate only the human-readable text: "text", "options", "extraText", "correctAnswer", the "explanation" of every option, and the text of the other answer keys ("multiSelect" options, "fillInBlank" accepted answers, "matching" pairs, "ordering" items).
+- Keep everything else exactly as it is: "id", "testId", "type", "points", "correctOption", "correctOptions", "trueFalse", "caseSensitive", "imageLinks", the "misconception" tags, the whole "code" detail, and the length and order of every list.
+- Keep code as it is: anything inside backticks or triple-backtick fences, identifiers, commands and literals.
+- Keep every ___ blank of fill-in-the-blank questions.
+%v- Keep the meaning, difficulty and tone; do not add, drop or reorder information.`, glossary)
+}
+
+func translateExamPrompt(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec validation.TranslationSpec) string {
+	return fmt.Sprintf(`
+You are a professional translator of technical exams. Translate the exam below into **%v**.
+
+📌 Rules:
+%v
+
+📥 Exam:
+%s
+
+📤 Output Format:
+Return only a valid JSON object {"questions": [...]} with every question of the exam, translated, in exactly the same shape. No notes, markdown, or trailing commas.
+`, spec.Language, translationRules(spec), translationQuestionsJSON(questions))
+}
+
+func translationRepairPrompt(original []*suggest.SuggestExamQuestionResponseV2_Quetion, violations []validation.Violation, spec validation.TranslationSpec) string {
+	problems := make(map[int32][]string)
+	for _, violation := range violations {
+		problems[violation.QuestionId] = append(problems[violation.QuestionId], violation.Field+": "+violation.Message)
+	}
+
+	var offending []*suggest.SuggestExamQuestionResponseV2_Quetion
+	var problemList strings.Builder
+	for _, question := range original {
+		if _, ok := problems[question.GetId()]; ok {
+			offending = append(offending, question)
+			fmt.Fprintf(&problemList, "- Question id %d: %s\n", question.GetId(), strings.Join(problems[question.GetId()], "; "))
+		}
+	}
+
+	return fmt.Sprintf(`
+Some of your translations into **%v** are missing or break the rules. Translate **only** the original questions listed below again.
+
+📌 Problems:
+%v
+📥 Original questions:
+%s
+
+🔁 Rules:
+%v
+
+📤 Output Format:
+Return only a valid JSON object {"questions": [...]} containing the translated questions with their **original ids**. No notes, markdown, or trailing commas.
+`, spec.Language, problemList.String(), translationQuestionsJSON(offending), translationRules(spec))
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+const vietnameseExplanations = `"optionExplanations": [{"explanation": "go khởi chạy một goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer trì hoãn một lời gọi hàm", "misconception": "defer-is-async"}, {"explanation": "chan là một kiểu dữ liệu", "misconception": "chan-is-a-statement"}, {"explanation": "select chờ trên nhiều kênh", "misconception": "select-is-a-loop"}]`
+
+func translatedQuestionJSON(id, text, correct string) string {
+	return `{"id": ` + id + `, "text": "` + text + `", "type": "MCQ", "points": 2, "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": ` + correct + `, ` + vietnameseExplanations + `}}`
+}
+
+func translationExam() *suggest.SuggestExamQuestionResponseV2 {
+	exam := &suggest.SuggestExamQuestionResponseV2{}
+	_ = llmjson.UnmarshalProto(`{"questions": [`+
+		editedQuestionJSON("1", "Which keyword starts a goroutine?", "0")+`, `+
+		editedQuestionJSON("2", "Which statement waits on several channel operations?", "3")+`]}`, exam)
+	for _, question := range exam.Questions {
+		question.TestId = "test-1"
+	}
+	return exam
+}
+
+func Test_TranslateExam(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8},
+		`{"questions": [`+translatedQuestionJSON("1", "Từ khóa nào dùng để khởi chạy một goroutine?", "0")+`, `+translatedQuestionJSON("2", "Câu lệnh nào chờ trên nhiều thao tác với kênh?", "1")+`]}`,
+		`{"questions": [`+translatedQuestionJSON("2", "Câu lệnh nào chờ trên nhiều thao tác với kênh?", "3")+`]}`,
+	)
+
+	resp, err := h.TranslateExam(ctx, &suggest.TranslateExamRequest{Exam: translationExam(), TargetLanguage: "Vietnamese", Glossary: []string{"goroutine"}, RequestKey: "key"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetViolations())
+	assert.Len(t, llm.prompts, 2)
+	assert.Equal(t, []string{"key", "key#repair.1"}, llm.requestKeys)
+	assert.Contains(t, llm.prompts[0], "Keep these terms exactly as written, untranslated: goroutine.")
+	assert.Contains(t, llm.prompts[1], "- Question id 2: detail.correctOption: correctOption changed from 3 to 1")
+	assert.NotContains(t, llm.prompts[1], "Which keyword starts a goroutine?")
+
+	assert.Equal(t, "Từ khóa nào dùng để khởi chạy một goroutine?", resp.GetQuestions()[0].GetText())
+	assert.Equal(t, int32(3), resp.GetQuestions()[1].GetDetail().GetCorrectOption())
+	assert.Equal(t, "test-1", resp.GetQuestions()[1].GetTestId())
+}
+
+func Test_TranslateExam_KeepsBrokenQuestionsUntranslated(t *testing.T) {
+	h, _, ctx := newTestHandler(Config{DuplicateThreshold: 0.8},
+		`{"questions": [`+translatedQuestionJSON("1", "Từ khóa nào dùng để khởi chạy một goroutine?", "0")+`, `+translatedQuestionJSON("2", "Câu lệnh nào chờ trên nhiều thao tác với kênh?", "1")+`]}`,
+		`not json`,
+		`{"questions": []}`,
+	)
+
+	resp, err := h.TranslateExam(ctx, &suggest.TranslateExamRequest{Exam: translationExam(), TargetLanguage: "Vietnamese"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetViolations(), 1)
+	assert.Equal(t, validation.CodeStructure, resp.GetViolations()[0].GetCode())
+	assert.Equal(t, "Which statement waits on several channel operations?", resp.GetQuestions()[1].GetText())
+	assert.True(t, strings.HasPrefix(resp.GetQuestions()[0].GetText(), "Từ khóa"))
+
+	_, err = h.TranslateExam(ctx, &suggest.TranslateExamRequest{Exam: translationExam()})
+	assert.Error(t, err)
+}
+
+func Test_TranslateExam_KeepsChecks(t *testing.T) {
+	h, llm, ctx := newTestHandler(Config{DuplicateThreshold: 0.8},
+		`{"questions": [`+translatedQuestionJSON("1", "Từ khóa nào dùng để khởi chạy một goroutine?", "0")+`, `+strings.Replace(translatedQuestionJSON("2", "Câu lệnh nào chờ trên nhiều thao tác với kênh?", "3"), `"bloomLevel"`, `"tagCheck": {"bloomLevel": "REMEMBER", "reason": "hỏi một từ khóa"}, "bloomLevel"`, 1)+`]}`,
+	)
+	exam := translationExam()
+	tagCheck := &suggest.SuggestExamQuestionResponseV2_TagCheck{BloomLevel: validation.BloomRemember, EstimatedMinutes: 1, Reason: "it asks for a keyword"}
+	keyCheck := &suggest.SuggestExamQuestionResponseV2_KeyCheck{Confidence: 0.9, SolverOption: 3}
+	for _, question := range exam.Questions {
+		question.TagCheck, question.KeyCheck = tagCheck, keyCheck
+	}
+
+	resp, err := h.TranslateExam(ctx, &suggest.TranslateExamRequest{Exam: exam, TargetLanguage: "Vietnamese"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetViolations())
+	assert.Len(t, llm.prompts, 1)
+	assert.NotContains(t, llm.prompts[0], "it asks for a keyword")
+	assert.NotContains(t, llm.prompts[0], "keyCheck")
+
+	// The first question came back without its checks, the second with a translated reason.
+	for _, question := range resp.GetQuestions() {
+		assert.True(t, strings.HasPrefix(question.GetText(), "Từ khóa") || strings.HasPrefix(question.GetText(), "Câu lệnh"), question.GetText())
+		assert.True(t, proto.Equal(tagCheck, question.GetTagCheck()))
+		assert.True(t, proto.Equal(keyCheck, question.GetKeyCheck()))
+	}
+}
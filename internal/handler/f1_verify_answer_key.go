@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/llmjson"
+	"darius/internal/validation"
+	llmManager "darius/managers/llm"
+	"darius/pkg/proto/suggest"
+	"encoding/json"
+	"fmt"
+	"log"
+)
+
+// keyVerifier has every MCQ answered again in a separate, blind prompt that does not show
+// the answer key, and records on the question how likely its correctOption is to be right.
+// Questions are verified once per repair, only the ones the model rewrote are sent again.
+type keyVerifier struct {
+	llmManager    llmManager.Manager
+	minConfidence float32
+	requestKey    string
+	calls         int
+	verified      map[*suggest.SuggestExamQuestionResponseV2_Quetion]bool
+}
+
+// newKeyVerifier returns nil when answer key verification is turned off.
+func (h *handler) newKeyVerifier(requestKey string) *keyVerifier {
+	if !h.config.AnswerKey.Enabled {
+		return nil
+	}
+	return &keyVerifier{
+		llmManager:    h.llmManager,
+		minConfidence: h.config.AnswerKey.MinConfidence,
+		requestKey:    requestKey,
+		verified:      make(map[*suggest.SuggestExamQuestionResponseV2_Quetion]bool),
+	}
+}
+
+type solverAnswer struct {
+	Id         int32   `json:"id"`
+	Answer     int32   `json:"answer"`
+	Defensible []int32 `json:"defensible"`
+	Confidence float32 `json:"confidence"`
+	Reason     string  `json:"reason"`
+}
+
+// check solves the MCQs that were not verified yet in one call and reports an answer_key
+// violation for every MCQ whose key is less likely to be right than the configured minimum.
+// A failed solving call is logged and leaves the questions unverified.
+func (v *keyVerifier) check(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) []validation.Violation {
+	if v == nil {
+		return nil
+	}
+	var pending []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, question := range questions {
+		if question.GetType() == validation.QuestionTypeMCQ && !v.verified[question] {
+			question.KeyCheck = nil
+			pending = append(pending, question)
+		}
+	}
+
+	if len(pending) > 0 {
+		answers, err := v.solve(ctx, pending)
+		if err != nil {
+			log.Printf("[VerifyAnswerKey] error solving %d questions: %v", len(pending), err)
+		}
+		for i, question := range pending {
+			v.verified[question] = true
+			if answer, ok := answers[int32(i+1)]; ok {
+				question.KeyCheck = keyCheck(question, answer)
+			}
+		}
+	}
+	return answerKeyViolations(questions, v.minConfidence)
+}
+
+// solve returns the solver's answers by position of the question in questions, counting
+// from 1. The model ids of the questions may collide before the repair renumbers them.
+func (v *keyVerifier) solve(ctx context.Context, questions []*suggest.SuggestExamQuestionResponseV2_Quetion) (map[int32]solverAnswer, error) {
+	v.calls++
+	_, llmResponse, err := v.llmManager.Generate(ctx, constants.F1_VERIFY_ANSWER_KEY, blindSolvePrompt(questions), subRequestKey(v.requestKey, "verify.%d", v.calls), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Answers []solverAnswer `json:"answers"`
+	}
+	if err := llmjson.Unmarshal(llmResponse, &result); err != nil {
+		return nil, err
+	}
+	answers := make(map[int32]solverAnswer, len(result.Answers))
+	for _, answer := range result.Answers {
+		answers[answer.Id] = answer
+	}
+	return answers, nil
+}
+
+// keyCheck turns the solver's answer into a confidence that the key is right: the solver's
+// own confidence split over every option it found defensible when the key is one of them,
+// and whatever confidence the solver did not have in its own pick when the key is not.
+// It is nil when the solver picked an option the question doesn't have.
+func keyCheck(question *suggest.SuggestExamQuestionResponseV2_Quetion, answer solverAnswer) *suggest.SuggestExamQuestionResponseV2_KeyCheck {
+	options := int32(len(question.GetDetail().GetOptions()))
+	key := question.GetDetail().GetCorrectOption()
+	if answer.Answer < 0 || answer.Answer >= options {
+		log.Printf("[VerifyAnswerKey] dropping answer %d to question %d, it has %d options", answer.Answer, question.GetId(), options)
+		return nil
+	}
+
+	solverConfidence := answer.Confidence
+	if solverConfidence <= 0 || solverConfidence > 1 {
+		solverConfidence = 1
+	}
+
+	defensible := []int32{answer.Answer}
+	for _, option := range answer.Defensible {
+		if option >= 0 && option < options && !containsOption(defensible, option) {
+			defensible = append(defensible, option)
+		}
+	}
+
+	confidence := 1 - solverConfidence
+	if containsOption(defensible, key) {
+		confidence = solverConfidence / float32(len(defensible))
+	}
+	return &suggest.SuggestExamQuestionResponseV2_KeyCheck{
+		Confidence:        confidence,
+		SolverOption:      answer.Answer,
+		DefensibleOptions: defensible,
+		Reason:            answer.Reason,
+	}
+}
+
+// answerKeyViolations flags the MCQs whose key check is below minConfidence, saying what
+// the solver found so a repair knows what to fix.
+func answerKeyViolations(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, minConfidence float32) []validation.Violation {
+	var violations []validation.Violation
+	for _, question := range questions {
+		check := question.GetKeyCheck()
+		if check == nil || check.GetConfidence() >= minConfidence {
+			continue
+		}
+		key := question.GetDetail().GetCorrectOption()
+		var message string
+		switch {
+		case check.GetSolverOption() != key:
+			message = fmt.Sprintf("a blind solver picked option %d, not the correct option %d (%s); make sure exactly one option is correct and correctOption points to it", check.GetSolverOption(), key, check.GetReason())
+		case len(check.GetDefensibleOptions()) > 1:
+			message = fmt.Sprintf("a blind solver found options %v defensible (%s); make sure exactly one option is correct", check.GetDefensibleOptions(), check.GetReason())
+		default:
+			message = fmt.Sprintf("a blind solver was unsure that option %d is correct (%s); make the question and its correct option unambiguous", key, check.GetReason())
+		}
+		violations = append(violations, validation.Violation{
+			QuestionId: question.GetId(),
+			Field:      "detail.correctOption",
+			Code:       validation.CodeAnswerKey,
+			Message:    message,
+		})
+	}
+	return violations
+}
+
+func containsOption(options []int32, option int32) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func blindSolvePrompt(questions []*suggest.SuggestExamQuestionResponseV2_Quetion) string {
+	type blindQuestion struct {
+		Id      int32    `json:"id"`
+		Text    string   `json:"text"`
+		Options []string `json:"options"`
+	}
+	blind := make([]blindQuestion, 0, len(questions))
+	for i, question := range questions {
+		blind = append(blind, blindQuestion{Id: int32(i + 1), Text: question.GetText(), Options: question.GetDetail().GetOptions()})
+	}
+	questionsJSON, _ := json.Marshal(blind)
+
+	return fmt.Sprintf(`
+You are a domain expert taking an exam. Answer every multiple-choice question below on your own. Options are numbered from 0.
+
+📥 Questions:
+%s
+
+🔁 For every question give:
+- "answer": the index of the best option.
+- "defensible": the index of every option an expert could defend as correct, including "answer". List more than one only when the question really allows it.
+- "confidence": how sure you are of "answer", from 0 to 1.
+- "reason": one short sentence explaining "answer".
+
+📤 Output Format:
+Return only a valid JSON object {"answers": [{"id": 1, "answer": 2, "defensible": [2], "confidence": 0.9, "reason": "..."}]} with one entry per question id. No notes, markdown, or trailing commas.
+`, questionsJSON)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_keyCheck(t *testing.T) {
+	question := &suggest.SuggestExamQuestionResponseV2_Quetion{Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+		Options:       []string{"go", "defer", "chan", "select"},
+		CorrectOption: 1,
+	}}
+
+	tests := []struct {
+		name   string
+		answer solverAnswer
+		want   float32
+	}{
+		{name: "agrees", answer: solverAnswer{Answer: 1, Defensible: []int32{1}, Confidence: 0.9}, want: 0.9},
+		{name: "agrees without a confidence", answer: solverAnswer{Answer: 1}, want: 1},
+		{name: "two defensible options", answer: solverAnswer{Answer: 1, Defensible: []int32{1, 3, 9}, Confidence: 0.8}, want: 0.4},
+		{name: "disagrees", answer: solverAnswer{Answer: 2, Defensible: []int32{2}, Confidence: 0.75}, want: 0.25},
+		{name: "disagrees but the key is defensible", answer: solverAnswer{Answer: 2, Defensible: []int32{1}, Confidence: 1}, want: 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, keyCheck(question, tt.answer).GetConfidence(), 0.001)
+		})
+	}
+
+	// An option the question doesn't have says nothing about its key.
+	assert.Nil(t, keyCheck(question, solverAnswer{Answer: 4, Confidence: 1}))
+	assert.Nil(t, keyCheck(question, solverAnswer{Answer: -1, Confidence: 1}))
+}
+
+const keyExamResponse = `{"questions": [
+	{"id": 1, "text": "Which keyword starts a goroutine?", "type": "MCQ", "topic": "Go", "level": "Junior", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": 0, "optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]}},
+	{"id": 2, "text": "Which statement waits on several channel operations?", "type": "MCQ", "topic": "Go", "level": "Junior", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": 2, "optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]}}
+]}`
+
+// blindSolver answers the blind solving prompt like a model that knows Go, keyed by the
+// position of every question in the prompt, and anything else with the given responses in
+// order.
+func blindSolver(responses ...string) func(prompt string) string {
+	return func(prompt string) string {
+		if strings.Contains(prompt, "taking an exam") {
+			var answers []string
+			for _, line := range strings.Split(strings.ReplaceAll(prompt, "},{", "}\n{"), "\n") {
+				switch {
+				case strings.Contains(line, "starts a goroutine"):
+					answers = append(answers, fmt.Sprintf(`{"id": %d, "answer": 0, "defensible": [0], "confidence": 0.95, "reason": "go starts a goroutine"}`, len(answers)+1))
+				case strings.Contains(line, "waits on several"):
+					answers = append(answers, fmt.Sprintf(`{"id": %d, "answer": 3, "defensible": [3], "confidence": 0.9, "reason": "select waits on channels"}`, len(answers)+1))
+				}
+			}
+			return `{"answers": [` + strings.Join(answers, ", ") + `]}`
+		}
+		response := responses[0]
+		responses = responses[1:]
+		return response
+	}
+}
+
+func Test_generateExam_AnswerKey(t *testing.T) {
+	req := &suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: validation.QuestionTypeMCQ,
+		Topics:       []*suggest.Topic{{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2}}},
+	}
+
+	t.Run("regenerates a wrong key", func(t *testing.T) {
+		llm := &mockLLMManager{respond: blindSolver(keyExamResponse, `{"questions": [
+			{"id": 2, "text": "Which statement waits on several channel operations?", "type": "MCQ", "topic": "Go", "level": "Junior", "bloomLevel": "REMEMBER", "estimatedMinutes": 1, "detail": {"type": "MCQ", "options": ["go", "defer", "chan", "select"], "correctOption": 3, "optionExplanations": [{"explanation": "go starts a goroutine", "misconception": "go-is-a-thread"}, {"explanation": "defer delays a call", "misconception": "defer-is-async"}, {"explanation": "chan is a type", "misconception": "chan-is-a-statement"}, {"explanation": "select waits on channels", "misconception": "select-is-a-loop"}]}}
+		]}`)}
+		h := &handler{llmManager: llm, missfortune: mockMissfortune{}, config: Config{AnswerKey: AnswerKeyConfig{Enabled: true, MinConfidence: 0.6, Regenerate: true}}}
+
+		keyed := proto.Clone(req).(*suggest.SuggestExamQuestionRequest)
+		keyed.RequestKey = "key"
+		exam, err := h.generateExam(context.Background(), keyed, validation.ExamSpecFromRequest(keyed))
+		assert.NoError(t, err)
+		assert.Empty(t, exam.GetViolations())
+		// Generate, solve both, repair the second, solve only the repaired one.
+		assert.Len(t, llm.prompts, 4)
+		assert.Equal(t, []string{"key", "key#verify.1", "key#repair.1", "key#verify.2"}, llm.requestKeys)
+		assert.NotContains(t, llm.prompts[1], "correctOption")
+		assert.Contains(t, llm.prompts[2], "a blind solver picked option 3, not the correct option 2 (select waits on channels)")
+		assert.NotContains(t, llm.prompts[3], "starts a goroutine")
+		// The repaired question is asked for by its position in the prompt, not its id.
+		assert.Contains(t, llm.prompts[3], `{"id":1,"text":"Which statement waits on several channel operations?"`)
+
+		assert.Equal(t, int32(3), exam.GetQuestions()[1].GetDetail().GetCorrectOption())
+		assert.InDelta(t, 0.95, exam.GetQuestions()[0].GetKeyCheck().GetConfidence(), 0.001)
+		assert.InDelta(t, 0.9, exam.GetQuestions()[1].GetKeyCheck().GetConfidence(), 0.001)
+	})
+
+	t.Run("reports a wrong key", func(t *testing.T) {
+		llm := &mockLLMManager{respond: blindSolver(keyExamResponse)}
+		h := &handler{llmManager: llm, missfortune: mockMissfortune{}, config: Config{AnswerKey: AnswerKeyConfig{Enabled: true, MinConfidence: 0.6}}}
+
+		exam, err := h.generateExam(context.Background(), req, validation.ExamSpecFromRequest(req))
+		assert.NoError(t, err)
+		assert.Len(t, llm.prompts, 2)
+		assert.Len(t, exam.GetViolations(), 1)
+		assert.Equal(t, int32(2), exam.GetViolations()[0].GetQuestionId())
+		assert.Equal(t, validation.CodeAnswerKey, exam.GetViolations()[0].GetCode())
+		assert.Equal(t, []int32{3}, exam.GetQuestions()[1].GetKeyCheck().GetDefensibleOptions())
+	})
+}
@@ -3,13 +3,11 @@ package f2_score
 import (
 	"context"
 	"darius/internal/constants"
+	"darius/internal/llmjson"
 	llmManager "darius/managers/llm"
 	ekko "darius/pkg/proto/deps/ekko"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
-	"strings"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 	proto "google.golang.org/protobuf/encoding/protojson"
@@ -69,17 +67,9 @@ func (h *scoringHandler) Score(ctx context.Context, req *ScoreRequest) {
 }
 
 func sanitizeAndParseResponse(input string) (*ekko.EvaluationResponse, error) {
-	start := strings.Index(input, "{")
-	end := strings.LastIndex(input, "}")
-	if start == -1 || end == -1 || start > end {
-		return nil, errors.New("no JSON object found in input")
-	}
-	jsonStr := input[start : end+1]
-
 	var parsed ekko.EvaluationResponse
-	err := json.Unmarshal([]byte(jsonStr), &parsed)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	if err := llmjson.UnmarshalProto(input, &parsed); err != nil {
+		return nil, fmt.Errorf("error parsing response: %v", err)
 	}
 	return &parsed, nil
 }
@@ -3,12 +3,10 @@ package f2_score
 import (
 	"context"
 	"darius/internal/constants"
+	"darius/internal/llmjson"
 	"darius/pkg/proto/deps/ekko"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
-	"strings"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 	proto "google.golang.org/protobuf/encoding/protojson"
@@ -177,17 +175,9 @@ Now, based on the following input, return your evaluation:
 }
 
 func sanitizeAndParseResponseV2(input string) (*ekko.EvaluationResponseV2, error) {
-	start := strings.Index(input, "{")
-	end := strings.LastIndex(input, "}")
-	if start == -1 || end == -1 || start > end {
-		return nil, errors.New("[ScoreV2] no JSON object found in input")
-	}
-	jsonStr := input[start : end+1]
-
 	var parsed ekko.EvaluationResponseV2
-	err := json.Unmarshal([]byte(jsonStr), &parsed)
-	if err != nil {
-		return nil, fmt.Errorf("[ScoreV2] error unmarshalling JSON: %v", err)
+	if err := llmjson.UnmarshalProto(input, &parsed); err != nil {
+		return nil, fmt.Errorf("[ScoreV2] error parsing response: %v", err)
 	}
 	return &parsed, nil
 }
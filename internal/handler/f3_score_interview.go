@@ -4,12 +4,10 @@ import (
 	"context"
 	"darius/internal/constants"
 	"darius/internal/errors"
+	"darius/internal/llmjson"
 	suggest "darius/pkg/proto/suggest"
 	"fmt"
 	"log"
-	"strings"
-
-	proto "google.golang.org/protobuf/encoding/protojson"
 )
 
 // ScoreInterviewParseFunc implements ParseFunction for ScoreInterviewResponse
@@ -41,20 +39,10 @@ func (h *handler) ScoreInterview(ctx context.Context, req *suggest.ScoreIntervie
 }
 
 func sanitizeAndParseResponse(input string) (*suggest.ScoreInterviewResponse, error) {
-	// B1: Lấy JSON từ dấu { đầu tiên đến } cuối cùng. shit hello work workr
-	start := strings.Index(input, "{")
-	end := strings.LastIndex(input, "}")
-	if start == -1 || end == -1 || start > end {
-		log.Print("[ScoreInterview] Cannot parse to Json")
-		return nil, errors.Error(errors.ErrJSONParsing)
-	}
-	jsonStr := input[start : end+1]
-
 	var parsed suggest.ScoreInterviewResponse
-	err := proto.Unmarshal([]byte(jsonStr), &parsed)
-	if err != nil {
-		log.Printf("[ScoreInterview] Cannot Unmarshal Json into ScoreInterviewResponse : %v \n %v", err, jsonStr)
-		return nil, fmt.Errorf("Cannot Unmarshal Json into ScoreInterviewResponse: %v", err)
+	if err := llmjson.UnmarshalProto(input, &parsed); err != nil {
+		log.Printf("[ScoreInterview] Cannot parse response into ScoreInterviewResponse: %v", err)
+		return nil, err
 	}
 
 	return &parsed, nil
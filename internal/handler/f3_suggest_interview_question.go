@@ -4,12 +4,10 @@ import (
 	"context"
 	"darius/internal/constants"
 	"darius/internal/errors"
+	"darius/internal/llmjson"
 	"darius/pkg/proto/suggest"
-	"encoding/json"
 	"fmt"
 	"log"
-	"regexp"
-	"strings"
 )
 
 type SuggestInterviewQuestionParseFunc struct{}
@@ -28,7 +26,7 @@ func (h *handler) SuggestInterviewQuestion(ctx context.Context, req *suggest.Sug
 	prompt := generateSuggestInterviewQuestionPrompt(req, listOfPreviosQuestions)
 
 	parseFunc := SuggestInterviewQuestionParseFunc{}
-	result, err := h.retryCallLLM(ctx, constants.F3_SCORE_INTERVIEW_QUESTIONS, prompt, parseFunc)
+	result, err := h.retryCallLLM(ctx, constants.F3_SUGGEST_INTERVIEW_QUESTIONS, prompt, parseFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -43,51 +41,16 @@ func (h *handler) SuggestInterviewQuestion(ctx context.Context, req *suggest.Sug
 func convertToInterviewQuestionResponse(llmResponse string) (*suggest.SuggestInterviewQuestionResponse, error) {
 	input := llmResponse
 
-	jsonStr, err := extractAndSanitizeJSON(input)
-	if err != nil {
-		log.Println("[SuggestInterviewQuestion] error json parsing", err)
-		return nil, errors.Error(errors.ErrJSONParsing)
-	}
-
 	// Parse JSON
-	questionListResp, err := parseInterviewQuestions(jsonStr)
-	if err != nil {
-		log.Println("[SuggestInterviewQuestion] error json unmarshalling", err)
-		return nil, errors.Error(errors.ErrJSONUnmarshalling)
+	questionListResp := &suggest.SuggestInterviewQuestionResponse{}
+	if err := llmjson.Unmarshal(input, questionListResp); err != nil {
+		log.Println("[SuggestInterviewQuestion] error json parsing", err)
+		return nil, err
 	}
 
 	return questionListResp, nil
 }
 
-func extractAndSanitizeJSON(input string) (string, error) {
-	// Bước 1: Chuẩn hóa escape sequences
-	normalized := strings.ReplaceAll(input, `\n`, "\n")
-	normalized = strings.ReplaceAll(normalized, `\t`, "\t")
-
-	// Bước 2: Regex bắt JSON object hoặc array (không dùng đệ quy)
-	re := regexp.MustCompile(`(?s)(\{.*?\}|\[.*?\])`)
-	match := re.FindString(normalized)
-	if match == "" {
-		return "", errors.Error(errors.ErrJSONParsing)
-	}
-
-	// Bước 3: Kiểm tra tính hợp lệ
-	if !json.Valid([]byte(match)) {
-		return "", errors.Error(errors.ErrJSONParsing)
-	}
-
-	return match, nil
-}
-
-func parseInterviewQuestions(jsonStr string) (*suggest.SuggestInterviewQuestionResponse, error) {
-	var questions *suggest.SuggestInterviewQuestionResponse
-	err := json.Unmarshal([]byte(jsonStr), &questions)
-	if err != nil {
-		return nil, errors.Error(errors.ErrJSONUnmarshalling)
-	}
-	return questions, nil
-}
-
 func generateSuggestInterviewQuestionPrompt(req *suggest.SuggestInterviewQuestionRequest, listOfPreviosQuestions string) string {
 	return fmt.Sprintf(`
 You are an expert in creating high-quality, contextually appropriate interview questions. Your task is to generate the next **two interview questions** based on the provided interview information and previous questions. To ensure the questions are pedagogically sound, logically structured, and role-appropriate, follow a chain-of-thought process with controlled output logic.
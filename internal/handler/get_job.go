@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	ctxdata "darius/ctx"
+	"darius/internal/errors"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetJob returns the state of a job of the caller. Someone else's job is reported as not
+// found.
+func (h *handler) GetJob(ctx context.Context, req *suggest.GetJobRequest) (*suggest.GetJobResponse, error) {
+	if req.GetRequestKey() == "" {
+		return nil, h.handleErrorWithStatusCode(ctx, nil, errors.ErrInvalidInput)
+	}
+
+	job, err := h.jobManager.Get(ctx, req.GetRequestKey())
+	if err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, err.Error())
+	}
+	if err := checkJobOwner(ctx, job); err != nil {
+		return nil, h.handleErrorWithStatusCode(ctx, err, errors.ErrNotFound)
+	}
+
+	return toGetJobResponse(job), nil
+}
+
+// checkJobOwner fails unless the job was enqueued by the caller.
+func checkJobOwner(ctx context.Context, job *models.Job) error {
+	userId, _ := ctxdata.GetUserIdFromContext(ctx)
+	if userId == "" || job.UserId != userId {
+		log.Printf("[Job] user %q asked for job %s of another user", userId, job.RequestKey)
+		return fmt.Errorf("job %s not found", job.RequestKey)
+	}
+	return nil
+}
+
+// WatchJob streams the state transitions of a job of the caller, with the same owner check
+// as GetJob.
+func (h *handler) WatchJob(req *suggest.GetJobRequest, stream suggest.SuggestService_WatchJobServer) error {
+	ctx := stream.Context()
+	if req.GetRequestKey() == "" {
+		return h.handleErrorWithStatusCode(ctx, nil, errors.ErrInvalidInput)
+	}
+
+	job, err := h.jobManager.Get(ctx, req.GetRequestKey())
+	if err != nil {
+		return h.handleErrorWithStatusCode(ctx, err, err.Error())
+	}
+	if err := checkJobOwner(ctx, job); err != nil {
+		return h.handleErrorWithStatusCode(ctx, err, errors.ErrNotFound)
+	}
+
+	err = h.jobManager.Watch(ctx, req.GetRequestKey(), func(job *models.Job) error {
+		return stream.Send(toGetJobResponse(job))
+	})
+	if err != nil && ctx.Err() == nil {
+		return h.handleErrorWithStatusCode(ctx, err, err.Error())
+	}
+	return nil
+}
+
+func toGetJobResponse(job *models.Job) *suggest.GetJobResponse {
+	return &suggest.GetJobResponse{
+		RequestKey:   job.RequestKey,
+		Kind:         job.Kind,
+		Status:       job.Status,
+		ErrorCode:    job.ErrorCode,
+		ErrorMessage: job.ErrorMessage,
+		Attempts:     int32(job.Attempts),
+		CreatedAt:    timestamppb.New(job.CreatedAt),
+		StartedAt:    toTimestamp(job.StartedAt),
+		FinishedAt:   toTimestamp(job.FinishedAt),
+	}
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
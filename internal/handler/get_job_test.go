@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"darius/internal/errors"
+	jobManager "darius/managers/job"
+	"darius/models"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockJobManager serves the jobs it was given by request key.
+type mockJobManager struct {
+	jobs map[string]*models.Job
+}
+
+func (m *mockJobManager) Register(kind string, handler jobManager.Handler) {}
+
+func (m *mockJobManager) Enqueue(ctx context.Context, kind, requestKey, payload string, opts ...jobManager.EnqueueOption) (*models.Job, error) {
+	return nil, errors.Error(errors.ErrGeneral)
+}
+
+func (m *mockJobManager) Get(ctx context.Context, requestKey string) (*models.Job, error) {
+	if found, ok := m.jobs[requestKey]; ok {
+		return found, nil
+	}
+	return nil, errors.Error(errors.ErrNotFound)
+}
+
+func (m *mockJobManager) Watch(ctx context.Context, requestKey string, onUpdate func(*models.Job) error) error {
+	found, err := m.Get(ctx, requestKey)
+	if err != nil {
+		return err
+	}
+	return onUpdate(found)
+}
+
+func (m *mockJobManager) Start(ctx context.Context) {}
+
+// mockWatchJobStream collects what WatchJob sends.
+type mockWatchJobStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*suggest.GetJobResponse
+}
+
+func (m *mockWatchJobStream) Context() context.Context { return m.ctx }
+
+func (m *mockWatchJobStream) Send(resp *suggest.GetJobResponse) error {
+	m.sent = append(m.sent, resp)
+	return nil
+}
+
+func Test_GetJob(t *testing.T) {
+	h := &handler{jobManager: &mockJobManager{jobs: map[string]*models.Job{
+		"req-1": {RequestKey: "req-1", UserId: "123", Status: models.JobStatusRunning},
+	}}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "123"))
+
+	resp, err := h.GetJob(ctx, &suggest.GetJobRequest{RequestKey: "req-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, models.JobStatusRunning, resp.GetStatus())
+
+	// Someone else's job is as missing as a job that doesn't exist.
+	other := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "456"))
+	_, err = h.GetJob(other, &suggest.GetJobRequest{RequestKey: "req-1"})
+	assert.EqualError(t, err, errors.ErrNotFound)
+	_, err = h.GetJob(context.Background(), &suggest.GetJobRequest{RequestKey: "req-1"})
+	assert.EqualError(t, err, errors.ErrNotFound)
+	_, err = h.GetJob(ctx, &suggest.GetJobRequest{RequestKey: "req-2"})
+	assert.EqualError(t, err, errors.ErrNotFound)
+}
+
+func Test_WatchJob(t *testing.T) {
+	h := &handler{jobManager: &mockJobManager{jobs: map[string]*models.Job{
+		"req-1": {RequestKey: "req-1", UserId: "123", Status: models.JobStatusSucceeded},
+	}}}
+
+	stream := &mockWatchJobStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "123"))}
+	assert.NoError(t, h.WatchJob(&suggest.GetJobRequest{RequestKey: "req-1"}, stream))
+	assert.Len(t, stream.sent, 1)
+
+	other := &mockWatchJobStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "456"))}
+	assert.EqualError(t, h.WatchJob(&suggest.GetJobRequest{RequestKey: "req-1"}, other), errors.ErrNotFound)
+	assert.Empty(t, other.sent)
+}
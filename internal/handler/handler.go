@@ -2,9 +2,11 @@ package handler
 
 import (
 	"context"
+	"darius/internal/sandbox"
 	"darius/internal/services/bulbasaur"
-	llm "darius/internal/services/llm"
 	"darius/internal/services/missfortune"
+	databaseService "darius/internal/services/repo"
+	jobManager "darius/managers/job"
 	llmManager "darius/managers/llm"
 	"darius/pkg/proto/suggest"
 
@@ -13,30 +15,42 @@ import (
 )
 
 type Dependency struct {
-	LlmService  llm.LLM
-	LLMManager  llmManager.Manager
-	Missfortune missfortune.Service
-	Bulbasaur   bulbasaur.Service
+	LLMManager   llmManager.Manager
+	JobManager   jobManager.Manager
+	Missfortune  missfortune.Service
+	Bulbasaur    bulbasaur.Service
+	Sandbox      sandbox.Runner
+	QuestionBank databaseService.QuestionService
+	Documents    databaseService.DocumentService
+	Config       Config
 }
 
 type handler struct {
 	suggest.UnimplementedSuggestServiceServer
 
-	llmService  llm.LLM
-	llmManager  llmManager.Manager
-	missfortune missfortune.Service
-	bulbasaur   bulbasaur.Service
+	llmManager   llmManager.Manager
+	jobManager   jobManager.Manager
+	missfortune  missfortune.Service
+	bulbasaur    bulbasaur.Service
+	sandbox      sandbox.Runner
+	questionBank databaseService.QuestionService
+	documents    databaseService.DocumentService
+	config       Config
 
 	cache map[string]interface{}
 }
 
 func NewHandlerWithDeps(deps Dependency) *handler {
 	return &handler{
-		llmService:  deps.LlmService,
-		llmManager:  deps.LLMManager,
-		missfortune: deps.Missfortune,
-		bulbasaur:   deps.Bulbasaur,
-		cache:       make(map[string]interface{}),
+		llmManager:   deps.LLMManager,
+		jobManager:   deps.JobManager,
+		missfortune:  deps.Missfortune,
+		bulbasaur:    deps.Bulbasaur,
+		sandbox:      deps.Sandbox,
+		questionBank: deps.QuestionBank,
+		documents:    deps.Documents,
+		config:       deps.Config,
+		cache:        make(map[string]interface{}),
 	}
 }
 
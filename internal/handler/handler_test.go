@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// newTestHandler returns a handler that answers with the given responses in order and
+// always lets the user call and be charged, and the context of a signed in user. Tests set
+// whatever other dependency they need on the handler.
+func newTestHandler(config Config, responses ...string) (*handler, *mockLLMManager, context.Context) {
+	llm := &mockLLMManager{responses: responses}
+	h := &handler{
+		llmManager:  llm,
+		missfortune: mockMissfortune{},
+		bulbasaur:   &mockBulbasaur{checkCallingLLMResult: "charge", chargeCallingLLMResult: true},
+		config:      config,
+	}
+	return h, llm, metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "123"))
+}
@@ -36,20 +36,13 @@ func (h *handler) retryCallLLM(ctx context.Context, entry string, prompt string,
 		}
 
 		conversationIdResp, llmResponse, err := h.llmManager.Generate(ctx, entry, prompt, "", conversationId)
-		conversationId = conversationIdResp
-
 		if err != nil {
+			// The manager has already walked the failover chain of the feature, retrying
+			// here would only hit the same exhausted backends again.
 			log.Printf("[retryCallLLM] LLM call failed on attempt %d: %v", attempt, err)
-			if attempt == maxRetries {
-				return nil, err
-			}
-
-			if conversationId != nil {
-				prompt = "Please try to generate the response again. The previous attempt has just met the error: " + err.Error()
-			}
-
-			continue
+			return nil, err
 		}
+		conversationId = conversationIdResp
 
 		result, err := parseFunc.Parse(llmResponse)
 		if err == nil {
@@ -64,3 +57,12 @@ func (h *handler) retryCallLLM(ctx context.Context, entry string, prompt string,
 	log.Printf("[retryCallLLM] All %d retry attempts failed", maxRetries)
 	return nil, errors.Error(errors.ErrJSONParsing)
 }
+
+// retryErrorType is the error type to report for an error of retryCallLLM: the answer
+// never parsed, or the LLM couldn't be reached.
+func retryErrorType(err error) string {
+	if err.Error() == errors.ErrJSONParsing {
+		return errors.ErrJSONParsing
+	}
+	return errors.ErrNetworkConnection
+}
@@ -0,0 +1,62 @@
+package importer
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	aikenOption = regexp.MustCompile(`^([A-Z])[.)]\s+(.*)$`)
+	aikenAnswer = regexp.MustCompile(`^ANSWER:\s*([A-Z])\s*$`)
+)
+
+// readAiken reads the Aiken format: the question on a line, a lettered option per line and
+// the letter of the correct one after "ANSWER:". Questions whose options are True and False
+// become true/false questions, all the others MCQs worth the default points.
+func readAiken(content string, result *Result) error {
+	position := 0
+	var text []string
+	var options []string
+	reset := func() {
+		text, options = nil, nil
+	}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if len(text) > 0 && len(options) < 26 {
+			if match := aikenOption.FindStringSubmatch(line); match != nil && int(match[1][0]-'A') == len(options) {
+				options = append(options, match[2])
+				continue
+			}
+		}
+		if match := aikenAnswer.FindStringSubmatch(line); match != nil && len(text) > 0 {
+			position++
+			answer := int(match[1][0] - 'A')
+			if answer >= len(options) {
+				result.problem(position, "answer %s is not one of the %d options", match[1], len(options))
+				reset()
+				continue
+			}
+			correct := make([]bool, len(options))
+			correct[answer] = true
+			question, err := choiceQuestion(strings.Join(text, "\n"), options, correct, nil, false)
+			result.keep(position, question, err)
+			reset()
+			continue
+		}
+		if len(options) > 0 {
+			// The previous question ended without an answer.
+			position++
+			result.problem(position, "question %q has no ANSWER line", strings.Join(text, " "))
+			reset()
+		}
+		text = append(text, line)
+	}
+	if len(text) > 0 {
+		position++
+		result.problem(position, "question %q has no ANSWER line", strings.Join(text, " "))
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package importer
+
+import (
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// csvColumns are the columns a CSV file may have, named in its header row in any order:
+// the columns the exporter writes. Only type and text are required.
+var csvColumns = []string{"id", "type", "text", "points", "options", "correct", "answer"}
+
+// readCSV reads a CSV file laid out like the exporter writes them, with one question per
+// row. Lists have one item per line of their cell, and the correct options of choice
+// questions are given by letter, or as TRUE or FALSE for true/false questions.
+func readCSV(content string, result *Result) error {
+	r := csv.NewReader(strings.NewReader(content))
+	r.FieldsPerRecord = -1
+	rows, err := r.ReadAll()
+	if err != nil {
+		return fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(rows) == 0 {
+		return errors.New("CSV file is empty")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"type", "text"} {
+		if _, ok := columns[required]; !ok {
+			return fmt.Errorf("CSV header has no %q column, expected the columns %s", required, strings.Join(csvColumns, ","))
+		}
+	}
+
+	for i, row := range rows[1:] {
+		cell := func(name string) string {
+			if column, ok := columns[name]; ok && column < len(row) {
+				return strings.TrimSpace(row[column])
+			}
+			return ""
+		}
+		if strings.Join(row, "") == "" {
+			continue
+		}
+		question, err := csvQuestion(strings.ToUpper(cell("type")), cell("text"), lines(cell("options")), cell("correct"), cell("answer"))
+		if question != nil {
+			points, _ := strconv.Atoi(cell("points"))
+			question.Points = int32(points)
+		}
+		result.keep(i+1, question, err)
+	}
+	return nil
+}
+
+func csvQuestion(questionType, text string, options []string, correct, answer string) (*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	switch questionType {
+	case validation.QuestionTypeMCQ, validation.QuestionTypeMultiSelect:
+		isCorrect := make([]bool, len(options))
+		for _, letter := range strings.Split(correct, ",") {
+			letter = strings.ToUpper(strings.TrimSpace(letter))
+			if letter == "" {
+				continue
+			}
+			if len(letter) != 1 || letter[0] < 'A' || int(letter[0]-'A') >= len(options) {
+				return nil, fmt.Errorf("correct option %q is not the letter of one of the %d options", letter, len(options))
+			}
+			isCorrect[letter[0]-'A'] = true
+		}
+		return choiceQuestion(text, options, isCorrect, nil, questionType == validation.QuestionTypeMultiSelect)
+	case validation.QuestionTypeTrueFalse:
+		answer, err := strconv.ParseBool(correct)
+		if err != nil {
+			return nil, fmt.Errorf("correct answer %q of a true/false question is not TRUE or FALSE", correct)
+		}
+		return trueFalseQuestion(text, answer), nil
+	case validation.QuestionTypeFillInBlank:
+		return blankQuestion(text, lines(answer), false)
+	case validation.QuestionTypeMatching:
+		var lefts, rights []string
+		for _, option := range options {
+			left, right, ok := strings.Cut(option, "=>")
+			if !ok {
+				return nil, fmt.Errorf("pair %q is not written as left => right", option)
+			}
+			lefts = append(lefts, strings.TrimSpace(left))
+			rights = append(rights, strings.TrimSpace(right))
+		}
+		return matchingQuestion(text, lefts, rights)
+	case validation.QuestionTypeOrdering:
+		if len(options) < 2 {
+			return nil, fmt.Errorf("expected at least 2 items to order, got %d", len(options))
+		}
+		question := newQuestion(questionType, text)
+		question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_Ordering{Ordering: &suggest.SuggestExamQuestionResponseV2_OrderingDetail{Items: options}}
+		return question, nil
+	case validation.QuestionTypeLongAnswer:
+		return essayQuestion(text, answer), nil
+	case validation.QuestionTypeCode:
+		question := newQuestion(questionType, text)
+		question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_Code{Code: &suggest.SuggestExamQuestionResponseV2_CodeDetail{ReferenceSolution: answer}}
+		return question, nil
+	}
+	return nil, fmt.Errorf("unknown question type %q", questionType)
+}
+
+// lines splits a list cell into its non-empty lines.
+func lines(cell string) []string {
+	var items []string
+	for _, line := range strings.Split(cell, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			items = append(items, line)
+		}
+	}
+	return items
+}
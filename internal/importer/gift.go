@@ -0,0 +1,206 @@
+package importer
+
+import (
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	giftPoints     = regexp.MustCompile(`^//\s*points:\s*(\d+)`)
+	giftFormatTag  = regexp.MustCompile(`^\[(html|moodle|plain|markdown)\]`)
+	giftWeight     = regexp.MustCompile(`^%(-?[\d.]+)%`)
+	giftTrueFalse  = map[string]bool{"T": true, "TRUE": true, "F": false, "FALSE": false}
+	giftBlankLines = regexp.MustCompile(`\n[ \t]*\n`)
+)
+
+// readGIFT reads Moodle's GIFT format: multiple choice questions, with several correct
+// answers when they are weighted, true/false, short answer and missing word questions as
+// blanks, matching questions and essays. The points of a question are read from a
+// "// points:" comment above it, as the exporter writes them. Numerical questions are left
+// out.
+func readGIFT(content string, result *Result) error {
+	position := 0
+	for _, block := range giftBlankLines.Split(content, -1) {
+		points := 0
+		var lines []string
+		for _, line := range strings.Split(block, "\n") {
+			trimmed := strings.TrimSpace(line)
+			if match := giftPoints.FindStringSubmatch(trimmed); match != nil {
+				points, _ = strconv.Atoi(match[1])
+			}
+			if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "$CATEGORY:") {
+				continue
+			}
+			lines = append(lines, line)
+		}
+		text := strings.TrimSpace(strings.Join(lines, "\n"))
+		if text == "" {
+			continue
+		}
+
+		position++
+		question, err := giftQuestion(text)
+		if question != nil {
+			question.Points = int32(points)
+		}
+		result.keep(position, question, err)
+	}
+	return nil
+}
+
+func giftQuestion(text string) (*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	if strings.HasPrefix(text, "::") {
+		if end := strings.Index(text[2:], "::"); end >= 0 {
+			text = strings.TrimSpace(text[end+4:])
+		}
+	}
+	text = giftFormatTag.ReplaceAllString(text, "")
+
+	open, close := giftIndex(text, '{'), giftLastIndex(text, '}')
+	if open < 0 || close < open {
+		return nil, fmt.Errorf("question has no answer block in braces")
+	}
+	before, answers, after := text[:open], strings.TrimSpace(text[open+1:close]), strings.TrimSpace(text[close+1:])
+	stem := strings.TrimSpace(giftUnescape(before))
+	if after != "" {
+		// A missing word question, the answer block marks the blank.
+		stem = strings.TrimSpace(stem + " ___ " + giftUnescape(after))
+	}
+
+	switch {
+	case answers == "":
+		return essayQuestion(stem, ""), nil
+	case strings.HasPrefix(answers, "####"):
+		return essayQuestion(stem, giftUnescape(answers[4:])), nil
+	case strings.HasPrefix(answers, "#"):
+		return nil, fmt.Errorf("numerical questions can't be imported")
+	}
+	if answer, ok := giftTrueFalse[strings.ToUpper(strings.TrimSpace(giftSplit(answers, '#')[0]))]; ok {
+		return trueFalseQuestion(stem, answer), nil
+	}
+
+	var options, explanations, lefts, rights []string
+	var correct []bool
+	weighted, wrong := false, false
+	for _, item := range giftItems(answers) {
+		mark, body := item[0], strings.TrimSpace(item[1:])
+		if match := giftWeight.FindStringSubmatch(body); match != nil {
+			weight, _ := strconv.ParseFloat(match[1], 64)
+			body = strings.TrimSpace(body[len(match[0]):])
+			weighted = true
+			mark = '~'
+			if weight > 0 {
+				mark = '='
+			}
+		}
+		parts := giftSplit(body, '#')
+		option, explanation := strings.TrimSpace(giftUnescape(parts[0])), ""
+		if len(parts) > 1 {
+			explanation = strings.TrimSpace(giftUnescape(strings.Join(parts[1:], "#")))
+		}
+		if left, right, ok := strings.Cut(parts[0], "->"); ok && mark == '=' {
+			lefts = append(lefts, strings.TrimSpace(giftUnescape(left)))
+			rights = append(rights, strings.TrimSpace(giftUnescape(right)))
+			continue
+		}
+		wrong = wrong || mark == '~'
+		options = append(options, option)
+		correct = append(correct, mark == '=')
+		explanations = append(explanations, explanation)
+	}
+
+	switch {
+	case len(lefts) > 0 && len(options) == 0:
+		return matchingQuestion(stem, lefts, rights)
+	case len(lefts) > 0:
+		return nil, fmt.Errorf("answer block mixes matching pairs and options")
+	case !wrong:
+		return blankQuestion(stem, options, false)
+	}
+	return choiceQuestion(stem, options, correct, explanations, weighted)
+}
+
+// giftItems splits an answer block into its answers, each starting with = or ~.
+func giftItems(answers string) []string {
+	var items []string
+	start := -1
+	escaped := false
+	for i, r := range answers {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '=' || r == '~':
+			if start >= 0 {
+				items = append(items, answers[start:i])
+			}
+			start = i
+		}
+	}
+	if start >= 0 {
+		items = append(items, answers[start:])
+	}
+	return items
+}
+
+// giftSplit splits the text at the separators that are not escaped, leaving the parts
+// escaped.
+func giftSplit(text string, separator rune) []string {
+	var parts []string
+	start := 0
+	escaped := false
+	for i, r := range text {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == separator:
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, text[start:])
+}
+
+func giftIndex(text string, char rune) int {
+	parts := giftSplit(text, char)
+	if len(parts) == 1 {
+		return -1
+	}
+	return len(parts[0])
+}
+
+func giftLastIndex(text string, char rune) int {
+	parts := giftSplit(text, char)
+	if len(parts) == 1 {
+		return -1
+	}
+	return len(text) - len(parts[len(parts)-1]) - 1
+}
+
+// giftUnescape undoes the escaping of the characters GIFT gives a meaning to, and turns \n
+// into line breaks.
+func giftUnescape(text string) string {
+	var unescaped strings.Builder
+	escaped := false
+	for _, r := range text {
+		switch {
+		case escaped && r == 'n':
+			unescaped.WriteRune('\n')
+		case escaped:
+			unescaped.WriteRune(r)
+		case r == '\\':
+			escaped = true
+			continue
+		default:
+			unescaped.WriteRune(r)
+		}
+		escaped = false
+	}
+	return unescaped.String()
+}
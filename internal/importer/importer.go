@@ -0,0 +1,178 @@
+// Package importer reads question sets written for learning management systems back into
+// exam questions, so trainers can have more questions generated like the ones they already
+// have, or none that overlap them. It reads the formats the exporter writes, except for QTI
+// packages, and the files Moodle and spreadsheets produce in them.
+package importer
+
+import (
+	"darius/internal/exporter"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var Formats = map[string]bool{
+	exporter.FormatMoodleXML: true,
+	exporter.FormatGIFT:      true,
+	exporter.FormatAiken:     true,
+	exporter.FormatCSV:       true,
+}
+
+// defaultPoints is what a question is worth when its format doesn't say.
+const defaultPoints = 1
+
+// Result holds the questions read from a file, numbered from 1 in the order of the file,
+// and the problems with those that couldn't be read.
+type Result struct {
+	Questions []*suggest.SuggestExamQuestionResponseV2_Quetion
+	Problems  []Problem
+}
+
+// Problem is a question of the file that was left out.
+type Problem struct {
+	Position int // of the question in the file, from 1
+	Message  string
+}
+
+// reader reads the questions of a file, reporting the ones it has to leave out.
+type reader func(content string, result *Result) error
+
+var readers = map[string]reader{
+	exporter.FormatMoodleXML: readMoodleXML,
+	exporter.FormatGIFT:      readGIFT,
+	exporter.FormatAiken:     readAiken,
+	exporter.FormatCSV:       readCSV,
+}
+
+// Import reads the questions of a file in the format. It fails only when the file as a
+// whole can't be read; questions that can't be are reported in the result.
+func Import(format, content string) (*Result, error) {
+	read, ok := readers[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown import format %q", format)
+	}
+	result := &Result{}
+	if err := read(strings.ReplaceAll(content, "\r\n", "\n"), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// keep numbers the question read at the position of the file and keeps it, unless it
+// couldn't be read.
+func (r *Result) keep(position int, question *suggest.SuggestExamQuestionResponseV2_Quetion, err error) {
+	if err == nil && question.GetText() == "" {
+		err = errors.New("question has no text")
+	}
+	if err != nil {
+		r.problem(position, "%v", err)
+		return
+	}
+	question.Id = int32(len(r.Questions) + 1)
+	if question.Points <= 0 {
+		question.Points = defaultPoints
+	}
+	r.Questions = append(r.Questions, question)
+}
+
+func (r *Result) problem(position int, format string, args ...interface{}) {
+	r.Problems = append(r.Problems, Problem{Position: position, Message: fmt.Sprintf(format, args...)})
+}
+
+func newQuestion(questionType, text string) *suggest.SuggestExamQuestionResponseV2_Quetion {
+	return &suggest.SuggestExamQuestionResponseV2_Quetion{
+		Text:   strings.TrimSpace(text),
+		Type:   questionType,
+		Detail: &suggest.SuggestExamQuestionResponseV2_Detail{Type: questionType},
+	}
+}
+
+// choiceQuestion makes an MCQ of options with a single correct one, a MULTI_SELECT of
+// options with several, and a TRUE_FALSE question of the options True and False.
+func choiceQuestion(text string, options []string, correct []bool, explanations []string, multiple bool) (*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	var correctOptions []int32
+	for i, isCorrect := range correct {
+		if isCorrect {
+			correctOptions = append(correctOptions, int32(i))
+		}
+	}
+	if len(options) < 2 {
+		return nil, fmt.Errorf("expected at least 2 options, got %d", len(options))
+	}
+	if len(correctOptions) == 0 {
+		return nil, fmt.Errorf("no option is marked correct")
+	}
+
+	if !multiple && len(correctOptions) == 1 && isTrueFalse(options) {
+		return trueFalseQuestion(text, strings.EqualFold(options[correctOptions[0]], "true")), nil
+	}
+	if multiple || len(correctOptions) > 1 {
+		question := newQuestion(validation.QuestionTypeMultiSelect, text)
+		question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_MultiSelect{MultiSelect: &suggest.SuggestExamQuestionResponseV2_MultiSelectDetail{
+			Options:        options,
+			CorrectOptions: correctOptions,
+		}}
+		return question, nil
+	}
+
+	question := newQuestion(validation.QuestionTypeMCQ, text)
+	question.Detail.Options = options
+	question.Detail.CorrectOption = correctOptions[0]
+	if strings.Join(explanations, "") != "" {
+		for _, explanation := range explanations {
+			question.Detail.OptionExplanations = append(question.Detail.OptionExplanations, &suggest.SuggestExamQuestionResponseV2_OptionExplanation{Explanation: explanation})
+		}
+	}
+	return question, nil
+}
+
+func trueFalseQuestion(text string, answer bool) *suggest.SuggestExamQuestionResponseV2_Quetion {
+	question := newQuestion(validation.QuestionTypeTrueFalse, text)
+	question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_TrueFalse{TrueFalse: &suggest.SuggestExamQuestionResponseV2_TrueFalseDetail{CorrectAnswer: answer}}
+	return question
+}
+
+func isTrueFalse(options []string) bool {
+	return len(options) == 2 && strings.EqualFold(options[0], "true") && strings.EqualFold(options[1], "false")
+}
+
+var blank = regexp.MustCompile(`_{3,}`)
+
+// blankQuestion makes a FILL_IN_BLANK question, adding the blank to texts without one.
+func blankQuestion(text string, answers []string, caseSensitive bool) (*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	if len(answers) == 0 {
+		return nil, fmt.Errorf("the blank has no accepted answer")
+	}
+	if !blank.MatchString(text) {
+		text = strings.TrimSpace(text) + " ___"
+	}
+	question := newQuestion(validation.QuestionTypeFillInBlank, text)
+	question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_FillInBlank{FillInBlank: &suggest.SuggestExamQuestionResponseV2_FillInBlankDetail{
+		AcceptedAnswers: answers,
+		CaseSensitive:   caseSensitive,
+	}}
+	return question, nil
+}
+
+func matchingQuestion(text string, lefts, rights []string) (*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	if len(lefts) < 2 {
+		return nil, fmt.Errorf("expected at least 2 pairs, got %d", len(lefts))
+	}
+	question := newQuestion(validation.QuestionTypeMatching, text)
+	matching := &suggest.SuggestExamQuestionResponseV2_MatchingDetail{}
+	for i := range lefts {
+		matching.Pairs = append(matching.Pairs, &suggest.SuggestExamQuestionResponseV2_MatchingDetail_Pair{Left: lefts[i], Right: rights[i]})
+	}
+	question.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_Matching{Matching: matching}
+	return question, nil
+}
+
+// essayQuestion makes a LONG_ANSWER question, its model answer the expected answer.
+func essayQuestion(text, answer string) *suggest.SuggestExamQuestionResponseV2_Quetion {
+	question := newQuestion(validation.QuestionTypeLongAnswer, text)
+	question.Detail.CorrectAnswer = strings.TrimSpace(answer)
+	return question
+}
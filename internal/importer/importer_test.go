@@ -0,0 +1,217 @@
+package importer
+
+import (
+	"darius/internal/exporter"
+	"darius/internal/validation"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+type question = suggest.SuggestExamQuestionResponseV2_Quetion
+
+func exam() []*question {
+	detail := func(questionType string) *suggest.SuggestExamQuestionResponseV2_Detail {
+		return &suggest.SuggestExamQuestionResponseV2_Detail{Type: questionType}
+	}
+	mcq := &question{Id: 1, Text: "What does `v := <-ch` do on a nil channel?", Type: validation.QuestionTypeMCQ, Points: 2, Detail: detail(validation.QuestionTypeMCQ)}
+	mcq.Detail.Options = []string{"Blocks forever", "Panics", "Returns the zero value", "Fails to compile"}
+	mcq.Detail.OptionExplanations = []*suggest.SuggestExamQuestionResponseV2_OptionExplanation{
+		{Explanation: "Receiving from a nil channel never proceeds."},
+		{Explanation: "Only closing a nil channel panics."},
+		{Explanation: "That is a closed channel {or a default case}."},
+		{Explanation: "It is valid Go."},
+	}
+
+	essay := &question{Id: 2, Text: "Explain when to use a mutex instead of a channel.\nGive an example.", Type: validation.QuestionTypeLongAnswer, Points: 5, Detail: detail(validation.QuestionTypeLongAnswer)}
+	essay.Detail.CorrectAnswer = "A mutex guards shared state; channels pass ownership."
+
+	multiSelect := &question{Id: 3, Text: "Which statements can block?", Type: validation.QuestionTypeMultiSelect, Points: 3, Detail: detail(validation.QuestionTypeMultiSelect)}
+	multiSelect.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_MultiSelect{MultiSelect: &suggest.SuggestExamQuestionResponseV2_MultiSelectDetail{
+		Options:        []string{"ch <- v", "len(ch)", "wg.Wait()", "cap(ch)"},
+		CorrectOptions: []int32{0, 2},
+	}}
+
+	trueFalse := &question{Id: 4, Text: "A closed channel can still be received from.", Type: validation.QuestionTypeTrueFalse, Points: 1, Detail: detail(validation.QuestionTypeTrueFalse)}
+	trueFalse.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_TrueFalse{TrueFalse: &suggest.SuggestExamQuestionResponseV2_TrueFalseDetail{CorrectAnswer: true}}
+
+	blank := &question{Id: 5, Text: "The ___ statement waits on several channel operations.", Type: validation.QuestionTypeFillInBlank, Points: 1, Detail: detail(validation.QuestionTypeFillInBlank)}
+	blank.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_FillInBlank{FillInBlank: &suggest.SuggestExamQuestionResponseV2_FillInBlankDetail{AcceptedAnswers: []string{"select", "select {}"}}}
+
+	matching := &question{Id: 6, Text: "Match each type with its zero value.", Type: validation.QuestionTypeMatching, Points: 3, Detail: detail(validation.QuestionTypeMatching)}
+	matching.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_Matching{Matching: &suggest.SuggestExamQuestionResponseV2_MatchingDetail{
+		Pairs: []*suggest.SuggestExamQuestionResponseV2_MatchingDetail_Pair{{Left: "int", Right: "0"}, {Left: "string", Right: `""`}, {Left: "*T", Right: "nil"}},
+	}}
+
+	ordering := &question{Id: 7, Text: "Order the steps of a graceful shutdown.", Type: validation.QuestionTypeOrdering, Points: 2, Detail: detail(validation.QuestionTypeOrdering)}
+	ordering.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_Ordering{Ordering: &suggest.SuggestExamQuestionResponseV2_OrderingDetail{
+		Items: []string{"Stop accepting requests", "Drain in-flight requests", "Close the database"},
+	}}
+
+	code := &question{Id: 8, Text: "Write a function returning the sum of a slice.", Type: validation.QuestionTypeCode, Points: 4, Detail: detail(validation.QuestionTypeCode)}
+	code.Detail.Answer = &suggest.SuggestExamQuestionResponseV2_Detail_Code{Code: &suggest.SuggestExamQuestionResponseV2_CodeDetail{
+		ReferenceSolution: "def total(xs):\n    return sum(xs)",
+	}}
+
+	return []*question{mcq, essay, multiSelect, trueFalse, blank, matching, ordering, code}
+}
+
+// asEssay is the question as formats without coding questions carry it: an essay with the
+// reference solution as its expected answer.
+func asEssay(code *question) *question {
+	essay := &question{Text: code.GetText(), Type: validation.QuestionTypeLongAnswer, Points: code.GetPoints(), Detail: &suggest.SuggestExamQuestionResponseV2_Detail{Type: validation.QuestionTypeLongAnswer}}
+	essay.Detail.CorrectAnswer = code.GetDetail().GetCode().GetReferenceSolution()
+	return essay
+}
+
+// roundTrip exports the exam in the format and imports the file back.
+func roundTrip(t *testing.T, format string, questions []*question) *Result {
+	file, err := exporter.Export(format, "Go", questions)
+	assert.NoError(t, err)
+	result, err := Import(format, string(file.Content))
+	assert.NoError(t, err)
+	return result
+}
+
+func assertQuestions(t *testing.T, want []*question, got []*question) {
+	t.Helper()
+	if !assert.Len(t, got, len(want)) {
+		return
+	}
+	for i := range want {
+		want[i].Id = int32(i + 1)
+		assert.True(t, proto.Equal(want[i], got[i]), "want %v\ngot  %v", want[i], got[i])
+	}
+}
+
+func TestImport_GIFT(t *testing.T) {
+	result := roundTrip(t, exporter.FormatGIFT, exam())
+	assert.Empty(t, result.Problems)
+	want := exam()
+	assertQuestions(t, append(want[:6], asEssay(want[7])), result.Questions)
+}
+
+func TestImport_MoodleXML(t *testing.T) {
+	questions := exam()
+	questions[4].GetDetail().GetFillInBlank().CaseSensitive = true
+	result := roundTrip(t, exporter.FormatMoodleXML, questions)
+	assert.Empty(t, result.Problems)
+	want := exam()
+	want[4].GetDetail().GetFillInBlank().CaseSensitive = true
+	assertQuestions(t, append(want[:6], asEssay(want[7])), result.Questions)
+}
+
+func TestImport_Aiken(t *testing.T) {
+	result := roundTrip(t, exporter.FormatAiken, exam())
+	assert.Empty(t, result.Problems)
+	want := exam()
+	mcq, trueFalse := want[0], want[3]
+	mcq.Points, mcq.Detail.OptionExplanations = defaultPoints, nil
+	assertQuestions(t, []*question{mcq, trueFalse}, result.Questions)
+}
+
+func TestImport_CSV(t *testing.T) {
+	result := roundTrip(t, exporter.FormatCSV, exam())
+	assert.Empty(t, result.Problems)
+	want := exam()
+	want[0].Detail.OptionExplanations = nil
+	assertQuestions(t, want, result.Questions)
+}
+
+func TestImport_GIFTFromMoodle(t *testing.T) {
+	result, err := Import(exporter.FormatGIFT, `// question: 1  name: Grant's tomb
+::Grant::Who's buried in Grant's tomb?{=Grant ~no one#Was true for 12 years ~Napoleon}
+
+::Colors::[html]The sky is {=blue =azure} on a clear day.
+
+Mars has rings.{F}
+
+How many moons does Mars have? {#2}
+
+Write a short essay about the moon.{}
+
+Match the colors.{
+	=red -> rojo
+	~blue
+}
+`)
+	assert.NoError(t, err)
+	assert.Len(t, result.Questions, 4)
+
+	mcq := result.Questions[0]
+	assert.Equal(t, validation.QuestionTypeMCQ, mcq.GetType())
+	assert.Equal(t, "Who's buried in Grant's tomb?", mcq.GetText())
+	assert.Equal(t, []string{"Grant", "no one", "Napoleon"}, mcq.GetDetail().GetOptions())
+	assert.Equal(t, "Was true for 12 years", mcq.GetDetail().GetOptionExplanations()[1].GetExplanation())
+	assert.Equal(t, int32(defaultPoints), mcq.GetPoints())
+
+	blank := result.Questions[1]
+	assert.Equal(t, validation.QuestionTypeFillInBlank, blank.GetType())
+	assert.Equal(t, "The sky is ___ on a clear day.", blank.GetText())
+	assert.Equal(t, []string{"blue", "azure"}, blank.GetDetail().GetFillInBlank().GetAcceptedAnswers())
+
+	assert.False(t, result.Questions[2].GetDetail().GetTrueFalse().GetCorrectAnswer())
+	assert.Equal(t, validation.QuestionTypeLongAnswer, result.Questions[3].GetType())
+	assert.Equal(t, int32(4), result.Questions[3].GetId())
+
+	assert.Equal(t, []Problem{
+		{Position: 4, Message: "numerical questions can't be imported"},
+		{Position: 6, Message: "answer block mixes matching pairs and options"},
+	}, result.Problems)
+}
+
+func TestImport_MoodleXMLFromMoodle(t *testing.T) {
+	result, err := Import(exporter.FormatMoodleXML, `<?xml version="1.0" encoding="UTF-8"?>
+<quiz>
+  <question type="category"><category><text>$course$/top/Default</text></category></question>
+  <question type="multichoice">
+    <name><text>Goroutines</text></name>
+    <questiontext format="html"><text><![CDATA[<p>Which keyword starts a <b>goroutine</b>?</p>]]></text></questiontext>
+    <defaultgrade>2.0000000</defaultgrade>
+    <single>true</single>
+    <answer fraction="100" format="html"><text><![CDATA[<p>go</p>]]></text><feedback format="html"><text></text></feedback></answer>
+    <answer fraction="0" format="html"><text><![CDATA[<p>defer</p>]]></text><feedback format="html"><text></text></feedback></answer>
+  </question>
+  <question type="numerical">
+    <questiontext format="html"><text>2 + 2?</text></questiontext>
+    <answer fraction="100"><text>4</text></answer>
+  </question>
+</quiz>`)
+	assert.NoError(t, err)
+	assert.Len(t, result.Questions, 1)
+	assert.Equal(t, "Which keyword starts a goroutine?", result.Questions[0].GetText())
+	assert.Equal(t, []string{"go", "defer"}, result.Questions[0].GetDetail().GetOptions())
+	assert.Empty(t, result.Questions[0].GetDetail().GetOptionExplanations())
+	assert.Equal(t, int32(2), result.Questions[0].GetPoints())
+	assert.Equal(t, []Problem{{Position: 2, Message: "numerical questions can't be imported"}}, result.Problems)
+
+	_, err = Import(exporter.FormatMoodleXML, "<quiz><question>")
+	assert.Error(t, err)
+}
+
+func TestImport_Problems(t *testing.T) {
+	result, err := Import(exporter.FormatAiken, "Is Go compiled?\nA) Yes\nB) No\nANSWER: B\n\nWhich is a keyword?\nA. go\nB. run\nANSWER: C\n\nUnanswered?\nA. Yes\nB. No\n")
+	assert.NoError(t, err)
+	assert.Len(t, result.Questions, 1)
+	assert.Equal(t, int32(1), result.Questions[0].GetDetail().GetCorrectOption())
+	assert.Equal(t, []Problem{
+		{Position: 2, Message: "answer C is not one of the 2 options"},
+		{Position: 3, Message: `question "Unanswered?" has no ANSWER line`},
+	}, result.Problems)
+
+	result, err = Import(exporter.FormatCSV, "Type,Text,Options,Correct\nMCQ,Which is a keyword?,\"go\nrun\",A\nESSAY,Why?,,\nTRUE_FALSE,Go is compiled.,,maybe\n")
+	assert.NoError(t, err)
+	assert.Len(t, result.Questions, 1)
+	assert.Equal(t, int32(defaultPoints), result.Questions[0].GetPoints())
+	assert.Equal(t, []Problem{
+		{Position: 2, Message: `unknown question type "ESSAY"`},
+		{Position: 3, Message: `correct answer "maybe" of a true/false question is not TRUE or FALSE`},
+	}, result.Problems)
+
+	_, err = Import(exporter.FormatCSV, "question,answer\nWhy?,Because\n")
+	assert.Error(t, err)
+	_, err = Import(exporter.FormatQTI, "")
+	assert.Error(t, err)
+}
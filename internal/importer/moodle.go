@@ -0,0 +1,141 @@
+package importer
+
+import (
+	"darius/pkg/proto/suggest"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The parts of the Moodle XML question format the importer reads.
+type moodleQuiz struct {
+	Questions []moodleQuestion `xml:"question"`
+}
+
+type moodleQuestion struct {
+	Type         string              `xml:"type,attr"`
+	QuestionText moodleText          `xml:"questiontext"`
+	DefaultGrade string              `xml:"defaultgrade"`
+	Single       string              `xml:"single"`
+	UseCase      string              `xml:"usecase"`
+	GraderInfo   moodleText          `xml:"graderinfo"`
+	Answers      []moodleAnswer      `xml:"answer"`
+	Subquestions []moodleSubquestion `xml:"subquestion"`
+}
+
+type moodleText struct {
+	Format string `xml:"format,attr"`
+	Text   string `xml:"text"`
+}
+
+type moodleAnswer struct {
+	Fraction string     `xml:"fraction,attr"`
+	Format   string     `xml:"format,attr"`
+	Text     string     `xml:"text"`
+	Feedback moodleText `xml:"feedback"`
+}
+
+type moodleSubquestion struct {
+	Format string     `xml:"format,attr"`
+	Text   string     `xml:"text"`
+	Answer moodleText `xml:"answer"`
+}
+
+// readMoodleXML reads a Moodle XML export: multiple choice questions, MULTI_SELECT ones when
+// they allow several answers, true/false, short answer questions as blanks, matching
+// questions and essays, their grader information the expected answer. Categories and
+// descriptions are passed over, other question types are left out.
+func readMoodleXML(content string, result *Result) error {
+	quiz := moodleQuiz{}
+	if err := xml.Unmarshal([]byte(content), &quiz); err != nil {
+		return fmt.Errorf("invalid Moodle XML: %w", err)
+	}
+
+	position := 0
+	for _, converted := range quiz.Questions {
+		if converted.Type == "category" || converted.Type == "description" {
+			continue
+		}
+		position++
+		question, err := moodleQuestionOf(converted)
+		if question != nil {
+			grade, _ := strconv.ParseFloat(converted.DefaultGrade, 64)
+			question.Points = int32(math.Round(grade))
+		}
+		result.keep(position, question, err)
+	}
+	return nil
+}
+
+func moodleQuestionOf(converted moodleQuestion) (*suggest.SuggestExamQuestionResponseV2_Quetion, error) {
+	text := moodlePlain(moodleHTML(converted.QuestionText.Format), converted.QuestionText.Text)
+	switch converted.Type {
+	case "multichoice":
+		var options, explanations []string
+		var correct []bool
+		for _, answer := range converted.Answers {
+			options = append(options, moodlePlain(moodleHTML(answer.Format), answer.Text))
+			correct = append(correct, moodleFraction(answer.Fraction) > 0)
+			explanations = append(explanations, moodlePlain(moodleHTML(answer.Feedback.Format), answer.Feedback.Text))
+		}
+		return choiceQuestion(text, options, correct, explanations, converted.Single == "false" || converted.Single == "0")
+	case "truefalse":
+		for _, answer := range converted.Answers {
+			if moodleFraction(answer.Fraction) > 0 {
+				return trueFalseQuestion(text, strings.EqualFold(strings.TrimSpace(answer.Text), "true")), nil
+			}
+		}
+		return nil, fmt.Errorf("no answer is marked correct")
+	case "shortanswer":
+		var answers []string
+		for _, answer := range converted.Answers {
+			if moodleFraction(answer.Fraction) >= 100 {
+				answers = append(answers, moodlePlain(answer.Format, answer.Text))
+			}
+		}
+		return blankQuestion(text, answers, converted.UseCase == "1")
+	case "matching":
+		var lefts, rights []string
+		for _, subquestion := range converted.Subquestions {
+			// Subquestions without a text only add wrong answers.
+			if left := moodlePlain(moodleHTML(subquestion.Format), subquestion.Text); left != "" {
+				lefts = append(lefts, left)
+				rights = append(rights, strings.TrimSpace(subquestion.Answer.Text))
+			}
+		}
+		return matchingQuestion(text, lefts, rights)
+	case "essay":
+		return essayQuestion(text, moodlePlain(moodleHTML(converted.GraderInfo.Format), converted.GraderInfo.Text)), nil
+	}
+	return nil, fmt.Errorf("%s questions can't be imported", converted.Type)
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// moodlePlain turns a text of Moodle into plain text, stripping the tags of HTML ones.
+// Question texts, feedback and the answers of multiple choice questions are HTML unless
+// they say otherwise.
+func moodlePlain(format, text string) string {
+	if format == "html" {
+		text = html.UnescapeString(htmlTag.ReplaceAllString(text, ""))
+	}
+	return strings.TrimSpace(text)
+}
+
+// moodleHTML is the format of a text that is HTML by default.
+func moodleHTML(format string) string {
+	if format == "" {
+		return "html"
+	}
+	return format
+}
+
+// moodleFraction reads the grade fraction of an answer, in percent.
+func moodleFraction(fraction string) float64 {
+	value, _ := strconv.ParseFloat(fraction, 64)
+	return value
+}
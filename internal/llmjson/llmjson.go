@@ -0,0 +1,68 @@
+// Package llmjson pulls JSON out of LLM responses. Models wrap it in prose and markdown
+// fences, leave comments and trailing commas, use smart quotes, forget to escape newlines
+// inside strings and get cut off mid-value; Extract undoes all of that before decoding.
+package llmjson
+
+import (
+	"darius/internal/errors"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var protoUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// Extract returns the JSON object or array in an LLM response, repaired so it is valid.
+// When the response has more than one, the largest wins.
+func Extract(response string) (string, error) {
+	if extracted, ok := extract(response); ok {
+		return extracted, nil
+	}
+	// Some models answer with the JSON escaped as if it were itself a string.
+	if strings.Contains(response, `\"`) {
+		if extracted, ok := extract(doubleEscaping.Replace(response)); ok {
+			return extracted, nil
+		}
+	}
+	return "", errors.Error(errors.ErrJSONParsing)
+}
+
+// Truncated reports whether the JSON value in an LLM response is cut off before it closes,
+// which is what running out of output tokens looks like when the backend doesn't say so.
+func Truncated(response string) bool {
+	return truncated(response)
+}
+
+// Unmarshal extracts the JSON in an LLM response and decodes it into v with encoding/json.
+func Unmarshal(response string, v interface{}) error {
+	extracted, err := Extract(response)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(extracted), v); err != nil {
+		log.Printf("[llmjson] error unmarshalling: %v", err)
+		return errors.Error(errors.ErrJSONUnmarshalling)
+	}
+	return nil
+}
+
+// UnmarshalProto extracts the JSON in an LLM response and decodes it into message with
+// protojson, ignoring fields the message doesn't have.
+func UnmarshalProto(response string, message proto.Message) error {
+	extracted, err := Extract(response)
+	if err != nil {
+		return err
+	}
+	if err := protoUnmarshal.Unmarshal([]byte(extracted), message); err != nil {
+		log.Printf("[llmjson] error unmarshalling into %s: %v", message.ProtoReflect().Descriptor().FullName(), err)
+		return errors.Error(errors.ErrJSONUnmarshalling)
+	}
+	return nil
+}
+
+func valid(text string) bool {
+	return json.Valid([]byte(text))
+}
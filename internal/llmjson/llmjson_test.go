@@ -0,0 +1,184 @@
+package llmjson
+
+import (
+	"darius/internal/errors"
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     string
+	}{
+		{
+			name:     "plain",
+			response: `{"outlines": ["Goroutines", "Channels"]}`,
+			want:     `{"outlines": ["Goroutines", "Channels"]}`,
+		},
+		{
+			name:     "markdown fence and prose",
+			response: "Here are the new outlines for your exam:\n```json\n{\n  \"outlines\": [\n    \"Context cancellation\"\n  ]\n}\n```\nLet me know if you need more!",
+			want:     "{\n  \"outlines\": [\n    \"Context cancellation\"\n  ]\n}",
+		},
+		{
+			name: "comments and trailing commas",
+			response: `{
+  // MCQ question
+  "questions": [
+    {"id": 1, "text": "What does defer do?", /* keep short */ "type": "MCQ",},
+  ],
+}`,
+			want: `{
+  
+  "questions": [
+    {"id": 1, "text": "What does defer do?",  "type": "MCQ"}
+  ]
+}`,
+		},
+		{
+			name:     "comment markers inside strings are kept",
+			response: `{"correctAnswer": "See https://go.dev/doc // and /* this */"}`,
+			want:     `{"correctAnswer": "See https://go.dev/doc // and /* this */"}`,
+		},
+		{
+			name:     "smart quotes",
+			response: `{“question”: [“What is a goroutine?”, “Explain “select” in Go”]}`,
+			want:     `{"question": ["What is a goroutine?", "Explain “select” in Go"]}`,
+		},
+		{
+			name:     "unescaped newlines and quotes in strings",
+			response: "{\"correctAnswer\": \"Use a buffered channel:\n\tch := make(chan int, 1)\nThe \"1\" is the capacity.\"}",
+			want:     `{"correctAnswer": "Use a buffered channel:\n\tch := make(chan int, 1)\nThe \"1\" is the capacity."}`,
+		},
+		{
+			name:     "invalid escape",
+			response: `{"text": "Match \d+ with regexp"}`,
+			want:     `{"text": "Match \\d+ with regexp"}`,
+		},
+		{
+			name:     "truncated inside a string",
+			response: `{"questions": [{"id": 1, "text": "What is a mutex?"}, {"id": 2, "text": "Explain the differ`,
+			want:     `{"questions": [{"id": 1, "text": "What is a mutex?"}, {"id": 2, "text": "Explain the differ"}]}`,
+		},
+		{
+			name:     "truncated inside a key",
+			response: `{"questions": [{"id": 1, "text": "What is a mutex?", "ty`,
+			want:     `{"questions": [{"id": 1, "text": "What is a mutex?"}]}`,
+		},
+		{
+			name:     "truncated after a colon",
+			response: `{"positiveFeedback": "Clear answers", "finalComment":`,
+			want:     `{"positiveFeedback": "Clear answers", "finalComment":null}`,
+		},
+		{
+			name:     "truncated inside a literal",
+			response: `{"questions": [{"id": 1, "correctOption": 2}, {"id": 2, "correctOption": tr`,
+			want:     `{"questions": [{"id": 1, "correctOption": 2}, {"id": 2}]}`,
+		},
+		{
+			name:     "unclosed array inside a closed object",
+			response: `{"outlines": ["Goroutines", "Channels"}`,
+			want:     `{"outlines": ["Goroutines", "Channels"]}`,
+		},
+		{
+			name:     "bracketed prose before the object",
+			response: `I created [2] questions {as requested}: {"questions": [{"id": 1}, {"id": 2}]}`,
+			want:     `{"questions": [{"id": 1}, {"id": 2}]}`,
+		},
+		{
+			name:     "nested objects",
+			response: `Sure! {"questions": [{"id": 1, "detail": {"options": ["a", "b"]}}, {"id": 2, "detail": {"options": ["c"]}}]} Hope it helps.`,
+			want:     `{"questions": [{"id": 1, "detail": {"options": ["a", "b"]}}, {"id": 2, "detail": {"options": ["c"]}}]}`,
+		},
+		{
+			name:     "top level array",
+			response: "```\n[{\"content\": \"Technical depth\", \"rate\": \"B\"}]\n```",
+			want:     `[{"content": "Technical depth", "rate": "B"}]`,
+		},
+		{
+			name:     "double escaped",
+			response: `"{\n\t\"questions\": [\n\t\t\"What is a goroutine?\"\n\t]\n}"`,
+			want:     "{\n\t\"questions\": [\n\t\t\"What is a goroutine?\"\n\t]\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Extract(tt.response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExtract_NoJSON(t *testing.T) {
+	for _, response := range []string{"", "I'm sorry, I can't help with that.", "{not json at all"} {
+		_, err := Extract(response)
+		assert.Equal(t, errors.Error(errors.ErrJSONParsing), err, response)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	response := "```json\n{\n  \"questions\": [\n    {\n      \"id\": 1,\n      \"text\": \"Which keyword starts a goroutine?\",\n      \"type\": \"MCQ\",\n      \"detail\": {\"type\": \"MCQ\", \"options\": [\"go\", \"defer\", \"chan\", \"select\",], \"correctOption\": 0}\n    },\n    {\n      \"id\": 2,\n      \"text\": \"Explain how a context is cancelled.\",\n      \"type\": \"LONG_ANSWER\",\n      \"detail\": {\"type\": \"LONG_ANSWER\", \"correctAnswer\": \"Calling the cancel func\n closes ctx.Done()"
+	var questions suggest.SuggestExamQuestionResponseV2
+	assert.NoError(t, Unmarshal(response, &questions))
+
+	assert.Len(t, questions.GetQuestions(), 2)
+	assert.Equal(t, []string{"go", "defer", "chan", "select"}, questions.GetQuestions()[0].GetDetail().GetOptions())
+	assert.Equal(t, "Calling the cancel func\n closes ctx.Done()", questions.GetQuestions()[1].GetDetail().GetCorrectAnswer())
+
+	var outlines []string
+	assert.Equal(t, errors.Error(errors.ErrJSONUnmarshalling), Unmarshal(`{"outlines": []}`, &outlines))
+}
+
+func TestUnmarshalProto(t *testing.T) {
+	response := `Here is the evaluation:
+{
+  "result": [
+    {"index": 1, "comment": "The introduction is clear but very brief.", "score": "C"},
+    {"index": 2, "comment": "Good awareness of strengths and weaknesses.", "score": "B"},
+  ],
+  "totalScore": {"A": 0, "B": 1, "C": 1, "D": 0, "F": 0},
+  "positiveFeedback": "- Shows interest in meaningful projects like building an AI chatbot.",
+  "notes": "fields the message doesn't have are ignored",
+  "finalComment": "The candidate shows potential`
+
+	var got suggest.ScoreInterviewResponse
+	assert.NoError(t, UnmarshalProto(response, &got))
+
+	want := &suggest.ScoreInterviewResponse{
+		Result: []*suggest.ScoreInterviewResponse_Submission{
+			{Index: 1, Comment: "The introduction is clear but very brief.", Score: "C"},
+			{Index: 2, Comment: "Good awareness of strengths and weaknesses.", Score: "B"},
+		},
+		TotalScore:       map[string]int32{"A": 0, "B": 1, "C": 1, "D": 0, "F": 0},
+		PositiveFeedback: "- Shows interest in meaningful projects like building an AI chatbot.",
+		FinalComment:     "The candidate shows potential",
+	}
+	assert.True(t, proto.Equal(want, &got), "got %v", &got)
+
+	assert.Equal(t, errors.Error(errors.ErrJSONUnmarshalling), UnmarshalProto(`{"result": "not a list"}`, &got))
+}
+
+func TestTruncated(t *testing.T) {
+	tests := []struct {
+		response string
+		want     bool
+	}{
+		{response: `{"questions": [{"id": 1, "text": "What is a mutex?"}]}`, want: false},
+		{response: "```json\n{\"outlines\": [\"Goroutines\"]}\n```", want: false},
+		{response: `I'm sorry, I can't help with that.`, want: false},
+		{response: `{"questions": [{"id": 1, "text": "What is a mutex?"}, {"id": 2, "text": "Explain the differ`, want: true},
+		{response: "```json\n{\"questions\": [{\"id\": 1}, ", want: true},
+		{response: `I created [2] questions: {"questions": [{"id": 1}, {"id": 2, "te`, want: true},
+		{response: `{"text": "Use } and ] freely inside strings"`, want: true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Truncated(tt.response), tt.response)
+	}
+}
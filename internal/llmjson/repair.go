@@ -0,0 +1,235 @@
+package llmjson
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxCandidates bounds how many opening brackets are tried as the start of the JSON value,
+// so prose full of stray brackets can't make extraction quadratic.
+const maxCandidates = 32
+
+// bareRunes are what numbers, true, false, null and the colon are made of. Anything else
+// outside a string means the candidate is prose.
+const bareRunes = "0123456789+-.eE:truefalsn"
+
+var (
+	fenceLine      = regexp.MustCompile("(?m)^[ \t]*```[A-Za-z0-9_-]*[ \t]*$")
+	doubleEscaping = strings.NewReplacer(`\"`, `"`, `\n`, "\n", `\r`, "\r", `\t`, "\t", `\\`, `\`)
+)
+
+// extract finds the largest JSON object or array in text and repairs it.
+func extract(text string) (string, bool) {
+	text = fenceLine.ReplaceAllString(text, "")
+
+	best := ""
+	for pos, tried := 0, 0; pos < len(text) && tried < maxCandidates; tried++ {
+		start := strings.IndexAny(text[pos:], "{[")
+		if start == -1 {
+			break
+		}
+		start += pos
+
+		repaired, end, _, ok := repair(text[start:])
+		if !ok {
+			pos = start + 1
+			continue
+		}
+		if len(repaired) > len(best) {
+			best = repaired
+		}
+		pos = start + end
+	}
+	return best, best != ""
+}
+
+// truncated reports whether a JSON value in text is cut off before it closes.
+func truncated(text string) bool {
+	text = fenceLine.ReplaceAllString(text, "")
+
+	for pos, tried := 0, 0; pos < len(text) && tried < maxCandidates; tried++ {
+		start := strings.IndexAny(text[pos:], "{[")
+		if start == -1 {
+			return false
+		}
+		start += pos
+
+		_, end, closed, ok := repair(text[start:])
+		switch {
+		case !ok:
+			pos = start + 1
+		case !closed:
+			return true
+		default:
+			pos = start + end
+		}
+	}
+	return false
+}
+
+// scanner rewrites one JSON value as it reads it, fixing what models commonly get wrong.
+type scanner struct {
+	out   []byte
+	stack []byte // closers of the open objects and arrays
+
+	// safe is the last point the output can be cut back to and still be closed into
+	// valid JSON, with the closers that were open there.
+	safeLen   int
+	safeStack []byte
+}
+
+func (s *scanner) markSafe() {
+	s.safeLen = len(s.out)
+	s.safeStack = append(s.safeStack[:0], s.stack...)
+}
+
+// repair rewrites the JSON value at the start of text. It returns the repaired value, how
+// much of text it consumed, whether the value was closed before text ran out and whether
+// the result is valid JSON.
+func repair(text string) (string, int, bool, bool) {
+	s := &scanner{}
+
+	var quote rune // closing quote of the open string, 0 outside strings
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+
+		if quote != 0 {
+			switch {
+			case (r == quote || r == '"') && nextIsStructural(text[i+size:]):
+				s.out = append(s.out, '"')
+				quote = 0
+			case r == '"':
+				// A quote that doesn't end the value is one the model forgot to escape.
+				s.out = append(s.out, '\\', '"')
+			case r == '\\':
+				if i+1 < len(text) && strings.IndexByte(`"\/bfnrtu`, text[i+1]) != -1 {
+					s.out = append(s.out, '\\', text[i+1])
+					i += 2
+					continue
+				}
+				s.out = append(s.out, '\\', '\\')
+			case r == '\n':
+				s.out = append(s.out, '\\', 'n')
+			case r == '\r':
+				s.out = append(s.out, '\\', 'r')
+			case r == '\t':
+				s.out = append(s.out, '\\', 't')
+			case r < 0x20:
+				// Other control characters are never meaningful in generated text.
+			default:
+				s.out = append(s.out, text[i:i+size]...)
+			}
+			i += size
+			continue
+		}
+
+		switch {
+		case r == '"':
+			quote = '"'
+			s.out = append(s.out, '"')
+		case r == '“' || r == '”':
+			quote = '”'
+			s.out = append(s.out, '"')
+		case r == '/' && strings.HasPrefix(text[i:], "//"):
+			end := strings.IndexByte(text[i:], '\n')
+			if end == -1 {
+				i = len(text)
+				continue
+			}
+			i += end
+			continue
+		case r == '/' && strings.HasPrefix(text[i:], "/*"):
+			end := strings.Index(text[i+2:], "*/")
+			if end == -1 {
+				i = len(text)
+				continue
+			}
+			i += end + 4
+			continue
+		case r == '{' || r == '[':
+			closer := byte('}')
+			if r == '[' {
+				closer = ']'
+			}
+			s.stack = append(s.stack, closer)
+			s.out = append(s.out, byte(r))
+			s.markSafe()
+		case r == '}' || r == ']':
+			depth := strings.LastIndexByte(string(s.stack), byte(r))
+			if depth == -1 {
+				// A closer with nothing to close is noise.
+				break
+			}
+			s.dropTrailingComma()
+			// Close whatever the model forgot to close inside this value first.
+			for len(s.stack) > depth {
+				s.out = append(s.out, s.stack[len(s.stack)-1])
+				s.stack = s.stack[:len(s.stack)-1]
+			}
+			if len(s.stack) == 0 {
+				result := string(s.out)
+				return result, i + size, true, valid(result)
+			}
+		case r == ',':
+			s.dropTrailingComma()
+			s.markSafe()
+			s.out = append(s.out, ',')
+		case r == ' ' || r == '\n' || r == '\r' || r == '\t':
+			s.out = append(s.out, byte(r))
+		case r < 0x20 || r == '\ufeff' || r == '\u200b':
+			// Byte order marks and zero-width spaces sneak in around the JSON.
+		case strings.ContainsRune(bareRunes, r):
+			s.out = append(s.out, byte(r))
+		default:
+			// Prose, not JSON.
+			return "", i, false, false
+		}
+		i += size
+	}
+
+	// The output was cut off. Close what is open, or fall back to the last safe point.
+	cut := append([]byte(nil), s.out...)
+	if quote != 0 {
+		cut = append(cut, '"')
+	}
+	cut = trimTrailingComma(cut)
+	if len(cut) > 0 && cut[len(cut)-1] == ':' {
+		cut = append(cut, "null"...)
+	}
+	if result := closeAll(cut, s.stack); valid(result) {
+		return result, len(text), false, true
+	}
+
+	result := closeAll(trimTrailingComma(s.out[:s.safeLen]), s.safeStack)
+	return result, len(text), false, valid(result)
+}
+
+func (s *scanner) dropTrailingComma() {
+	s.out = trimTrailingComma(s.out)
+}
+
+// trimTrailingComma removes a comma that only has whitespace after it from the end of out.
+func trimTrailingComma(out []byte) []byte {
+	last := len(strings.TrimRight(string(out), " \n\r\t")) - 1
+	if last >= 0 && out[last] == ',' {
+		return append(out[:last], out[last+1:]...)
+	}
+	return out
+}
+
+func closeAll(out []byte, stack []byte) string {
+	var builder strings.Builder
+	builder.Write(out)
+	for i := len(stack) - 1; i >= 0; i-- {
+		builder.WriteByte(stack[i])
+	}
+	return builder.String()
+}
+
+// nextIsStructural reports whether the text after a quote continues the JSON structure,
+// which is how a closing straight quote is told apart from one quoted inside the string.
+func nextIsStructural(rest string) bool {
+	rest = strings.TrimLeft(rest, " \n\r\t")
+	return rest == "" || strings.IndexByte(":,}]", rest[0]) != -1
+}
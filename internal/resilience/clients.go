@@ -0,0 +1,122 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+var errServerStatus = errors.New("server answered with a 5xx status")
+
+// UnaryClientInterceptor guards every unary call made through a gRPC connection.
+func UnaryClientInterceptor(guard *Guard) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return guard.Do(ctx, func(ctx context.Context) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+// StreamClientInterceptor guards server-streaming calls. The concurrency slot and the call
+// timeout last until the stream ends, fails or its context is canceled.
+func StreamClientInterceptor(guard *Guard) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, done, err := guard.begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithCancel(ctx)
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			cancel()
+			done(err)
+			return nil, err
+		}
+
+		// Cancelling once the stream is finished also stops the watcher below.
+		guarded := &guardedStream{ClientStream: stream, done: func(err error) { done(err); cancel() }}
+		go func() {
+			<-ctx.Done()
+			guarded.finish(ctx.Err())
+		}()
+		return guarded, nil
+	}
+}
+
+type guardedStream struct {
+	grpc.ClientStream
+	done func(error)
+	once sync.Once
+}
+
+func (s *guardedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == io.EOF {
+		s.finish(nil)
+	} else if err != nil {
+		s.finish(err)
+	}
+	return err
+}
+
+func (s *guardedStream) finish(err error) {
+	s.once.Do(func() { s.done(err) })
+}
+
+// Transport guards every request sent through an http.Client. 5xx responses count as
+// failures for the breaker but are still handed back to the caller.
+func Transport(guard *Guard, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{
+		guard: guard,
+		base:  base,
+	}
+}
+
+type transport struct {
+	guard *Guard
+	base  http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, done, err := t.guard.begin(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		done(err)
+		return nil, err
+	}
+
+	var outcome error
+	if resp.StatusCode >= http.StatusInternalServerError {
+		outcome = errServerStatus
+	}
+	// The call timeout and the concurrency slot last until the body has been read.
+	resp.Body = &guardedBody{ReadCloser: resp.Body, done: func() { done(outcome) }}
+	return resp, nil
+}
+
+type guardedBody struct {
+	io.ReadCloser
+	done   func()
+	closed bool
+}
+
+func (b *guardedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.closed {
+		b.closed = true
+		b.done()
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+package resilience
+
+import (
+	"context"
+	"darius/internal/errors"
+	"darius/metrics"
+	stdErrors "errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrCircuitOpen is returned without calling the dependency while its circuit is open.
+	ErrCircuitOpen = errors.Error(errors.ErrNetworkConnection)
+	// ErrBulkheadFull is returned when no concurrency slot frees up within MaxWait.
+	ErrBulkheadFull = errors.Error(errors.ErrNetworkConnection)
+)
+
+// Config describes how one outbound dependency is protected.
+type Config struct {
+	MaxConcurrent    int           `mapstructure:"max_concurrent"`
+	MaxWait          time.Duration `mapstructure:"max_wait"`
+	Timeout          time.Duration `mapstructure:"timeout"`
+	FailureThreshold int           `mapstructure:"failure_threshold"`
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`
+}
+
+// Guard combines a circuit breaker, a bulkhead and a call timeout for one dependency.
+type Guard struct {
+	name    string
+	config  Config
+	breaker *breaker
+	slots   chan struct{}
+}
+
+func NewGuard(name string, config Config) *Guard {
+	g := &Guard{
+		name:   name,
+		config: config,
+	}
+	g.breaker = newBreaker(config.FailureThreshold, config.OpenTimeout, func(state State) {
+		log.Printf("[Resilience] %s circuit is now %s", name, state)
+		metrics.DependencyCircuitState.WithLabelValues(name).Set(float64(state))
+	})
+	if config.MaxConcurrent > 0 {
+		g.slots = make(chan struct{}, config.MaxConcurrent)
+	}
+	metrics.DependencyCircuitState.WithLabelValues(name).Set(float64(StateClosed))
+	return g
+}
+
+func (g *Guard) Name() string {
+	return g.name
+}
+
+func (g *Guard) State() State {
+	return g.breaker.State()
+}
+
+// Do runs call under the guard. The context handed to call carries the guard timeout.
+func (g *Guard) Do(ctx context.Context, call func(ctx context.Context) error) error {
+	ctx, done, err := g.begin(ctx)
+	if err != nil {
+		return err
+	}
+	err = call(ctx)
+	done(err)
+	return err
+}
+
+// begin admits a call and returns the context to run it with and the callback that
+// records its outcome and frees its slot.
+func (g *Guard) begin(ctx context.Context) (context.Context, func(error), error) {
+	if !g.breaker.allow() {
+		metrics.DependencyRejectedCounter.WithLabelValues(g.name, "circuit_open").Inc()
+		return nil, nil, ErrCircuitOpen
+	}
+
+	if err := g.acquire(ctx); err != nil {
+		g.breaker.release()
+		return nil, nil, err
+	}
+	metrics.DependencyInFlight.WithLabelValues(g.name).Inc()
+
+	cancel := context.CancelFunc(func() {})
+	if g.config.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, g.config.Timeout)
+	}
+
+	done := func(err error) {
+		cancel()
+		metrics.DependencyInFlight.WithLabelValues(g.name).Dec()
+		if g.slots != nil {
+			<-g.slots
+		}
+		// Errors that are not failures (bad input, caller gone, ...) still prove the dependency is up.
+		g.breaker.record(IsFailure(err))
+	}
+	return ctx, done, nil
+}
+
+func (g *Guard) acquire(ctx context.Context) error {
+	if g.slots == nil {
+		return nil
+	}
+
+	select {
+	case g.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	wait := time.NewTimer(g.config.MaxWait)
+	defer wait.Stop()
+	select {
+	case g.slots <- struct{}{}:
+		return nil
+	case <-wait.C:
+		metrics.DependencyRejectedCounter.WithLabelValues(g.name, "bulkhead_full").Inc()
+		return ErrBulkheadFull
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsRejected reports whether the guard refused the call without reaching the dependency.
+func IsRejected(err error) bool {
+	return stdErrors.Is(err, ErrCircuitOpen) || stdErrors.Is(err, ErrBulkheadFull)
+}
+
+// IsFailure reports whether err means the dependency itself is unhealthy, as opposed to
+// a rejected request or a caller that went away.
+func IsFailure(err error) bool {
+	if err == nil || stdErrors.Is(err, context.Canceled) {
+		return false
+	}
+	if stdErrors.Is(err, context.DeadlineExceeded) || stdErrors.Is(err, errServerStatus) {
+		return true
+	}
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.DeadlineExceeded, codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+			return true
+		default:
+			return false
+		}
+	}
+	// Remaining errors come from the transport (refused connection, timeout, broken pipe, ...).
+	return true
+}
@@ -0,0 +1,132 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGuard_OpensAfterConsecutiveFailures(t *testing.T) {
+	guard := NewGuard("test_breaker", Config{FailureThreshold: 2, OpenTimeout: time.Minute})
+	now := time.Now()
+	guard.breaker.now = func() time.Time { return now }
+
+	unavailable := func(ctx context.Context) error { return status.Error(codes.Unavailable, "down") }
+	assert.Error(t, guard.Do(context.Background(), unavailable))
+	assert.Equal(t, StateClosed, guard.State())
+	assert.Error(t, guard.Do(context.Background(), unavailable))
+	assert.Equal(t, StateOpen, guard.State())
+
+	called := false
+	err := guard.Do(context.Background(), func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	assert.False(t, called)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, "Network connection error", err.Error())
+
+	// After the open timeout a single probe closes the circuit again.
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, guard.Do(context.Background(), func(ctx context.Context) error { return nil }))
+	assert.Equal(t, StateClosed, guard.State())
+}
+
+func TestGuard_ClientErrorsDoNotTrip(t *testing.T) {
+	guard := NewGuard("test_client_errors", Config{FailureThreshold: 1, OpenTimeout: time.Minute})
+
+	err := guard.Do(context.Background(), func(ctx context.Context) error {
+		return status.Error(codes.InvalidArgument, "bad request")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, StateClosed, guard.State())
+}
+
+func TestGuard_BulkheadRejectsWhenFull(t *testing.T) {
+	guard := NewGuard("test_bulkhead", Config{MaxConcurrent: 1, MaxWait: 10 * time.Millisecond, FailureThreshold: 5})
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go guard.Do(context.Background(), func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	})
+	<-started
+
+	err := guard.Do(context.Background(), func(ctx context.Context) error { return nil })
+	assert.ErrorIs(t, err, ErrBulkheadFull)
+	assert.True(t, IsRejected(err))
+	close(release)
+}
+
+func TestGuard_AppliesTimeout(t *testing.T) {
+	guard := NewGuard("test_timeout", Config{Timeout: 10 * time.Millisecond, FailureThreshold: 5})
+
+	err := guard.Do(context.Background(), func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestTransport_ServerErrorsTripBreaker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	guard := NewGuard("test_transport", Config{FailureThreshold: 1, OpenTimeout: time.Minute})
+	client := &http.Client{Transport: Transport(guard, nil)}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	resp.Body.Close()
+	assert.Equal(t, StateOpen, guard.State())
+
+	_, err = client.Get(server.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+type fakeClientStream struct {
+	grpc.ClientStream
+	messages int
+}
+
+func (s *fakeClientStream) RecvMsg(m interface{}) error {
+	if s.messages == 0 {
+		return io.EOF
+	}
+	s.messages--
+	return nil
+}
+
+func TestStreamClientInterceptor_HoldsSlotUntilEOF(t *testing.T) {
+	guard := NewGuard("test_stream", Config{MaxConcurrent: 1})
+	interceptor := StreamClientInterceptor(guard)
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return &fakeClientStream{messages: 1}, nil
+	}
+
+	stream, err := interceptor(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, "/test/Stream", streamer)
+	assert.NoError(t, err)
+
+	_, err = interceptor(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, "/test/Stream", streamer)
+	assert.ErrorIs(t, err, ErrBulkheadFull)
+
+	assert.NoError(t, stream.RecvMsg(nil))
+	assert.Equal(t, io.EOF, stream.RecvMsg(nil))
+
+	_, err = interceptor(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, "/test/Stream", streamer)
+	assert.NoError(t, err)
+}
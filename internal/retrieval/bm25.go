@@ -0,0 +1,88 @@
+package retrieval
+
+import (
+	"darius/internal/similarity"
+	"math"
+	"sort"
+)
+
+// Okapi BM25 parameters: how quickly repeating a term stops adding to the score, and how
+// much long passages are held back.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Index ranks passages against a query with Okapi BM25.
+type Index struct {
+	counts    []map[string]int // term counts per passage
+	lengths   []int
+	avgLength float64
+	frequency map[string]int // passages containing each term
+}
+
+// Match is a passage that shares at least one term with the query. Index indexes the
+// texts the Index was built from.
+type Match struct {
+	Index int
+	Score float64
+}
+
+func NewIndex(texts []string) *Index {
+	index := &Index{
+		counts:    make([]map[string]int, len(texts)),
+		lengths:   make([]int, len(texts)),
+		frequency: make(map[string]int),
+	}
+	total := 0
+	for i, text := range texts {
+		terms := similarity.Terms(text)
+		counts := make(map[string]int, len(terms))
+		for _, term := range terms {
+			if counts[term] == 0 {
+				index.frequency[term]++
+			}
+			counts[term]++
+		}
+		index.counts[i], index.lengths[i] = counts, len(terms)
+		total += len(terms)
+	}
+	if len(texts) > 0 {
+		index.avgLength = float64(total) / float64(len(texts))
+	}
+	return index
+}
+
+// Search returns at most limit passages matching the query, best first. Passages scoring
+// the same keep their order.
+func (index *Index) Search(query string, limit int) []Match {
+	queryTerms := make(map[string]bool)
+	for _, term := range similarity.Terms(query) {
+		queryTerms[term] = true
+	}
+
+	n := float64(len(index.counts))
+	var matches []Match
+	for i, counts := range index.counts {
+		score := 0.0
+		for term := range queryTerms {
+			count := float64(counts[term])
+			if count == 0 {
+				continue
+			}
+			frequency := float64(index.frequency[term])
+			idf := math.Log(1 + (n-frequency+0.5)/(frequency+0.5))
+			norm := 1 - bm25B + bm25B*float64(index.lengths[i])/index.avgLength
+			score += idf * count * (bm25K1 + 1) / (count + bm25K1*norm)
+		}
+		if score > 0 {
+			matches = append(matches, Match{Index: i, Score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
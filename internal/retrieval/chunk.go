@@ -0,0 +1,219 @@
+// Package retrieval splits course material uploaded by trainers into passages and finds
+// the passages relevant to a topic, so generated questions can be grounded in the material
+// and cite where they come from. Passages are ranked with BM25 over the same terms the
+// similarity package compares texts by; nothing is sent out to an embedding service.
+package retrieval
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Formats of the uploaded documents.
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatText     = "text"
+	FormatPDF      = "pdf" // text extracted from a PDF, with pages separated by form feeds
+)
+
+var Formats = map[string]bool{
+	FormatMarkdown: true,
+	FormatHTML:     true,
+	FormatText:     true,
+	FormatPDF:      true,
+}
+
+// Chunk is a passage of a document.
+type Chunk struct {
+	Section string // headings the passage is under, outermost first, joined with " > "
+	Page    int    // page of PDF text, from 1; 0 for the other formats
+	Text    string
+}
+
+// block is a paragraph of a document and where it is.
+type block struct {
+	section string
+	page    int
+	text    string
+}
+
+// Split parses the document and packs its paragraphs into chunks of at most size words.
+// Every section and page starts a new chunk, a paragraph longer than size is cut into
+// windows, and consecutive chunks of a section share overlap words so an idea cut in two
+// can still be found from either side.
+func Split(format, content string, size, overlap int) ([]Chunk, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	overlap = max(0, min(overlap, size/2))
+
+	var blocks []block
+	switch format {
+	case FormatMarkdown:
+		blocks = paragraphs(content, 0, true)
+	case FormatHTML:
+		blocks = paragraphs(htmlToMarkdown(content), 0, true)
+	case FormatText:
+		blocks = paragraphs(content, 0, false)
+	case FormatPDF:
+		for i, page := range strings.Split(content, "\f") {
+			blocks = append(blocks, paragraphs(page, i+1, false)...)
+		}
+	default:
+		return nil, fmt.Errorf("unknown document format %q", format)
+	}
+
+	p := &packer{size: size, overlap: overlap}
+	for _, b := range blocks {
+		p.add(b)
+	}
+	p.flush()
+	return p.chunks, nil
+}
+
+var (
+	headingLine = regexp.MustCompile(`^(#{1,6})\s+(.*?)[\s#]*$`)
+	fenceLine   = regexp.MustCompile("^\\s*(```|~~~)")
+)
+
+// paragraphs splits text into its paragraphs at blank lines. With markdown, headings open
+// a section and fenced code blocks stay whole.
+func paragraphs(text string, page int, markdown bool) []block {
+	var (
+		blocks   []block
+		headings []string
+		lines    []string
+		fenced   bool
+	)
+	flush := func() {
+		if paragraph := strings.TrimSpace(strings.Join(lines, "\n")); paragraph != "" {
+			blocks = append(blocks, block{section: section(headings), page: page, text: paragraph})
+		}
+		lines = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if markdown && fenceLine.MatchString(line) {
+			fenced = !fenced
+		} else if markdown && !fenced {
+			if match := headingLine.FindStringSubmatch(line); match != nil {
+				flush()
+				level := len(match[1])
+				for len(headings) < level-1 {
+					headings = append(headings, "")
+				}
+				headings = append(headings[:level-1], match[2])
+				continue
+			}
+		}
+		if !fenced && strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+	flush()
+	return blocks
+}
+
+// section joins the headings, skipping the levels a document jumped over.
+func section(headings []string) string {
+	var path []string
+	for _, heading := range headings {
+		if heading != "" {
+			path = append(path, heading)
+		}
+	}
+	return strings.Join(path, " > ")
+}
+
+var (
+	htmlIgnored  = regexp.MustCompile(`(?is)<!--.*?-->|<script\b.*?</script>|<style\b.*?</style>|<head\b.*?</head>`)
+	htmlHeading  = regexp.MustCompile(`(?is)<h([1-6])\b[^>]*>(.*?)</h[1-6]>`)
+	htmlBlockTag = regexp.MustCompile(`(?i)</?(p|div|section|article|main|header|footer|nav|aside|li|ul|ol|dl|dt|dd|tr|table|thead|tbody|blockquote|pre|figure|figcaption|br|hr)\b[^>]*>`)
+	htmlTag      = regexp.MustCompile(`(?s)<[^>]*>`)
+	blankLines   = regexp.MustCompile(`\n\s*\n\s*`)
+	spaces       = regexp.MustCompile(`[ \t]+`)
+)
+
+// htmlToMarkdown keeps the text of an HTML page, turning its headings into markdown
+// headings and its block elements into paragraphs.
+func htmlToMarkdown(page string) string {
+	page = htmlIgnored.ReplaceAllString(page, "")
+	page = htmlHeading.ReplaceAllStringFunc(page, func(heading string) string {
+		match := htmlHeading.FindStringSubmatch(heading)
+		text := strings.Join(strings.Fields(html.UnescapeString(htmlTag.ReplaceAllString(match[2], ""))), " ")
+		return "\n\n" + strings.Repeat("#", int(match[1][0]-'0')) + " " + text + "\n\n"
+	})
+	page = htmlBlockTag.ReplaceAllString(page, "\n\n")
+	page = html.UnescapeString(htmlTag.ReplaceAllString(page, ""))
+
+	lines := strings.Split(page, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(spaces.ReplaceAllString(line, " "))
+	}
+	return blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+}
+
+// packer gathers consecutive paragraphs of a section into chunks.
+type packer struct {
+	size, overlap int
+	chunks        []Chunk
+
+	section string
+	page    int
+	parts   []string
+	words   int
+	carried bool // parts only hold the end of the previous chunk
+}
+
+func (p *packer) add(b block) {
+	if b.section != p.section || b.page != p.page {
+		p.flush()
+		p.parts, p.words, p.carried = nil, 0, false
+		p.section, p.page = b.section, b.page
+	}
+
+	words := strings.Fields(b.text)
+	if len(words) > p.size {
+		p.flush()
+		for start := 0; ; start += p.size - p.overlap {
+			end := min(start+p.size, len(words))
+			p.parts, p.words, p.carried = []string{strings.Join(words[start:end], " ")}, end-start, false
+			if end == len(words) {
+				// The last window stays open, the next paragraphs may fit in with it.
+				return
+			}
+			p.flush()
+		}
+	}
+
+	if p.words+len(words) > p.size {
+		p.flush()
+		if p.words+len(words) > p.size {
+			p.parts, p.words = nil, 0
+		}
+	}
+	p.parts = append(p.parts, b.text)
+	p.words += len(words)
+	p.carried = false
+}
+
+// flush closes the open chunk and starts the next one with its last overlap words.
+func (p *packer) flush() {
+	if len(p.parts) == 0 || p.carried {
+		return
+	}
+	text := strings.Join(p.parts, "\n\n")
+	p.chunks = append(p.chunks, Chunk{Section: p.section, Page: p.page, Text: text})
+	p.parts, p.words = nil, 0
+
+	if p.overlap > 0 {
+		words := strings.Fields(text)
+		tail := words[max(0, len(words)-p.overlap):]
+		p.parts, p.words, p.carried = []string{strings.Join(tail, " ")}, len(tail), true
+	}
+}
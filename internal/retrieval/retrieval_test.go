@@ -0,0 +1,100 @@
+package retrieval
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit_Markdown(t *testing.T) {
+	content := "# Concurrency\n\nGo runs functions concurrently with goroutines.\n\n" +
+		"## Channels\n\nChannels connect goroutines.\n\n```go\nch := make(chan int)\n\nch <- 1\n```\n\n" +
+		"#### Buffered\n\nA buffered channel only blocks when full.\n"
+
+	chunks, err := Split(FormatMarkdown, content, 100, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []Chunk{
+		{Section: "Concurrency", Text: "Go runs functions concurrently with goroutines."},
+		{Section: "Concurrency > Channels", Text: "Channels connect goroutines.\n\n```go\nch := make(chan int)\n\nch <- 1\n```"},
+		{Section: "Concurrency > Channels > Buffered", Text: "A buffered channel only blocks when full."},
+	}, chunks)
+}
+
+func TestSplit_HTML(t *testing.T) {
+	content := `<html><head><title>Ignored</title></head><body>
+		<h1>Indexes</h1>
+		<p>A <b>B-tree</b> index keeps keys sorted &amp; balanced.</p>
+		<script>track()</script>
+		<h2>Covering  <em>indexes</em></h2><p>They hold every column a query reads.</p>
+	</body></html>`
+
+	chunks, err := Split(FormatHTML, content, 100, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []Chunk{
+		{Section: "Indexes", Text: "A B-tree index keeps keys sorted & balanced."},
+		{Section: "Indexes > Covering indexes", Text: "They hold every column a query reads."},
+	}, chunks)
+}
+
+func TestSplit_PDF(t *testing.T) {
+	chunks, err := Split(FormatPDF, "First page.\n\nStill the first page.\fSecond page.", 100, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []Chunk{
+		{Page: 1, Text: "First page.\n\nStill the first page."},
+		{Page: 2, Text: "Second page."},
+	}, chunks)
+
+	_, err = Split("docx", "text", 100, 0)
+	assert.Error(t, err)
+}
+
+func TestSplit_Size(t *testing.T) {
+	// Paragraphs are packed up to the size, and the next chunk repeats the end of the last.
+	chunks, err := Split(FormatText, "one two three\n\nfour five\n\nsix seven eight", 5, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one two three\n\nfour five", "four five\n\nsix seven eight"}, texts(chunks))
+
+	// A paragraph longer than the size is cut into overlapping windows.
+	chunks, err = Split(FormatText, "a1 a2 a3 a4 a5 a6 a7 a8", 4, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a1 a2 a3 a4", "a4 a5 a6 a7", "a7 a8"}, texts(chunks))
+	for _, chunk := range chunks {
+		assert.LessOrEqual(t, len(strings.Fields(chunk.Text)), 4)
+	}
+}
+
+func texts(chunks []Chunk) []string {
+	var texts []string
+	for _, chunk := range chunks {
+		texts = append(texts, chunk.Text)
+	}
+	return texts
+}
+
+func TestIndex_Search(t *testing.T) {
+	index := NewIndex([]string{
+		"A goroutine is a lightweight thread managed by the Go runtime.",
+		"Channels let goroutines communicate. Sending on an unbuffered channel blocks until a receiver is ready.",
+		"An index speeds up reads at the cost of slower writes.",
+	})
+
+	matches := index.Search("buffered channels", 5)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, 1, matches[0].Index)
+
+	matches = index.Search("goroutines", 5)
+	assert.Equal(t, []int{0, 1}, indexes(matches), "the shorter passage ranks first")
+	assert.Len(t, index.Search("goroutines", 1), 1)
+
+	assert.Empty(t, index.Search("kubernetes", 5))
+	assert.Empty(t, NewIndex(nil).Search("goroutines", 5))
+}
+
+func indexes(matches []Match) []int {
+	var indexes []int
+	for _, match := range matches {
+		indexes = append(indexes, match.Index)
+	}
+	return indexes
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package sandbox
+
+import "os/exec"
+
+// killProcessGroup leaves cancellation to exec, which kills only the process itself.
+func killProcessGroup(cmd *exec.Cmd) {}
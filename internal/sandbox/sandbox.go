@@ -0,0 +1,269 @@
+// Package sandbox runs untrusted code, such as the reference solutions of generated coding
+// questions, in a throwaway directory as a subprocess with CPU time, memory, process count,
+// output size and wall clock limits. Programs read a test's input on stdin and print the
+// answer on stdout. Nothing is run without a Wrapper that isolates the program from the
+// host, such as nsjail, bwrap or a container without network and with a read-only root.
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnsupportedLanguage is returned for a language that has no Language config.
+	ErrUnsupportedLanguage = stdErrors.New("sandbox: unsupported language")
+	// ErrUnavailable is returned when the compiler or interpreter of a language is not installed.
+	ErrUnavailable = stdErrors.New("sandbox: language toolchain is not installed")
+	// ErrNotIsolated is returned when no Wrapper is configured to isolate the program.
+	ErrNotIsolated = stdErrors.New("sandbox: no isolation wrapper is configured")
+)
+
+// Config sets the limits of every run and the languages that can be run.
+//
+// MaxProcesses is applied with ulimit -u, which counts every process of the user a program
+// runs as, not only the ones of its own run. It only bounds a single run when the Wrapper
+// runs every program as a user of its own, as nsjail does with a fresh user namespace;
+// a wrapper that shares one user across runs must enforce it itself, e.g. with a pids cgroup.
+type Config struct {
+	Timeout           time.Duration       `mapstructure:"timeout"`             // wall clock limit of one test run
+	CompileTimeout    time.Duration       `mapstructure:"compile_timeout"`     // wall clock limit of compiling
+	CPUSeconds        int                 `mapstructure:"cpu_seconds"`         // CPU time limit of one test run
+	CompileCPUSeconds int                 `mapstructure:"compile_cpu_seconds"` // CPU time limit of compiling
+	MemoryMB          int                 `mapstructure:"memory_mb"`           // address space limit of one test run, on top of what the language reserves
+	CompileMemoryMB   int                 `mapstructure:"compile_memory_mb"`   // address space limit of compiling, on top of what the language reserves
+	MaxProcesses      int                 `mapstructure:"max_processes"`       // most processes the user of a run may have, against fork bombs
+	MaxOutputKB       int                 `mapstructure:"max_output_kb"`       // most output and file size a run may write
+	Wrapper           []string            `mapstructure:"wrapper"`             // required command isolating every program, e.g. ["nsjail", "--config", "sandbox.cfg", "--"]
+	Env               []string            `mapstructure:"env"`                 // extra KEY=value environment, e.g. a shared GOCACHE
+	Languages         map[string]Language `mapstructure:"languages"`
+}
+
+// Language says how to compile and run a program. Commands run in the directory the code
+// was written to, as File.
+type Language struct {
+	File       string   `mapstructure:"file"`
+	Compile    []string `mapstructure:"compile"` // optional
+	Run        []string `mapstructure:"run"`
+	ReservedMB int      `mapstructure:"reserved_mb"` // address space the runtime reserves up front without using it, added to the memory limits
+}
+
+// DefaultLanguages are the languages the sandbox knows out of the box, by their usual names.
+// The Go runtime, the Go toolchain and node reserve several hundred MB of address space
+// before running any code.
+var DefaultLanguages = map[string]Language{
+	"python":     {File: "main.py", Run: []string{"python3", "main.py"}},
+	"python3":    {File: "main.py", Run: []string{"python3", "main.py"}},
+	"javascript": {File: "main.js", Run: []string{"node", "main.js"}, ReservedMB: 1024},
+	"js":         {File: "main.js", Run: []string{"node", "main.js"}, ReservedMB: 1024},
+	"go":         {File: "main.go", Compile: []string{"go", "build", "-o", "main", "main.go"}, Run: []string{"./main"}, ReservedMB: 1024},
+	"golang":     {File: "main.go", Compile: []string{"go", "build", "-o", "main", "main.go"}, Run: []string{"./main"}, ReservedMB: 1024},
+}
+
+// TestCase is one run of a program: the input it gets and the output it must print.
+type TestCase struct {
+	Input          string
+	ExpectedOutput string
+}
+
+// Result is the outcome of one test case. Error says why a run failed, such as a timeout
+// or a crash, and is empty when the program ran to the end.
+type Result struct {
+	Passed bool
+	Output string
+	Error  string
+}
+
+// CompileError is returned when the program does not compile.
+type CompileError struct {
+	Output string
+}
+
+func (e *CompileError) Error() string {
+	return "sandbox: compile error: " + e.Output
+}
+
+// Runner runs programs against test cases.
+type Runner interface {
+	// Run writes code to a fresh directory, compiles it if the language needs it and runs
+	// it once per test case. It returns ErrNotIsolated when there is no Wrapper.
+	Run(ctx context.Context, language, code string, tests []TestCase) ([]Result, error)
+}
+
+type runner struct {
+	config Config
+}
+
+func New(config Config) Runner {
+	return &runner{config: config}
+}
+
+func (r *runner) Run(ctx context.Context, language, code string, tests []TestCase) ([]Result, error) {
+	lang, ok := r.config.Languages[strings.ToLower(strings.TrimSpace(language))]
+	if !ok || len(lang.Run) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedLanguage, language)
+	}
+	if len(r.config.Wrapper) == 0 {
+		return nil, ErrNotIsolated
+	}
+
+	dir, err := os.MkdirTemp("", "darius-sandbox-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if err := os.WriteFile(filepath.Join(dir, lang.File), []byte(code), 0o600); err != nil {
+		return nil, err
+	}
+
+	if len(lang.Compile) > 0 {
+		out, err := r.exec(ctx, dir, lang.Compile, "", r.config.CompileTimeout, r.config.CompileCPUSeconds, limitMB(r.config.CompileMemoryMB, lang))
+		if stdErrors.Is(err, ErrUnavailable) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, &CompileError{Output: strings.TrimSpace(out.stderr + "\n" + out.stdout)}
+		}
+	}
+
+	results := make([]Result, 0, len(tests))
+	for _, test := range tests {
+		out, err := r.exec(ctx, dir, lang.Run, test.Input, r.config.Timeout, r.config.CPUSeconds, limitMB(r.config.MemoryMB, lang))
+		if stdErrors.Is(err, ErrUnavailable) {
+			return nil, err
+		}
+		result := Result{Output: out.stdout}
+		switch {
+		case err != nil:
+			result.Error = err.Error()
+			if stderr := strings.TrimSpace(out.stderr); stderr != "" {
+				result.Error += ": " + lastLines(stderr, 5)
+			}
+		case out.truncated:
+			result.Error = fmt.Sprintf("output is larger than %d KB", r.config.MaxOutputKB)
+		default:
+			result.Passed = sameOutput(out.stdout, test.ExpectedOutput)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+type output struct {
+	stdout, stderr string
+	truncated      bool
+}
+
+// limitMB is the address space limit of a language, memoryMB on top of what its runtime
+// reserves. It is 0, no limit, when memoryMB is.
+func limitMB(memoryMB int, lang Language) int {
+	if memoryMB <= 0 {
+		return 0
+	}
+	return memoryMB + lang.ReservedMB
+}
+
+// exec runs command under sh, which applies the limits with ulimit before exec'ing it.
+// Compiling gets limits of its own, compilers need more memory and time than programs.
+func (r *runner) exec(ctx context.Context, dir string, command []string, stdin string, timeout time.Duration, cpuSeconds, memoryMB int) (output, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	args := append([]string{"-c", r.prelude(cpuSeconds, memoryMB), "sh"}, r.config.Wrapper...)
+	cmd := exec.CommandContext(ctx, "/bin/sh", append(args, command...)...)
+	cmd.Dir = dir
+	cmd.Env = append([]string{"PATH=" + os.Getenv("PATH"), "HOME=" + dir, "TMPDIR=" + dir, "LANG=C.UTF-8"}, r.config.Env...)
+	cmd.Stdin = strings.NewReader(stdin)
+	limit := r.config.MaxOutputKB * 1024
+	stdout, stderr := &limitedBuffer{max: limit}, &limitedBuffer{max: limit}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	cmd.WaitDelay = time.Second
+	killProcessGroup(cmd)
+
+	err := cmd.Run()
+	out := output{stdout: stdout.String(), stderr: stderr.String(), truncated: stdout.truncated}
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return out, nil
+	case ctx.Err() == context.DeadlineExceeded:
+		return out, fmt.Errorf("timed out after %v", timeout)
+	case stdErrors.As(err, &exitErr) && exitErr.ExitCode() == 127:
+		// sh could not find the compiler or interpreter.
+		return out, fmt.Errorf("%w: %s", ErrUnavailable, command[0])
+	default:
+		return out, err
+	}
+}
+
+// prelude sets the output and process limits of every command, and the given CPU time and
+// address space limits. The address space, unlike the data segment, also covers memory
+// a program maps itself.
+func (r *runner) prelude(cpuSeconds, memoryMB int) string {
+	var limits []string
+	if r.config.MaxOutputKB > 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -f %d", r.config.MaxOutputKB))
+	}
+	if r.config.MaxProcesses > 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -u %d", r.config.MaxProcesses))
+	}
+	if cpuSeconds > 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -t %d", cpuSeconds))
+	}
+	if memoryMB > 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -v %d", memoryMB*1024))
+	}
+	return strings.Join(append(limits, `exec "$@"`), "; ")
+}
+
+// sameOutput compares outputs line by line, ignoring trailing whitespace and line endings.
+func sameOutput(got, want string) bool {
+	return normalizeOutput(got) == normalizeOutput(want)
+}
+
+func normalizeOutput(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+func lastLines(text string, n int) string {
+	lines := strings.Split(text, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// limitedBuffer keeps the first max bytes written to it and drops the rest, without
+// failing the writer.
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.max > 0 && b.buf.Len()+len(p) > b.max {
+		b.truncated = true
+		b.buf.Write(p[:b.max-b.buf.Len()])
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
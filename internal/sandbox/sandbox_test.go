@@ -0,0 +1,108 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testWrapper stands in for an isolating wrapper, the tests only run code they trust.
+var testWrapper = []string{"env"}
+
+func newTestRunner(t *testing.T) Runner {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 is not installed")
+	}
+	return New(Config{
+		Timeout:     2 * time.Second,
+		CPUSeconds:  2,
+		MemoryMB:    128,
+		MaxOutputKB: 64,
+		Wrapper:     testWrapper,
+		Languages:   DefaultLanguages,
+	})
+}
+
+func TestRun(t *testing.T) {
+	runner := newTestRunner(t)
+	solution := "import sys\nprint(sum(int(x) for x in sys.stdin.read().split()))\n"
+
+	results, err := runner.Run(context.Background(), "Python", solution, []TestCase{
+		{Input: "1 2 3\n", ExpectedOutput: "6\n"},
+		{Input: "10 -4", ExpectedOutput: "6  \r\n\n"},
+		{Input: "1 1", ExpectedOutput: "3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Result{
+		{Passed: true, Output: "6\n"},
+		{Passed: true, Output: "6\n"},
+		{Passed: false, Output: "2\n"},
+	}, results)
+}
+
+func TestRun_Limits(t *testing.T) {
+	runner := newTestRunner(t)
+	tests := []TestCase{{Input: "", ExpectedOutput: "done"}}
+
+	started := time.Now()
+	results, err := runner.Run(context.Background(), "python", "while True:\n    pass\n", tests)
+	assert.NoError(t, err)
+	assert.False(t, results[0].Passed)
+	assert.NotEmpty(t, results[0].Error)
+	assert.Less(t, time.Since(started), 5*time.Second)
+
+	results, err = runner.Run(context.Background(), "python", "blocks = []\nwhile True:\n    blocks.append(' ' * 10**7)\n", tests)
+	assert.NoError(t, err)
+	assert.False(t, results[0].Passed)
+	assert.Contains(t, results[0].Error, "MemoryError")
+
+	results, err = runner.Run(context.Background(), "python", "while True:\n    print('x' * 1000)\n", tests)
+	assert.NoError(t, err)
+	assert.False(t, results[0].Passed)
+	assert.NotEmpty(t, results[0].Error)
+	assert.LessOrEqual(t, len(results[0].Output), 64*1024)
+}
+
+func TestRun_Errors(t *testing.T) {
+	runner := newTestRunner(t)
+
+	_, err := runner.Run(context.Background(), "cobol", "DISPLAY 'HI'.", nil)
+	assert.ErrorIs(t, err, ErrUnsupportedLanguage)
+
+	_, err = New(Config{Timeout: time.Second, Languages: DefaultLanguages}).Run(context.Background(), "python", "print(1)", []TestCase{{}})
+	assert.ErrorIs(t, err, ErrNotIsolated)
+
+	unavailable := New(Config{Timeout: time.Second, Wrapper: testWrapper, Languages: map[string]Language{
+		"brainfuck": {File: "main.bf", Run: []string{"no-such-interpreter", "main.bf"}},
+	}})
+	_, err = unavailable.Run(context.Background(), "brainfuck", "+.", []TestCase{{}})
+	assert.ErrorIs(t, err, ErrUnavailable)
+
+	if goCache, lookErr := exec.Command("go", "env", "GOCACHE").Output(); lookErr == nil {
+		_, err = New(Config{
+			CompileTimeout: time.Minute,
+			Timeout:        time.Second,
+			Env:            []string{"GOCACHE=" + strings.TrimSpace(string(goCache))},
+			Wrapper:        testWrapper,
+			Languages:      DefaultLanguages,
+		}).Run(context.Background(), "go", "package main\nfunc main() { undefined() }\n", []TestCase{{}})
+		var compileErr *CompileError
+		assert.True(t, errors.As(err, &compileErr), "%v", err)
+	}
+}
+
+func TestPrelude(t *testing.T) {
+	r := &runner{config: Config{MaxProcesses: 32, MaxOutputKB: 64}}
+	assert.Equal(t, `ulimit -f 64; ulimit -u 32; ulimit -t 2; ulimit -v 131072; exec "$@"`, r.prelude(2, 128))
+	assert.Equal(t, `ulimit -f 64; ulimit -u 32; exec "$@"`, r.prelude(0, 0))
+
+	// The runtime's reservation comes on top of the memory limit, and no limit stays none.
+	assert.Equal(t, 1152, limitMB(128, DefaultLanguages["go"]))
+	assert.Equal(t, 128, limitMB(128, DefaultLanguages["python"]))
+	assert.Equal(t, 0, limitMB(0, DefaultLanguages["go"]))
+}
@@ -3,6 +3,7 @@ package bulbasaur
 import (
 	"context"
 	"darius/internal/errors"
+	"darius/internal/resilience"
 	"darius/pkg/proto/deps/bulbasaur"
 	"log"
 
@@ -33,6 +34,10 @@ func (s *service) CheckCallingLLM(ctx context.Context, uid uint64, amount float3
 	})
 
 	if err != nil {
+		if resilience.IsRejected(err) {
+			log.Printf("[CheckCallingLLM] Bulbasaur is unavailable: %v", err)
+			return "", err
+		}
 		st, ok := status.FromError(err)
 		if ok && st.Code() == codes.Internal {
 			log.Printf("[CheckCallingLLM] 500 Internal Server Error: %v", err)
@@ -0,0 +1,63 @@
+package llm_grpc
+
+import (
+	"math/rand"
+	"sync"
+)
+
+const maxConversations = 1000
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// conversationStore keeps chat history for stateless HTTP backends so callers can
+// keep threading a conversation id the same way they do with Arceus.
+type conversationStore struct {
+	mu            sync.Mutex
+	lastId        uint64
+	conversations map[uint64][]chatMessage
+}
+
+func newConversationStore() *conversationStore {
+	return &conversationStore{
+		// Start at a random point far away from the small database ids Arceus hands out so
+		// a conversation id never means something to two providers at once.
+		lastId:        uint64(rand.Int63()),
+		conversations: make(map[uint64][]chatMessage),
+	}
+}
+
+// history returns the id to use for this turn and the messages exchanged so far.
+// An unknown or nil id starts a new conversation.
+func (s *conversationStore) history(conversationId *uint64) (uint64, []chatMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if conversationId != nil {
+		if messages, ok := s.conversations[*conversationId]; ok {
+			return *conversationId, append([]chatMessage(nil), messages...)
+		}
+	}
+
+	s.lastId++
+	return s.lastId, nil
+}
+
+func (s *conversationStore) save(conversationId uint64, messages []chatMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.conversations[conversationId]; !ok && len(s.conversations) >= maxConversations {
+		// Ids are monotonic, so the smallest one is the oldest conversation.
+		oldest := conversationId
+		for id := range s.conversations {
+			if id < oldest {
+				oldest = id
+			}
+		}
+		delete(s.conversations, oldest)
+	}
+	s.conversations[conversationId] = messages
+}
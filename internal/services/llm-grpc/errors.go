@@ -0,0 +1,90 @@
+package llm_grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrorClass string
+
+const (
+	ErrorClassTimeout     ErrorClass = "timeout"
+	ErrorClassUnavailable ErrorClass = "unavailable"
+	ErrorClassRateLimited ErrorClass = "rate_limited"
+	ErrorClassCanceled    ErrorClass = "canceled"
+	ErrorClassClient      ErrorClass = "client"
+)
+
+// StatusError is returned by the HTTP providers when the backend answers with a non-200 status.
+type StatusError struct {
+	Provider   string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("HTTP request failed with status code: %d", e.StatusCode)
+}
+
+// ShouldFailover reports whether another provider or model may succeed where this one failed.
+func (c ErrorClass) ShouldFailover() bool {
+	switch c {
+	case ErrorClassTimeout, ErrorClassUnavailable, ErrorClassRateLimited:
+		return true
+	default:
+		return false
+	}
+}
+
+// ClassifyError sorts a provider error into timeouts, backend outages, rate limits,
+// caller cancellations and client errors.
+func ClassifyError(err error) ErrorClass {
+	if errors.Is(err, context.Canceled) {
+		return ErrorClassCanceled
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTimeout
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch {
+		case statusErr.StatusCode == http.StatusTooManyRequests:
+			return ErrorClassRateLimited
+		case statusErr.StatusCode == http.StatusRequestTimeout || statusErr.StatusCode == http.StatusGatewayTimeout:
+			return ErrorClassTimeout
+		case statusErr.StatusCode >= 500:
+			return ErrorClassUnavailable
+		default:
+			return ErrorClassClient
+		}
+	}
+
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.DeadlineExceeded:
+			return ErrorClassTimeout
+		case codes.Unavailable, codes.Internal, codes.Unknown, codes.Aborted:
+			return ErrorClassUnavailable
+		case codes.ResourceExhausted:
+			return ErrorClassRateLimited
+		case codes.Canceled:
+			return ErrorClassCanceled
+		default:
+			return ErrorClassClient
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorClassTimeout
+	}
+
+	// Anything else is a transport failure (refused connection, broken body, ...).
+	return ErrorClassUnavailable
+}
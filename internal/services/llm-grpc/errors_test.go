@@ -0,0 +1,39 @@
+package llm_grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		class    ErrorClass
+		failover bool
+	}{
+		{"context deadline", fmt.Errorf("call: %w", context.DeadlineExceeded), ErrorClassTimeout, true},
+		{"context canceled", context.Canceled, ErrorClassCanceled, false},
+		{"http 503", &StatusError{StatusCode: 503}, ErrorClassUnavailable, true},
+		{"http 429", &StatusError{StatusCode: 429}, ErrorClassRateLimited, true},
+		{"http 400", &StatusError{StatusCode: 400}, ErrorClassClient, false},
+		{"grpc deadline", status.Error(codes.DeadlineExceeded, "slow"), ErrorClassTimeout, true},
+		{"grpc unavailable", status.Error(codes.Unavailable, "down"), ErrorClassUnavailable, true},
+		{"grpc invalid argument", status.Error(codes.InvalidArgument, "bad"), ErrorClassClient, false},
+		{"transport", errors.New("connection refused"), ErrorClassUnavailable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class := ClassifyError(tt.err)
+			assert.Equal(t, tt.class, class)
+			assert.Equal(t, tt.failover, class.ShouldFailover())
+		})
+	}
+}
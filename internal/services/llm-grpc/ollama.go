@@ -0,0 +1,195 @@
+package llm_grpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	arceus "darius/pkg/proto/deps/arceus"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	URL_OllamaChat = "/api/chat"
+)
+
+type ollamaChatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+	Options  ollamaOptions `json:"options"`
+}
+
+type ollamaOptions struct {
+	Temperature *float32 `json:"temperature,omitempty"`
+	NumPredict  *int32   `json:"num_predict,omitempty"`
+}
+
+type ollamaChatResponse struct {
+	CreatedAt       time.Time   `json:"created_at"`
+	Message         chatMessage `json:"message"`
+	Done            bool        `json:"done"`
+	DoneReason      string      `json:"done_reason"`
+	PromptEvalCount int32       `json:"prompt_eval_count"`
+	EvalCount       int32       `json:"eval_count"`
+}
+
+// ollamaProvider talks to a local Ollama server (or anything exposing its /api/chat).
+type ollamaProvider struct {
+	host          string
+	httpClient    *http.Client
+	conversations *conversationStore
+}
+
+func NewOllamaProvider(host string, httpClient *http.Client) Provider {
+	return &ollamaProvider{
+		host:          strings.TrimSuffix(host, "/"),
+		httpClient:    httpClient,
+		conversations: newConversationStore(),
+	}
+}
+
+func (p *ollamaProvider) Name() string {
+	return ProviderOllama
+}
+
+func (p *ollamaProvider) GenerateText(ctx context.Context, req *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+	conversationId, messages := p.conversations.history(req.ConversationId)
+	messages = append(messages, chatMessage{Role: "user", Content: req.GetContent()})
+
+	httpResp, err := p.post(ctx, req, messages, false)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		log.Printf("[Ollama][GenerateText] Error reading response body: %v", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	chatResp := &ollamaChatResponse{}
+	if err := json.Unmarshal(respBody, chatResp); err != nil {
+		log.Printf("[Ollama][GenerateText] Error unmarshalling response body: %v", err)
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	p.conversations.save(conversationId, append(messages, chatMessage{Role: "assistant", Content: chatResp.Message.Content}))
+
+	return toGenerateTextResponse(chatResp, chatResp.Message.Content, conversationId), nil
+}
+
+// GenerateTextStream reads the newline-delimited JSON objects of a streamed chat.
+func (p *ollamaProvider) GenerateTextStream(ctx context.Context, req *arceus.GenerateTextRequest, onChunk func(string) error) (*arceus.GenerateTextResponse, error) {
+	conversationId, messages := p.conversations.history(req.ConversationId)
+	messages = append(messages, chatMessage{Role: "user", Content: req.GetContent()})
+
+	httpResp, err := p.post(ctx, req, messages, true)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var content strings.Builder
+	last := &ollamaChatResponse{}
+
+	scanner := bufio.NewScanner(httpResp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		chunk := &ollamaChatResponse{}
+		if err := json.Unmarshal(line, chunk); err != nil {
+			log.Printf("[Ollama][GenerateTextStream] Error unmarshalling chunk: %v", err)
+			return nil, fmt.Errorf("failed to unmarshal stream chunk: %w", err)
+		}
+		last = chunk
+
+		if chunk.Message.Content != "" {
+			content.WriteString(chunk.Message.Content)
+			if err := onChunk(chunk.Message.Content); err != nil {
+				return nil, err
+			}
+		}
+		if chunk.Done {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("[Ollama][GenerateTextStream] Error reading stream: %v", err)
+		return nil, err
+	}
+
+	p.conversations.save(conversationId, append(messages, chatMessage{Role: "assistant", Content: content.String()}))
+
+	return toGenerateTextResponse(last, content.String(), conversationId), nil
+}
+
+// post sends a chat request and returns the response once its status is OK.
+func (p *ollamaProvider) post(ctx context.Context, req *arceus.GenerateTextRequest, messages []chatMessage, stream bool) (*http.Response, error) {
+	jsonBody, err := json.Marshal(&ollamaChatRequest{
+		Model:    req.GetModel(),
+		Messages: messages,
+		Stream:   stream,
+		Options: ollamaOptions{
+			Temperature: req.Temperature,
+			NumPredict:  req.MaxTokens,
+		},
+	})
+	if err != nil {
+		log.Printf("[Ollama] Error marshalling request body: %v", err)
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.host+URL_OllamaChat, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.Printf("[Ollama] Error creating HTTP request: %v", err)
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		log.Printf("[Ollama] Error making HTTP request: %v", err)
+		return nil, err
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		httpResp.Body.Close()
+		log.Printf("[Ollama] HTTP request failed with status code: %d, body: %s", httpResp.StatusCode, respBody)
+		return nil, &StatusError{Provider: ProviderOllama, StatusCode: httpResp.StatusCode}
+	}
+
+	return httpResp, nil
+}
+
+func toGenerateTextResponse(chatResp *ollamaChatResponse, content string, conversationId uint64) *arceus.GenerateTextResponse {
+	createdAt := chatResp.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	return &arceus.GenerateTextResponse{
+		Content:        content,
+		ConversationId: conversationId,
+		CreatedAt:      timestamppb.New(createdAt),
+		Usage: &arceus.Usage{
+			PromptTokens:     chatResp.PromptEvalCount,
+			CompletionTokens: chatResp.EvalCount,
+			TotalTokens:      chatResp.PromptEvalCount + chatResp.EvalCount,
+		},
+		FinishReason: chatResp.DoneReason,
+	}
+}
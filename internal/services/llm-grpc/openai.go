@@ -0,0 +1,249 @@
+package llm_grpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	arceus "darius/pkg/proto/deps/arceus"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	URL_OpenAIChatCompletions = "/chat/completions"
+)
+
+type openAIChatRequest struct {
+	Model         string               `json:"model"`
+	Messages      []chatMessage        `json:"messages"`
+	Temperature   *float32             `json:"temperature,omitempty"`
+	MaxTokens     *int32               `json:"max_tokens,omitempty"`
+	Stream        bool                 `json:"stream,omitempty"`
+	StreamOptions *openAIStreamOptions `json:"stream_options,omitempty"`
+}
+
+type openAIStreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+type openAIUsage struct {
+	PromptTokens     int32 `json:"prompt_tokens"`
+	CompletionTokens int32 `json:"completion_tokens"`
+	TotalTokens      int32 `json:"total_tokens"`
+}
+
+type openAIChatResponse struct {
+	Created int64 `json:"created"`
+	Choices []struct {
+		Message      chatMessage `json:"message"`
+		FinishReason string      `json:"finish_reason"`
+	} `json:"choices"`
+	Usage openAIUsage `json:"usage"`
+}
+
+type openAIChatChunk struct {
+	Created int64 `json:"created"`
+	Choices []struct {
+		Delta        chatMessage `json:"delta"`
+		FinishReason string      `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *openAIUsage `json:"usage"`
+}
+
+// openAIProvider talks to any OpenAI-compatible chat completions endpoint
+// (OpenAI, Azure OpenAI, vLLM, LiteLLM, ...).
+type openAIProvider struct {
+	baseURL       string
+	apiKey        string
+	httpClient    *http.Client
+	conversations *conversationStore
+}
+
+func NewOpenAIProvider(baseURL, apiKey string, httpClient *http.Client) Provider {
+	return &openAIProvider{
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		apiKey:        apiKey,
+		httpClient:    httpClient,
+		conversations: newConversationStore(),
+	}
+}
+
+func (p *openAIProvider) Name() string {
+	return ProviderOpenAI
+}
+
+func (p *openAIProvider) GenerateText(ctx context.Context, req *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+	conversationId, messages := p.conversations.history(req.ConversationId)
+	messages = append(messages, chatMessage{Role: "user", Content: req.GetContent()})
+
+	httpResp, err := p.post(ctx, &openAIChatRequest{
+		Model:       req.GetModel(),
+		Messages:    messages,
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		log.Printf("[OpenAI][GenerateText] Error reading response body: %v", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	chatResp := &openAIChatResponse{}
+	if err := json.Unmarshal(respBody, chatResp); err != nil {
+		log.Printf("[OpenAI][GenerateText] Error unmarshalling response body: %v", err)
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	if len(chatResp.Choices) == 0 {
+		return nil, fmt.Errorf("response has no choices")
+	}
+
+	answer := chatResp.Choices[0].Message
+	p.conversations.save(conversationId, append(messages, chatMessage{Role: "assistant", Content: answer.Content}))
+
+	return &arceus.GenerateTextResponse{
+		Content:        answer.Content,
+		ConversationId: conversationId,
+		CreatedAt:      timestamppb.New(unixOrNow(chatResp.Created)),
+		Usage: &arceus.Usage{
+			PromptTokens:     chatResp.Usage.PromptTokens,
+			CompletionTokens: chatResp.Usage.CompletionTokens,
+			TotalTokens:      chatResp.Usage.TotalTokens,
+		},
+		FinishReason: chatResp.Choices[0].FinishReason,
+	}, nil
+}
+
+// GenerateTextStream reads the server-sent events of a streamed chat completion.
+func (p *openAIProvider) GenerateTextStream(ctx context.Context, req *arceus.GenerateTextRequest, onChunk func(string) error) (*arceus.GenerateTextResponse, error) {
+	conversationId, messages := p.conversations.history(req.ConversationId)
+	messages = append(messages, chatMessage{Role: "user", Content: req.GetContent()})
+
+	httpResp, err := p.post(ctx, &openAIChatRequest{
+		Model:         req.GetModel(),
+		Messages:      messages,
+		Temperature:   req.Temperature,
+		MaxTokens:     req.MaxTokens,
+		Stream:        true,
+		StreamOptions: &openAIStreamOptions{IncludeUsage: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var content strings.Builder
+	var created int64
+	var finishReason string
+	usage := &arceus.Usage{}
+
+	scanner := bufio.NewScanner(httpResp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			break
+		}
+
+		chunk := &openAIChatChunk{}
+		if err := json.Unmarshal([]byte(data), chunk); err != nil {
+			log.Printf("[OpenAI][GenerateTextStream] Error unmarshalling chunk: %v", err)
+			return nil, fmt.Errorf("failed to unmarshal stream chunk: %w", err)
+		}
+		if chunk.Created > 0 {
+			created = chunk.Created
+		}
+		if chunk.Usage != nil {
+			usage = &arceus.Usage{
+				PromptTokens:     chunk.Usage.PromptTokens,
+				CompletionTokens: chunk.Usage.CompletionTokens,
+				TotalTokens:      chunk.Usage.TotalTokens,
+			}
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+		if chunk.Choices[0].FinishReason != "" {
+			finishReason = chunk.Choices[0].FinishReason
+		}
+		if chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta.Content
+		content.WriteString(delta)
+		if err := onChunk(delta); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("[OpenAI][GenerateTextStream] Error reading stream: %v", err)
+		return nil, err
+	}
+
+	p.conversations.save(conversationId, append(messages, chatMessage{Role: "assistant", Content: content.String()}))
+
+	return &arceus.GenerateTextResponse{
+		Content:        content.String(),
+		ConversationId: conversationId,
+		CreatedAt:      timestamppb.New(unixOrNow(created)),
+		Usage:          usage,
+		FinishReason:   finishReason,
+	}, nil
+}
+
+// post sends a chat completion request and returns the response once its status is OK.
+func (p *openAIProvider) post(ctx context.Context, body *openAIChatRequest) (*http.Response, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		log.Printf("[OpenAI] Error marshalling request body: %v", err)
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+URL_OpenAIChatCompletions, bytes.NewReader(jsonBody))
+	if err != nil {
+		log.Printf("[OpenAI] Error creating HTTP request: %v", err)
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	httpResp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		log.Printf("[OpenAI] Error making HTTP request: %v", err)
+		return nil, err
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		httpResp.Body.Close()
+		log.Printf("[OpenAI] HTTP request failed with status code: %d, body: %s", httpResp.StatusCode, respBody)
+		return nil, &StatusError{Provider: ProviderOpenAI, StatusCode: httpResp.StatusCode}
+	}
+
+	return httpResp, nil
+}
+
+func unixOrNow(seconds int64) time.Time {
+	if seconds > 0 {
+		return time.Unix(seconds, 0)
+	}
+	return time.Now()
+}
@@ -0,0 +1,96 @@
+package llm_grpc
+
+import (
+	"context"
+	arceus "darius/pkg/proto/deps/arceus"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ProviderArceus = "arceus"
+	ProviderOpenAI = "openai"
+	ProviderOllama = "ollama"
+)
+
+// Finish reasons reported in GenerateTextResponse.FinishReason. Backends that don't say
+// leave it empty.
+const (
+	FinishReasonStop   = "stop"
+	FinishReasonLength = "length"
+)
+
+// Provider is a concrete LLM backend. Every backend speaks the Arceus request and
+// response shape so the manager and handlers don't care which one is configured.
+type Provider interface {
+	Name() string
+	GenerateText(ctx context.Context, req *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error)
+	// GenerateTextStream calls onChunk with every piece of text as it arrives and returns the
+	// assembled response once the backend is done. An error from onChunk aborts the stream.
+	GenerateTextStream(ctx context.Context, req *arceus.GenerateTextRequest, onChunk func(string) error) (*arceus.GenerateTextResponse, error)
+}
+
+type arceusProvider struct {
+	client arceus.ArceusClient
+}
+
+func NewArceusProvider(client arceus.ArceusClient) Provider {
+	return &arceusProvider{
+		client: client,
+	}
+}
+
+func (p *arceusProvider) Name() string {
+	return ProviderArceus
+}
+
+func (p *arceusProvider) GenerateText(ctx context.Context, req *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+	return p.client.GenerateText(ctx, req)
+}
+
+func (p *arceusProvider) GenerateTextStream(ctx context.Context, req *arceus.GenerateTextRequest, onChunk func(string) error) (*arceus.GenerateTextResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := p.client.GenerateTextStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	resp := &arceus.GenerateTextResponse{}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if chunk.GetConversationId() != 0 {
+			resp.ConversationId = chunk.GetConversationId()
+		}
+		if chunk.GetUsage() != nil {
+			resp.Usage = chunk.GetUsage()
+		}
+		if chunk.GetFinishReason() != "" {
+			resp.FinishReason = chunk.GetFinishReason()
+		}
+		if chunk.GetContent() == "" {
+			continue
+		}
+
+		content.WriteString(chunk.GetContent())
+		if err := onChunk(chunk.GetContent()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp.Content = content.String()
+	resp.CreatedAt = timestamppb.New(time.Now())
+	return resp, nil
+}
@@ -0,0 +1,134 @@
+package llm_grpc
+
+import (
+	"context"
+	arceus "darius/pkg/proto/deps/arceus"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAIProvider_GenerateText(t *testing.T) {
+	var received []openAIChatRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, URL_OpenAIChatCompletions, r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var body openAIChatRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		received = append(received, body)
+
+		w.Write([]byte(`{"created": 1700000000, "choices": [{"message": {"role": "assistant", "content": "{\"ok\": true}"}, "finish_reason": "stop"}], "usage": {"prompt_tokens": 3, "completion_tokens": 4, "total_tokens": 7}}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(server.URL+"/", "secret", server.Client())
+
+	resp, err := provider.GenerateText(context.Background(), &arceus.GenerateTextRequest{Content: "hello", Model: "gpt-4o-mini"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"ok": true}`, resp.GetContent())
+	assert.Equal(t, int32(7), resp.GetUsage().GetTotalTokens())
+	assert.Equal(t, FinishReasonStop, resp.GetFinishReason())
+
+	conversationId := resp.GetConversationId()
+	_, err = provider.GenerateText(context.Background(), &arceus.GenerateTextRequest{Content: "again", Model: "gpt-4o-mini", ConversationId: &conversationId})
+	assert.NoError(t, err)
+
+	assert.Len(t, received, 2)
+	assert.Equal(t, "gpt-4o-mini", received[0].Model)
+	assert.Equal(t, []chatMessage{
+		{Role: "user", Content: "hello"},
+		{Role: "assistant", Content: `{"ok": true}`},
+		{Role: "user", Content: "again"},
+	}, received[1].Messages)
+}
+
+func TestOpenAIProvider_GenerateText_StatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(server.URL, "", server.Client())
+	_, err := provider.GenerateText(context.Background(), &arceus.GenerateTextRequest{Content: "hello"})
+	assert.Error(t, err)
+}
+
+func TestOllamaProvider_GenerateText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, URL_OllamaChat, r.URL.Path)
+
+		var body ollamaChatRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.False(t, body.Stream)
+		assert.Equal(t, "llama3.1", body.Model)
+
+		w.Write([]byte(`{"created_at": "2025-06-14T09:30:00Z", "message": {"role": "assistant", "content": "hi"}, "done": true, "done_reason": "stop", "prompt_eval_count": 5, "eval_count": 2}`))
+	}))
+	defer server.Close()
+
+	provider := NewOllamaProvider(server.URL, server.Client())
+	resp, err := provider.GenerateText(context.Background(), &arceus.GenerateTextRequest{Content: "hello", Model: "llama3.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "hi", resp.GetContent())
+	assert.Equal(t, int32(7), resp.GetUsage().GetTotalTokens())
+	assert.NotZero(t, resp.GetConversationId())
+	assert.Equal(t, FinishReasonStop, resp.GetFinishReason())
+}
+
+func TestOpenAIProvider_GenerateTextStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body openAIChatRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.True(t, body.Stream)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("data: {\"created\": 1700000000, \"choices\": [{\"delta\": {\"role\": \"assistant\", \"content\": \"[{\\\"a\\\"\"}}]}\n\n"))
+		w.Write([]byte("data: {\"choices\": [{\"delta\": {\"content\": \": 1}]\"}, \"finish_reason\": \"stop\"}]}\n\n"))
+		w.Write([]byte("data: {\"choices\": [], \"usage\": {\"prompt_tokens\": 3, \"completion_tokens\": 4, \"total_tokens\": 7}}\n\n"))
+		w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(server.URL, "", server.Client())
+
+	var chunks []string
+	resp, err := provider.GenerateTextStream(context.Background(), &arceus.GenerateTextRequest{Content: "hello"}, func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`[{"a"`, `: 1}]`}, chunks)
+	assert.Equal(t, `[{"a": 1}]`, resp.GetContent())
+	assert.Equal(t, int32(7), resp.GetUsage().GetTotalTokens())
+	assert.Equal(t, FinishReasonStop, resp.GetFinishReason())
+}
+
+func TestOllamaProvider_GenerateTextStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body ollamaChatRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.True(t, body.Stream)
+
+		w.Write([]byte(`{"message": {"role": "assistant", "content": "h"}, "done": false}` + "\n"))
+		w.Write([]byte(`{"message": {"role": "assistant", "content": "i"}, "done": false}` + "\n"))
+		w.Write([]byte(`{"message": {"role": "assistant", "content": ""}, "done": true, "done_reason": "length", "prompt_eval_count": 5, "eval_count": 2}` + "\n"))
+	}))
+	defer server.Close()
+
+	provider := NewOllamaProvider(server.URL, server.Client())
+
+	var chunks []string
+	resp, err := provider.GenerateTextStream(context.Background(), &arceus.GenerateTextRequest{Content: "hello"}, func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"h", "i"}, chunks)
+	assert.Equal(t, "hi", resp.GetContent())
+	assert.Equal(t, int32(7), resp.GetUsage().GetTotalTokens())
+	assert.Equal(t, FinishReasonLength, resp.GetFinishReason())
+}
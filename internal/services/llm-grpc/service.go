@@ -3,38 +3,94 @@ package llm_grpc
 import (
 	"context"
 	arceus "darius/pkg/proto/deps/arceus"
+	"fmt"
 	"log"
 )
 
 type Service interface {
-	Generate(context.Context, string, *uint64) (*arceus.GenerateTextResponse, error)
+	Generate(context.Context, string, *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error)
+	GenerateStream(context.Context, string, *arceus.GenerateTextRequest, func(string) error) (*arceus.GenerateTextResponse, error)
+	DefaultProvider() string
 }
 
-func NewService(client arceus.ArceusClient, llm_model string) Service {
+func NewService(providers []Provider, llm_model string) Service {
+	providerMap := make(map[string]Provider, len(providers))
+	for _, provider := range providers {
+		providerMap[provider.Name()] = provider
+	}
+
+	defaultProvider := ""
+	if len(providers) > 0 {
+		defaultProvider = providers[0].Name()
+	}
+
 	return &service{
-		client:    client,
-		llm_model: llm_model,
+		providers:       providerMap,
+		defaultProvider: defaultProvider,
+		llm_model:       llm_model,
 	}
 }
 
 type service struct {
-	client    arceus.ArceusClient
-	llm_model string
+	providers       map[string]Provider
+	defaultProvider string
+	llm_model       string
 }
 
-func (s *service) Generate(ctx context.Context, text string, conversationId *uint64) (resp *arceus.GenerateTextResponse, err error) {
-	res, err := s.client.GenerateText(ctx, &arceus.GenerateTextRequest{
-		Content:        text,
-		Model:          s.llm_model,
-		ConversationId: conversationId,
-	})
+func (s *service) DefaultProvider() string {
+	return s.defaultProvider
+}
+
+// Generate sends the request to the named provider, or to the default one when the name is empty.
+func (s *service) Generate(ctx context.Context, providerName string, req *arceus.GenerateTextRequest) (resp *arceus.GenerateTextResponse, err error) {
+	provider, err := s.provider(providerName, req)
+	if err != nil {
+		return &arceus.GenerateTextResponse{}, err
+	}
+
+	res, err := provider.GenerateText(ctx, req)
 
 	if err != nil {
-		log.Printf("Error calling Arceus service: %v", err)
+		log.Printf("Error calling %s provider: %v", provider.Name(), err)
 		return &arceus.GenerateTextResponse{}, err
 	}
 
-	log.Printf("[Generate] LLM request: %s, LLM response %s", text, res)
+	log.Printf("[Generate] LLM request: %s, LLM response %s", req.GetContent(), res)
 
 	return res, err
 }
+
+// GenerateStream is Generate for backends that can hand the answer back piece by piece.
+func (s *service) GenerateStream(ctx context.Context, providerName string, req *arceus.GenerateTextRequest, onChunk func(string) error) (*arceus.GenerateTextResponse, error) {
+	provider, err := s.provider(providerName, req)
+	if err != nil {
+		return &arceus.GenerateTextResponse{}, err
+	}
+
+	res, err := provider.GenerateTextStream(ctx, req, onChunk)
+	if err != nil {
+		log.Printf("Error streaming from %s provider: %v", provider.Name(), err)
+		return &arceus.GenerateTextResponse{}, err
+	}
+
+	log.Printf("[GenerateStream] LLM request: %s, LLM response %s", req.GetContent(), res)
+
+	return res, nil
+}
+
+// provider resolves the named provider and fills in the default model.
+func (s *service) provider(providerName string, req *arceus.GenerateTextRequest) (Provider, error) {
+	if providerName == "" {
+		providerName = s.defaultProvider
+	}
+	provider, ok := s.providers[providerName]
+	if !ok {
+		log.Printf("[Generate] LLM provider %q is not configured", providerName)
+		return nil, fmt.Errorf("LLM provider %q is not configured", providerName)
+	}
+
+	if req.GetModel() == "" {
+		req.Model = s.llm_model
+	}
+	return provider, nil
+}
@@ -1,6 +0,0 @@
-package llm
-
-type Config struct {
-	Host  string
-	Model string
-}
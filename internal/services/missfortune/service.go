@@ -9,7 +9,6 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"time"
 )
 
 const (
@@ -50,17 +49,14 @@ func (s *service) GetExamQuestionContent(ctx context.Context, req *missfortune.S
 	bodyReader := bytes.NewReader(jsonBody)
 
 	requestURL := s.address + URL_GetExamQuestionContent
-	httpReq, err := http.NewRequest(http.MethodPost, requestURL, bodyReader)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bodyReader)
 	if err != nil {
 		log.Printf("[MFT][GetExamQuestionContent] Error creating HTTP request: %v, \n MFT body: %v", err, httpReq)
 		return nil, err
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
 
-	httpResp, err := client.Do(httpReq)
+	httpResp, err := s.httpClient.Do(httpReq)
 	if err != nil {
 		log.Printf("[MFT][GetExamQuestionContent] Error making HTTP request: %v,\n MFT body: %v", err, httpReq)
 		return nil, err
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"darius/cmd/db"
+	"darius/internal/errors"
+	"darius/internal/retrieval"
+	"darius/models"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// maxCachedIndexes bounds how many document sets keep their search index in memory.
+const maxCachedIndexes = 32
+
+type DocumentService interface {
+	SaveDocument(context.Context, *models.Document) error
+	GetDocuments(context.Context, []uint) ([]models.Document, error)
+	SearchChunks(context.Context, []uint, string, int) ([]models.DocumentChunk, error)
+	GetChunks(context.Context, []uint) ([]models.DocumentChunk, error)
+}
+
+type documentService struct {
+	db db.Database
+	// indexes keeps the chunks of recently searched document sets indexed, so the topics
+	// and chunks of an exam don't load and index the same documents again. Documents don't
+	// change once uploaded, so an index never goes stale.
+	mu      sync.Mutex
+	indexes map[string]*chunkIndex
+}
+
+// chunkIndex is the search index over the chunks of a set of documents.
+type chunkIndex struct {
+	chunks []models.DocumentChunk
+	index  *retrieval.Index
+}
+
+func NewDocumentService(db db.Database) DocumentService {
+	return &documentService{
+		db:      db,
+		indexes: make(map[string]*chunkIndex),
+	}
+}
+
+func (s *documentService) SaveDocument(ctx context.Context, document *models.Document) error {
+	if s.db == nil {
+		return errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.CreateDocument(document)
+}
+
+// GetDocuments returns the documents without their chunks, failing with ErrNotFound when
+// any of them doesn't exist.
+func (s *documentService) GetDocuments(ctx context.Context, ids []uint) ([]models.Document, error) {
+	if s.db == nil {
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	documents, err := s.db.GetDocuments(ids)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[uint]bool, len(documents))
+	for _, document := range documents {
+		found[document.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return nil, errors.Error(errors.ErrNotFound)
+		}
+	}
+	return documents, nil
+}
+
+// SearchChunks ranks the chunks of the documents against the query and returns at most
+// limit of them, best first. When no chunk matches, the first chunks of the documents are
+// returned instead, so a topic named differently from the material is still grounded in
+// it. The documents are indexed once and the index is reused by later searches of the
+// same documents.
+func (s *documentService) SearchChunks(ctx context.Context, documentIds []uint, query string, limit int) ([]models.DocumentChunk, error) {
+	if s.db == nil {
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	index, err := s.chunkIndex(documentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := index.chunks
+	matches := index.index.Search(query, limit)
+	if len(matches) == 0 {
+		if limit > 0 && len(chunks) > limit {
+			chunks = chunks[:limit]
+		}
+		return append([]models.DocumentChunk(nil), chunks...), nil
+	}
+	found := make([]models.DocumentChunk, len(matches))
+	for i, match := range matches {
+		found[i] = chunks[match.Index]
+	}
+	return found, nil
+}
+
+// chunkIndex returns the index over the chunks of the documents, loading and indexing them
+// the first time the set is searched.
+func (s *documentService) chunkIndex(documentIds []uint) (*chunkIndex, error) {
+	ids := append([]uint(nil), documentIds...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	key := fmt.Sprint(ids)
+
+	s.mu.Lock()
+	index, ok := s.indexes[key]
+	s.mu.Unlock()
+	if ok {
+		return index, nil
+	}
+
+	chunks, err := s.db.ListDocumentChunks(ids)
+	if err != nil {
+		return nil, err
+	}
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = chunk.Section + "\n" + chunk.Text
+	}
+	index = &chunkIndex{chunks: chunks, index: retrieval.NewIndex(texts)}
+
+	s.mu.Lock()
+	if len(s.indexes) >= maxCachedIndexes {
+		s.indexes = make(map[string]*chunkIndex)
+	}
+	s.indexes[key] = index
+	s.mu.Unlock()
+	return index, nil
+}
+
+func (s *documentService) GetChunks(ctx context.Context, ids []uint) ([]models.DocumentChunk, error) {
+	if s.db == nil {
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.db.GetDocumentChunks(ids)
+}
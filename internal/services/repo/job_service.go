@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"darius/cmd/db"
+	"darius/internal/errors"
+	"darius/models"
+	stdErrors "errors"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type JobService interface {
+	CreateJob(context.Context, *models.Job) error
+	GetJob(context.Context, string) (*models.Job, error)
+	ListJobs(context.Context, string, time.Time) ([]models.Job, error)
+	ClaimJob(context.Context, *models.Job) (bool, error)
+	TouchJob(context.Context, *models.Job) error
+	ListPendingCallbacks(context.Context, time.Time) ([]models.Job, error)
+	ClaimCallback(context.Context, *models.Job) (bool, error)
+	UpdateJob(context.Context, *models.Job) error
+}
+
+type jobService struct {
+	db db.Database
+}
+
+func NewJobService(db db.Database) JobService {
+	return &jobService{
+		db: db,
+	}
+}
+
+// Unlike call reports, jobs can't be dropped silently: without a database there is
+// nothing to resume or poll, so every method fails instead.
+func (s *jobService) CreateJob(ctx context.Context, job *models.Job) error {
+	if s.db == nil {
+		log.Print("Database service is not initialized")
+		return errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.CreateJob(job)
+}
+
+func (s *jobService) GetJob(ctx context.Context, requestKey string) (*models.Job, error) {
+	if s.db == nil {
+		log.Print("Database service is not initialized")
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	job, err := s.db.GetJobByRequestKey(requestKey)
+	if stdErrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Error(errors.ErrNotFound)
+	}
+	return job, err
+}
+
+func (s *jobService) ListJobs(ctx context.Context, status string, updatedBefore time.Time) ([]models.Job, error) {
+	if s.db == nil {
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.ListJobs(status, updatedBefore)
+}
+
+func (s *jobService) ClaimJob(ctx context.Context, job *models.Job) (bool, error) {
+	if s.db == nil {
+		return false, errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.ClaimJob(job)
+}
+
+func (s *jobService) TouchJob(ctx context.Context, job *models.Job) error {
+	if s.db == nil {
+		return errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.TouchJob(job)
+}
+
+func (s *jobService) ListPendingCallbacks(ctx context.Context, updatedBefore time.Time) ([]models.Job, error) {
+	if s.db == nil {
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.ListPendingCallbacks(updatedBefore)
+}
+
+func (s *jobService) ClaimCallback(ctx context.Context, job *models.Job) (bool, error) {
+	if s.db == nil {
+		return false, errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.ClaimCallback(job)
+}
+
+func (s *jobService) UpdateJob(ctx context.Context, job *models.Job) error {
+	if s.db == nil {
+		return errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.UpdateJob(job)
+}
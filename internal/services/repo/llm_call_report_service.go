@@ -7,7 +7,7 @@ import (
 )
 
 type Service interface {
-	CreateLLMCallReport(context.Context, string, string, string, string, float64) error
+	CreateLLMCallReport(context.Context, string, string, string, string, string, string, float64) error
 	GetByRequestKey(context.Context, string) (string, error)
 }
 
@@ -21,7 +21,7 @@ func NewService(db db.Database) Service {
 	}
 }
 
-func (s *service) CreateLLMCallReport(ctx context.Context, entry, res, resp, requestKey string, amount float64) error {
+func (s *service) CreateLLMCallReport(ctx context.Context, entry, res, resp, requestKey, provider, model string, amount float64) error {
 	if s.db == nil {
 		log.Print("Database service is not initialized")
 		return nil
@@ -31,6 +31,8 @@ func (s *service) CreateLLMCallReport(ctx context.Context, entry, res, resp, req
 		res,
 		resp,
 		requestKey,
+		provider,
+		model,
 		amount,
 	)
 }
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"darius/cmd/db"
+	"darius/internal/errors"
+	"darius/models"
+	stdErrors "errors"
+
+	"gorm.io/gorm"
+)
+
+type QuestionService interface {
+	SaveQuestions(context.Context, []models.Question) error
+	SearchQuestions(context.Context, models.QuestionFilter) ([]models.Question, int64, error)
+	GetQuestion(context.Context, uint) (*models.Question, error)
+	UpdateQuestionStatus(context.Context, uint, string) (*models.Question, error)
+	RecordExposures(context.Context, string, []uint) error
+}
+
+type questionService struct {
+	db db.Database
+}
+
+func NewQuestionService(db db.Database) QuestionService {
+	return &questionService{
+		db: db,
+	}
+}
+
+// Like jobs, the question bank is useless without a database, so every method fails
+// instead of dropping the call.
+func (s *questionService) SaveQuestions(ctx context.Context, questions []models.Question) error {
+	if s.db == nil {
+		return errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.CreateQuestions(questions)
+}
+
+func (s *questionService) SearchQuestions(ctx context.Context, filter models.QuestionFilter) ([]models.Question, int64, error) {
+	if s.db == nil {
+		return nil, 0, errors.Error(errors.ErrDatabaseConnection)
+	}
+	return s.db.SearchQuestions(filter)
+}
+
+// GetQuestion returns the question with its tags, failing with ErrNotFound when it doesn't
+// exist.
+func (s *questionService) GetQuestion(ctx context.Context, id uint) (*models.Question, error) {
+	if s.db == nil {
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	question, err := s.db.GetQuestion(id)
+	if stdErrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Error(errors.ErrNotFound)
+	}
+	return question, err
+}
+
+// UpdateQuestionStatus sets the review status of a question and returns the updated
+// question.
+func (s *questionService) UpdateQuestionStatus(ctx context.Context, id uint, status string) (*models.Question, error) {
+	if s.db == nil {
+		return nil, errors.Error(errors.ErrDatabaseConnection)
+	}
+	err := s.db.UpdateQuestionStatus(id, status)
+	if err == nil {
+		var question *models.Question
+		question, err = s.db.GetQuestion(id)
+		if err == nil {
+			return question, nil
+		}
+	}
+	if stdErrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Error(errors.ErrNotFound)
+	}
+	return nil, err
+}
+
+// RecordExposures notes that the candidate pool was shown the questions.
+func (s *questionService) RecordExposures(ctx context.Context, candidatePool string, ids []uint) error {
+	if s.db == nil {
+		return errors.Error(errors.ErrDatabaseConnection)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	exposures := make([]models.QuestionExposure, len(ids))
+	for i, id := range ids {
+		exposures[i] = models.QuestionExposure{QuestionID: id, CandidatePool: candidatePool}
+	}
+	return s.db.CreateExposures(exposures)
+}
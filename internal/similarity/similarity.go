@@ -0,0 +1,183 @@
+// Package similarity finds near-duplicate texts, such as generated questions and outlines,
+// without calling out to any service. Texts are compared by the cosine of their TF-IDF
+// weighted word vectors, so words shared by every text count for little and the rare words
+// that make a question what it is count for a lot.
+package similarity
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Duplicate is a candidate that is too close to a text before it. Of indexes the
+// references when Reference is set, otherwise the candidates.
+type Duplicate struct {
+	Index     int
+	Of        int
+	Reference bool
+	Score     float64
+}
+
+// Duplicates compares every candidate with the references and with the candidates before
+// it that were kept, and reports those scoring at or above threshold. A threshold of 0 or
+// less finds nothing.
+func Duplicates(candidates, references []string, threshold float64) []Duplicate {
+	if threshold <= 0 || len(candidates) == 0 {
+		return nil
+	}
+
+	vectors := vectorize(append(append([]string(nil), references...), candidates...))
+	refVectors, candVectors := vectors[:len(references)], vectors[len(references):]
+
+	var duplicates []Duplicate
+	var kept []int
+	for i, vector := range candVectors {
+		duplicate, found := Duplicate{Index: i}, false
+		for j, ref := range refVectors {
+			if score := cosine(vector, ref); score >= threshold && score > duplicate.Score {
+				duplicate, found = Duplicate{Index: i, Of: j, Reference: true, Score: score}, true
+			}
+		}
+		for _, j := range kept {
+			if score := cosine(vector, candVectors[j]); score >= threshold && score > duplicate.Score {
+				duplicate, found = Duplicate{Index: i, Of: j, Score: score}, true
+			}
+		}
+		if found {
+			duplicates = append(duplicates, duplicate)
+			continue
+		}
+		kept = append(kept, i)
+	}
+	return duplicates
+}
+
+// Filter returns the candidates that are not near-duplicates of the references or of an
+// earlier candidate, in order.
+func Filter(candidates, references []string, threshold float64) []string {
+	dropped := make(map[int]bool)
+	for _, duplicate := range Duplicates(candidates, references, threshold) {
+		dropped[duplicate.Index] = true
+	}
+	var kept []string
+	for i, candidate := range candidates {
+		if !dropped[i] {
+			kept = append(kept, candidate)
+		}
+	}
+	return kept
+}
+
+// Similarity is the TF-IDF cosine similarity of two texts, weighted as if they were the
+// only texts there are.
+func Similarity(a, b string) float64 {
+	vectors := vectorize([]string{a, b})
+	return cosine(vectors[0], vectors[1])
+}
+
+type vector map[string]float64
+
+// vectorize turns every text into a unit-length TF-IDF vector, with document frequencies
+// taken over the texts themselves.
+func vectorize(texts []string) []vector {
+	counts := make([]map[string]int, len(texts))
+	frequency := make(map[string]int)
+	for i, text := range texts {
+		counts[i] = make(map[string]int)
+		for _, term := range terms(text) {
+			if counts[i][term] == 0 {
+				frequency[term]++
+			}
+			counts[i][term]++
+		}
+	}
+
+	vectors := make([]vector, len(texts))
+	for i := range texts {
+		v := make(vector, len(counts[i]))
+		var norm float64
+		for term, count := range counts[i] {
+			// Sublinear term frequency and smoothed inverse document frequency.
+			idf := math.Log(float64(1+len(texts))/float64(1+frequency[term])) + 1
+			weight := (1 + math.Log(float64(count))) * idf
+			v[term] = weight
+			norm += weight * weight
+		}
+		norm = math.Sqrt(norm)
+		for term := range v {
+			v[term] /= norm
+		}
+		vectors[i] = v
+	}
+	return vectors
+}
+
+func cosine(a, b vector) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var dot float64
+	for term, weight := range a {
+		dot += weight * b[term]
+	}
+	return dot
+}
+
+// Terms are the words of a text as texts are compared by: lowercased, without punctuation,
+// stop words and plural endings.
+func Terms(text string) []string {
+	return terms(text)
+}
+
+// terms are the words of a text without stop words and plural endings. A text of only
+// stop words keeps them.
+func terms(text string) []string {
+	words := words(text)
+	var terms []string
+	for _, word := range words {
+		if !stopWords[word] {
+			terms = append(terms, singular(word))
+		}
+	}
+	if len(terms) == 0 {
+		return words
+	}
+	return terms
+}
+
+// singular strips the English plural ending of a word, good enough to match "slices" with
+// "slice", "queries" with "query" and "mutexes" with "mutex".
+func singular(word string) string {
+	switch {
+	case len(word) <= 3 || !strings.HasSuffix(word, "s") || strings.HasSuffix(word, "ss"):
+		return word
+	case strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "xes") || strings.HasSuffix(word, "ches") || strings.HasSuffix(word, "shes") || strings.HasSuffix(word, "sses"):
+		return strings.TrimSuffix(word, "es")
+	default:
+		return strings.TrimSuffix(word, "s")
+	}
+}
+
+// words lowercases the text and splits it into words, ignoring punctuation and markdown.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+var stopWords = wordSet(`
+	a an and are as at be been but by can could did do does for from had has have how i if
+	in into is it its may might of on or should so than that the their them then there
+	these they this those to was we were what when where which while who whom why will
+	with would you your`)
+
+func wordSet(list string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(list) {
+		set[word] = true
+	}
+	return set
+}
@@ -0,0 +1,48 @@
+package similarity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarity(t *testing.T) {
+	assert.InDelta(t, 1, Similarity("What does the defer keyword do in Go?", "What does the `defer` keyword do in Go??"), 1e-9)
+	assert.InDelta(t, 0, Similarity("Explain goroutines", "Describe database indexes"), 1e-9)
+	assert.Equal(t, 0.0, Similarity("", "What is a goroutine?"))
+
+	// Plurals and word order don't hide a repeat.
+	assert.InDelta(t, 1, Similarity("Compare mutexes with channels", "Compare a channel with a mutex"), 1e-9)
+	assert.Less(t, Similarity("What is the time complexity of inserting into a binary heap?", "What is the time complexity of deleting from a binary heap?"), 0.8)
+}
+
+func TestDuplicates(t *testing.T) {
+	candidates := []string{
+		"How do you close a channel in Go?",
+		"How do you create a buffered channel in Go?",
+		"In Go, how do you close a channel?",
+		"What is the difference between a slice and an array?",
+		"Explain the difference between an array and a slice in Go.",
+	}
+	references := []string{"What is the difference between slices and arrays in Go?", "Explain the difference between a Go array and a slice"}
+
+	got := Duplicates(candidates, references, 0.8)
+	for i := range got {
+		assert.GreaterOrEqual(t, got[i].Score, 0.8)
+		got[i].Score = 0
+	}
+	assert.Equal(t, []Duplicate{
+		{Index: 2, Of: 0},
+		{Index: 3, Of: 0, Reference: true},
+		{Index: 4, Of: 1, Reference: true},
+	}, got)
+
+	assert.Empty(t, Duplicates(candidates, references, 0))
+}
+
+func TestFilter(t *testing.T) {
+	existing := []string{"Goroutines and channels", "Error handling"}
+	suggested := []string{"Channels and goroutines", "Error handling patterns", "Testing with table-driven tests", "Table-driven tests for testing"}
+
+	assert.Equal(t, []string{"Error handling patterns", "Testing with table-driven tests"}, Filter(suggested, existing, 0.8))
+}
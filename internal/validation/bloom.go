@@ -0,0 +1,142 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"fmt"
+)
+
+const (
+	BloomRemember   = "REMEMBER"
+	BloomUnderstand = "UNDERSTAND"
+	BloomApply      = "APPLY"
+	BloomAnalyze    = "ANALYZE"
+	BloomEvaluate   = "EVALUATE"
+	BloomCreate     = "CREATE"
+)
+
+// BloomLevels are the cognitive levels of Bloom's taxonomy, from the lowest to the highest.
+var BloomLevels = []string{
+	BloomRemember,
+	BloomUnderstand,
+	BloomApply,
+	BloomAnalyze,
+	BloomEvaluate,
+	BloomCreate,
+}
+
+// bloomFields read and write the count of each of BloomLevels in a BloomDistribution.
+var bloomFields = []struct {
+	get func(*suggest.BloomDistribution) int32
+	set func(*suggest.BloomDistribution, int32)
+}{
+	{(*suggest.BloomDistribution).GetRemember, func(d *suggest.BloomDistribution, n int32) { d.Remember = n }},
+	{(*suggest.BloomDistribution).GetUnderstand, func(d *suggest.BloomDistribution, n int32) { d.Understand = n }},
+	{(*suggest.BloomDistribution).GetApply, func(d *suggest.BloomDistribution, n int32) { d.Apply = n }},
+	{(*suggest.BloomDistribution).GetAnalyze, func(d *suggest.BloomDistribution, n int32) { d.Analyze = n }},
+	{(*suggest.BloomDistribution).GetEvaluate, func(d *suggest.BloomDistribution, n int32) { d.Evaluate = n }},
+	{(*suggest.BloomDistribution).GetCreate, func(d *suggest.BloomDistribution, n int32) { d.Create = n }},
+}
+
+// IsBloomLevel reports whether level is one of BloomLevels.
+func IsBloomLevel(level string) bool {
+	for _, bloomLevel := range BloomLevels {
+		if bloomLevel == level {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckBloomDistribution rejects a Bloom distribution with a negative count, or one that
+// doesn't add up to the questionCount of the exam. An empty distribution asks for nothing.
+func CheckBloomDistribution(distribution *suggest.BloomDistribution, questionCount int) error {
+	total := 0
+	for i, field := range bloomFields {
+		n := field.get(distribution)
+		if n < 0 {
+			return fmt.Errorf("negative count for %s in Bloom distribution", BloomLevels[i])
+		}
+		total += int(n)
+	}
+	if total > 0 && total != questionCount {
+		return fmt.Errorf("Bloom distribution asks for %d questions, the topics for %d", total, questionCount)
+	}
+	return nil
+}
+
+// BloomCounts reads a Bloom distribution into questions per level, leaving out the levels
+// without questions. It is nil for an empty distribution.
+func BloomCounts(distribution *suggest.BloomDistribution) map[string]int {
+	var counts map[string]int
+	for i, field := range bloomFields {
+		if n := field.get(distribution); n > 0 {
+			if counts == nil {
+				counts = make(map[string]int)
+			}
+			counts[BloomLevels[i]] = int(n)
+		}
+	}
+	return counts
+}
+
+// NewBloomDistribution is the Bloom distribution of questions per level.
+func NewBloomDistribution(counts map[string]int) *suggest.BloomDistribution {
+	distribution := &suggest.BloomDistribution{}
+	for i, field := range bloomFields {
+		field.set(distribution, int32(counts[BloomLevels[i]]))
+	}
+	return distribution
+}
+
+// ScaleBloomCounts splits total questions over the levels in proportion to counts, the
+// way TypeCounts splits them over types.
+func ScaleBloomCounts(counts map[string]int, total int) map[string]int {
+	return apportion(counts, total, orderedLevels(counts))
+}
+
+// QuestionBloomLevel is the Bloom level of a question: the one the classification pass
+// found when there was one, the level it was tagged with otherwise.
+func QuestionBloomLevel(question *suggest.SuggestExamQuestionResponseV2_Quetion) string {
+	if level := question.GetTagCheck().GetBloomLevel(); IsBloomLevel(level) {
+		return level
+	}
+	return question.GetBloomLevel()
+}
+
+// NewBloomReport compares the Bloom levels of the questions with the target counts. The
+// match is the share of the target met: per level, the questions up to its target count,
+// over all targeted questions. It is nil without a target.
+func NewBloomReport(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, target map[string]int) *suggest.SuggestExamQuestionResponseV2_BloomReport {
+	wanted := 0
+	for _, n := range target {
+		wanted += n
+	}
+	if wanted == 0 {
+		return nil
+	}
+
+	actual := make(map[string]int)
+	for _, question := range questions {
+		actual[QuestionBloomLevel(question)]++
+	}
+	met := 0
+	for level, n := range target {
+		met += min(actual[level], n)
+	}
+	return &suggest.SuggestExamQuestionResponseV2_BloomReport{
+		Target: NewBloomDistribution(target),
+		Actual: NewBloomDistribution(actual),
+		Match:  float32(met) / float32(wanted),
+	}
+}
+
+// orderedLevels lists the levels of a map in BloomLevels order.
+func orderedLevels[V any](levels map[string]V) []string {
+	var ordered []string
+	for _, level := range BloomLevels {
+		if _, ok := levels[level]; ok {
+			ordered = append(ordered, level)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckBloomDistribution(t *testing.T) {
+	assert.NoError(t, CheckBloomDistribution(nil, 5))
+	assert.NoError(t, CheckBloomDistribution(&suggest.BloomDistribution{Remember: 2, Analyze: 3}, 5))
+	assert.EqualError(t, CheckBloomDistribution(&suggest.BloomDistribution{Apply: 4}, 5), "Bloom distribution asks for 4 questions, the topics for 5")
+	assert.Error(t, CheckBloomDistribution(&suggest.BloomDistribution{Apply: 6, Create: -1}, 5))
+}
+
+func TestBloomCounts(t *testing.T) {
+	counts := BloomCounts(&suggest.BloomDistribution{Understand: 2, Evaluate: 1})
+	assert.Equal(t, map[string]int{BloomUnderstand: 2, BloomEvaluate: 1}, counts)
+	assert.True(t, proto.Equal(&suggest.BloomDistribution{Understand: 2, Evaluate: 1}, NewBloomDistribution(counts)))
+	assert.Nil(t, BloomCounts(&suggest.BloomDistribution{}))
+
+	assert.Equal(t, map[string]int{BloomUnderstand: 2, BloomEvaluate: 1}, ScaleBloomCounts(counts, 3))
+	assert.Equal(t, map[string]int{BloomUnderstand: 1, BloomEvaluate: 1}, ScaleBloomCounts(counts, 2))
+	assert.Equal(t, map[string]int{BloomUnderstand: 1, BloomEvaluate: 0}, ScaleBloomCounts(counts, 1))
+}
+
+func TestNewBloomReport(t *testing.T) {
+	tagged := func(level string, check string) *suggest.SuggestExamQuestionResponseV2_Quetion {
+		question := &suggest.SuggestExamQuestionResponseV2_Quetion{BloomLevel: level}
+		if check != "" {
+			question.TagCheck = &suggest.SuggestExamQuestionResponseV2_TagCheck{BloomLevel: check}
+		}
+		return question
+	}
+	questions := []*suggest.SuggestExamQuestionResponseV2_Quetion{
+		tagged(BloomRemember, ""),
+		tagged(BloomRemember, BloomApply),
+		tagged(BloomApply, ""),
+		tagged(BloomAnalyze, ""),
+	}
+	assert.Equal(t, BloomApply, QuestionBloomLevel(questions[1]))
+
+	report := NewBloomReport(questions, map[string]int{BloomRemember: 2, BloomApply: 1, BloomCreate: 1})
+	assert.True(t, proto.Equal(&suggest.BloomDistribution{Remember: 2, Apply: 1, Create: 1}, report.GetTarget()))
+	assert.True(t, proto.Equal(&suggest.BloomDistribution{Remember: 1, Apply: 2, Analyze: 1}, report.GetActual()))
+	// One REMEMBER and one APPLY question meet the target, out of four.
+	assert.InDelta(t, 0.5, report.GetMatch(), 0.001)
+
+	assert.Nil(t, NewBloomReport(questions, nil))
+}
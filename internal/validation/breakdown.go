@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"strings"
+)
+
+// DifficultyLevels are the levels of a DifficultyDistribution, in order.
+var DifficultyLevels = []string{"Intern", "Junior", "Middle", "Senior", "Lead", "Expert"}
+
+// ExamCell is one row of the topic × difficulty breakdown of an exam request.
+type ExamCell struct {
+	Topic string
+	Level string
+	Count int
+}
+
+func (c ExamCell) String() string {
+	return fmt.Sprintf("%s at the %s level", c.Topic, c.Level)
+}
+
+// levelCounts returns the questions of each of the DifficultyLevels in the distribution.
+func levelCounts(distribution *suggest.DifficultyDistribution) []int32 {
+	return []int32{
+		distribution.GetIntern(), distribution.GetJunior(), distribution.GetMiddle(),
+		distribution.GetSenior(), distribution.GetLead(), distribution.GetExpert(),
+	}
+}
+
+// ExamCells lists the topic and level rows of a request that ask for questions, in request
+// order.
+func ExamCells(topics []*suggest.Topic) []ExamCell {
+	var cells []ExamCell
+	for _, topic := range topics {
+		for i, count := range levelCounts(topic.GetDifficultyDistribution()) {
+			if count > 0 {
+				cells = append(cells, ExamCell{Topic: topic.GetName(), Level: DifficultyLevels[i], Count: int(count)})
+			}
+		}
+	}
+	return cells
+}
+
+// cellOf returns the index of the cell the question says it belongs to, or -1.
+func cellOf(question *suggest.SuggestExamQuestionResponseV2_Quetion, cells []ExamCell) int {
+	for i, cell := range cells {
+		if strings.EqualFold(strings.TrimSpace(question.GetTopic()), cell.Topic) && strings.EqualFold(strings.TrimSpace(question.GetLevel()), cell.Level) {
+			return i
+		}
+	}
+	return -1
+}
+
+// MissingCells counts the questions each cell of the breakdown still lacks.
+func MissingCells(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec) []ExamCell {
+	have := make([]int, len(spec.Cells))
+	for _, question := range questions {
+		if i := cellOf(question, spec.Cells); i >= 0 {
+			have[i]++
+		}
+	}
+	var missing []ExamCell
+	for i, cell := range spec.Cells {
+		if have[i] < cell.Count {
+			cell.Count -= have[i]
+			missing = append(missing, cell)
+		}
+	}
+	return missing
+}
+
+// validateBreakdown checks the questions against the topic × difficulty breakdown. A
+// question outside of the breakdown, or beyond the count of its cell, is asked to be
+// rewritten for a cell that is short, and cells still short are reported on the exam.
+func validateBreakdown(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec) []Violation {
+	if len(spec.Cells) == 0 {
+		return nil
+	}
+
+	have := make([]int, len(spec.Cells))
+	var surplus []*suggest.SuggestExamQuestionResponseV2_Quetion
+	for _, question := range questions {
+		i := cellOf(question, spec.Cells)
+		if i < 0 || have[i] >= spec.Cells[i].Count {
+			surplus = append(surplus, question)
+			continue
+		}
+		have[i]++
+	}
+
+	var short []ExamCell
+	for i, cell := range spec.Cells {
+		for n := have[i]; n < cell.Count; n++ {
+			short = append(short, cell)
+		}
+	}
+
+	var violations []Violation
+	for _, question := range surplus {
+		message := fmt.Sprintf("the exam already has the %d questions asked for on %s", countOf(spec.Cells, question), ExamCell{Topic: question.GetTopic(), Level: question.GetLevel()})
+		if cellOf(question, spec.Cells) < 0 {
+			message = fmt.Sprintf("the question is on %s, which is not part of the requested breakdown", ExamCell{Topic: question.GetTopic(), Level: question.GetLevel()})
+		}
+		if len(short) > 0 {
+			message += fmt.Sprintf(`; rewrite it as a question on %s with "topic" %q and "level" %q`, short[0], short[0].Topic, short[0].Level)
+			short = short[1:]
+		}
+		violations = append(violations, Violation{
+			QuestionId: question.GetId(),
+			Field:      "topic",
+			Code:       CodeBreakdown,
+			Message:    message,
+		})
+	}
+
+	for i, cell := range spec.Cells {
+		if have[i] < cell.Count {
+			violations = append(violations, Violation{
+				Field:   "questions",
+				Code:    CodeBreakdown,
+				Message: fmt.Sprintf("expected %d questions on %s, got %d", cell.Count, cell, have[i]),
+			})
+		}
+	}
+	return violations
+}
+
+func countOf(cells []ExamCell, question *suggest.SuggestExamQuestionResponseV2_Quetion) int {
+	if i := cellOf(question, cells); i >= 0 {
+		return cells[i].Count
+	}
+	return 0
+}
@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExam_Breakdown(t *testing.T) {
+	spec := ExamSpec{QuestionCount: 3, QuestionType: QuestionTypeMCQ, Cells: []ExamCell{{"Go", "Junior", 2}, {"SQL", "Senior", 1}}}
+	options := []string{"A goroutine", "A channel", "A mutex", "A wait group"}
+	labelled := func(id int32, text, topic, level string) *suggest.SuggestExamQuestionResponseV2_Quetion {
+		question := mcq(id, text, options, 0)
+		question.Topic, question.Level = topic, level
+		return question
+	}
+
+	// Labels are matched regardless of case.
+	assert.Empty(t, ValidateExam([]*suggest.SuggestExamQuestionResponseV2_Quetion{
+		labelled(1, "Which primitive starts concurrent work in Go?", "Go", "Junior"),
+		labelled(2, "Which primitive passes values between goroutines?", "go", "junior"),
+		labelled(3, "Which isolation level prevents phantom reads?", "SQL", "Senior"),
+	}, spec))
+
+	// The right total on a single cell is not enough.
+	assert.Equal(t, []Violation{
+		{QuestionId: 3, Field: "topic", Code: CodeBreakdown, Message: `the exam already has the 2 questions asked for on Go at the Junior level; rewrite it as a question on SQL at the Senior level with "topic" "SQL" and "level" "Senior"`},
+		{Field: "questions", Code: CodeBreakdown, Message: "expected 1 questions on SQL at the Senior level, got 0"},
+	}, ValidateExam([]*suggest.SuggestExamQuestionResponseV2_Quetion{
+		labelled(1, "Which primitive starts concurrent work in Go?", "Go", "Junior"),
+		labelled(2, "Which primitive passes values between goroutines?", "Go", "Junior"),
+		labelled(3, "Which primitive guards shared memory in Go?", "Go", "Junior"),
+	}, spec))
+
+	// A question outside of the breakdown is asked to move to a short cell.
+	assert.Equal(t, []Violation{
+		{QuestionId: 3, Field: "topic", Code: CodeBreakdown, Message: `the question is on SQL at the Junior level, which is not part of the requested breakdown; rewrite it as a question on SQL at the Senior level with "topic" "SQL" and "level" "Senior"`},
+		{Field: "questions", Code: CodeBreakdown, Message: "expected 1 questions on SQL at the Senior level, got 0"},
+	}, ValidateExam([]*suggest.SuggestExamQuestionResponseV2_Quetion{
+		labelled(1, "Which primitive starts concurrent work in Go?", "Go", "Junior"),
+		labelled(2, "Which primitive passes values between goroutines?", "Go", "Junior"),
+		labelled(3, "Which isolation level prevents phantom reads?", "SQL", "Junior"),
+	}, spec))
+
+	assert.Equal(t, []ExamCell{{"SQL", "Senior", 1}}, MissingCells([]*suggest.SuggestExamQuestionResponseV2_Quetion{
+		labelled(1, "Which primitive starts concurrent work in Go?", "Go", "Junior"),
+	}, ExamSpec{Cells: []ExamCell{{"Go", "Junior", 1}, {"SQL", "Senior", 1}}}))
+}
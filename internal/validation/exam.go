@@ -0,0 +1,295 @@
+package validation
+
+import (
+	"darius/internal/similarity"
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"strings"
+)
+
+const (
+	QuestionTypeMCQ        = "MCQ"
+	QuestionTypeLongAnswer = "LONG_ANSWER"
+	QuestionTypeMixed      = "MIXED"
+
+	MCQOptionCount = 4
+)
+
+const (
+	CodeQuestionCount   = "question_count"
+	CodeDuplicateId     = "duplicate_id"
+	CodeEmptyText       = "empty_text"
+	CodeUnknownType     = "unknown_type"
+	CodeTypeMismatch    = "type_mismatch"
+	CodeDisallowedType  = "disallowed_type"
+	CodeOptionCount     = "option_count"
+	CodeDuplicateOption = "duplicate_option"
+	CodeCorrectOption   = "correct_option_out_of_range"
+	CodeMissingAnswer   = "missing_answer"
+	CodeLanguage        = "language_mismatch"
+	CodeNearDuplicate   = "near_duplicate"
+	CodeTypeCount       = "type_count"
+	CodeBlankCount      = "blank_count"
+	CodeCodeLanguage    = "code_language"
+	CodeTestCount       = "test_count"
+	CodeFailingTests    = "failing_tests"
+	CodeUnverified      = "unverified"
+	CodeAnswerKey       = "answer_key"
+	CodeExplanation     = "option_explanation"
+	CodePointsTotal     = "points_total"
+	CodeTimeBudget      = "time_budget"
+	CodeCitation        = "citation"
+	CodeUnsupportedType = "unsupported_type"
+	CodeUnreadable      = "unreadable_question"
+	CodeBloomLevel      = "bloom_level"
+	CodeSolveTime       = "solve_time"
+	CodeTagMismatch     = "tag_mismatch"
+	CodeBreakdown       = "breakdown"
+)
+
+// Violation is one rule a generated question breaks. QuestionId is 0 for problems with
+// the exam as a whole.
+type Violation struct {
+	QuestionId int32
+	Field      string
+	Code       string
+	Message    string
+}
+
+func (v Violation) String() string {
+	if v.QuestionId == 0 {
+		return fmt.Sprintf("exam: %s", v.Message)
+	}
+	return fmt.Sprintf("question %d, %s: %s", v.QuestionId, v.Field, v.Message)
+}
+
+// ExamSpec is what the request asked for.
+type ExamSpec struct {
+	QuestionCount int
+	Cells         []ExamCell // questions per topic and level, when the request has a breakdown
+	QuestionType  string     // one of QuestionTypes or MIXED
+	Language      string
+	TypeCounts    map[string]int // questions per type of a MIXED exam with a type ratio
+	CodeLanguage  string         // programming language of CODE questions, when the exam may have them
+	DocumentIds   []uint64       // uploaded documents every question must cite
+	Excluded      []string       // texts of existing questions, exemplars included, the exam must not repeat
+	BloomCounts   map[string]int // questions per Bloom level, when the request has a target distribution
+	Banked        map[int32]bool // ids of questions taken from the question bank, which may predate the fields generated questions must have
+}
+
+// ExamSpecFromRequest reads the expected count, breakdown, type and language of an exam
+// request.
+func ExamSpecFromRequest(req *suggest.SuggestExamQuestionRequest) ExamSpec {
+	cells := ExamCells(req.GetTopics())
+	count := 0
+	for _, cell := range cells {
+		count += cell.Count
+	}
+	spec := ExamSpec{
+		QuestionCount: count,
+		Cells:         cells,
+		QuestionType:  req.GetQuestionType(),
+		Language:      req.GetLanguage(),
+		DocumentIds:   req.GetDocumentIds(),
+		BloomCounts:   BloomCounts(req.GetBloomDistribution()),
+	}
+	for _, questions := range [][]*suggest.SuggestExamQuestionResponseV2_Quetion{req.GetExemplars(), req.GetExcludedQuestions()} {
+		for _, question := range questions {
+			spec.Excluded = append(spec.Excluded, question.GetText())
+		}
+	}
+	if spec.QuestionType == "" || spec.QuestionType == QuestionTypeMixed {
+		spec.TypeCounts = TypeCounts(req.GetTypeRatio(), count)
+	}
+	if spec.Allows(QuestionTypeCode) {
+		spec.CodeLanguage = req.GetCodeLanguage()
+		if spec.CodeLanguage == "" {
+			spec.CodeLanguage = DefaultCodeLanguage
+		}
+	}
+	return spec
+}
+
+// ValidateExam checks the generated questions against the spec and the structural rules
+// every question must follow.
+func ValidateExam(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec) []Violation {
+	var violations []Violation
+
+	if spec.QuestionCount > 0 && len(questions) != spec.QuestionCount {
+		violations = append(violations, Violation{
+			Field:   "questions",
+			Code:    CodeQuestionCount,
+			Message: fmt.Sprintf("expected %d questions, got %d", spec.QuestionCount, len(questions)),
+		})
+	}
+
+	seenIds := make(map[int32]bool, len(questions))
+	for _, question := range questions {
+		if seenIds[question.GetId()] {
+			violations = append(violations, Violation{
+				QuestionId: question.GetId(),
+				Field:      "id",
+				Code:       CodeDuplicateId,
+				Message:    "id is used by more than one question",
+			})
+		}
+		seenIds[question.GetId()] = true
+
+		violations = append(violations, ValidateQuestion(question, spec)...)
+	}
+	violations = append(violations, validateBreakdown(questions, spec)...)
+	return append(violations, validateTypeCounts(questions, spec)...)
+}
+
+// FullBucket names the share of the spec a question would go over on top of the questions
+// already kept: its type, its Bloom level, or its topic and level. It is empty while the
+// question still fits, so a question can be turned away as soon as its share is full.
+func FullBucket(kept []*suggest.SuggestExamQuestionResponseV2_Quetion, question *suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec) string {
+	if len(spec.TypeCounts) > 0 && countTypes(kept)[question.GetType()] >= spec.TypeCounts[question.GetType()] {
+		return fmt.Sprintf("%s questions", question.GetType())
+	}
+	if len(spec.BloomCounts) > 0 {
+		level, have := QuestionBloomLevel(question), 0
+		for _, other := range kept {
+			if QuestionBloomLevel(other) == level {
+				have++
+			}
+		}
+		if have >= spec.BloomCounts[level] {
+			return fmt.Sprintf("questions at the %s Bloom level", level)
+		}
+	}
+	if len(spec.Cells) > 0 {
+		i := cellOf(question, spec.Cells)
+		if i < 0 {
+			return fmt.Sprintf("questions on %s at the %s level, which is not part of the requested breakdown", question.GetTopic(), question.GetLevel())
+		}
+		have := 0
+		for _, other := range kept {
+			if cellOf(other, spec.Cells) == i {
+				have++
+			}
+		}
+		if have >= spec.Cells[i].Count {
+			return fmt.Sprintf("questions on %s", spec.Cells[i])
+		}
+	}
+	return ""
+}
+
+// ValidateDuplicates flags every question whose text is at least threshold similar to an
+// earlier question of the exam. A threshold of 0 turns the check off.
+func ValidateDuplicates(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, threshold float64) []Violation {
+	texts := make([]string, len(questions))
+	for i, question := range questions {
+		texts[i] = question.GetText()
+	}
+
+	var violations []Violation
+	for _, duplicate := range similarity.Duplicates(texts, nil, threshold) {
+		violations = append(violations, Violation{
+			QuestionId: questions[duplicate.Index].GetId(),
+			Field:      "text",
+			Code:       CodeNearDuplicate,
+			Message:    fmt.Sprintf("question repeats question %d", questions[duplicate.Of].GetId()),
+		})
+	}
+	return violations
+}
+
+// ValidateQuestion checks a single question. Questions from the question bank don't need the
+// tags and option explanations that generated questions carry.
+func ValidateQuestion(question *suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec) []Violation {
+	var violations []Violation
+	add := func(field, code, format string, args ...interface{}) {
+		violations = append(violations, Violation{
+			QuestionId: question.GetId(),
+			Field:      field,
+			Code:       code,
+			Message:    fmt.Sprintf(format, args...),
+		})
+	}
+
+	if strings.TrimSpace(question.GetText()) == "" {
+		add("text", CodeEmptyText, "question text is empty")
+	}
+	generated := !spec.Banked[question.GetId()]
+	if generated && !IsBloomLevel(question.GetBloomLevel()) {
+		add("bloomLevel", CodeBloomLevel, "bloomLevel %q is not one of %s", question.GetBloomLevel(), strings.Join(BloomLevels, ", "))
+	}
+	if generated && question.GetEstimatedMinutes() <= 0 {
+		add("estimatedMinutes", CodeSolveTime, "question has no estimated solve time")
+	}
+
+	questionType := question.GetType()
+	if !IsQuestionType(questionType) {
+		add("type", CodeUnknownType, "type %q is not one of %s", questionType, strings.Join(QuestionTypes, ", "))
+		return violations
+	}
+
+	if question.GetDetail().GetType() != questionType {
+		add("detail.type", CodeTypeMismatch, "detail.type %q does not match type %q", question.GetDetail().GetType(), questionType)
+	}
+	if !spec.Allows(questionType) {
+		add("type", CodeDisallowedType, "only %s questions were requested", strings.Join(spec.AllowedTypes(), ", "))
+	}
+
+	switch questionType {
+	case QuestionTypeMCQ:
+		options := question.GetDetail().GetOptions()
+		if len(options) != MCQOptionCount {
+			add("detail.options", CodeOptionCount, "expected %d options, got %d", MCQOptionCount, len(options))
+		}
+		checkRepeated(options, func(i, first int) {
+			add("detail.options", CodeDuplicateOption, "option %d repeats option %d", i, first)
+		})
+
+		correctOption := question.GetDetail().GetCorrectOption()
+		if correctOption < 0 || int(correctOption) >= len(options) {
+			add("detail.correctOption", CodeCorrectOption, "correctOption %d is not an index of the %d options", correctOption, len(options))
+		}
+		if generated {
+			validateExplanations(question.GetDetail(), add)
+		}
+	case QuestionTypeLongAnswer:
+		if strings.TrimSpace(question.GetDetail().GetCorrectAnswer()) == "" {
+			add("detail.correctAnswer", CodeMissingAnswer, "long answer question has no expected answer")
+		}
+	default:
+		validateTypeDetail(question, spec, add)
+	}
+
+	if detected, ok := DetectLanguage(questionText(question)); ok && !SameLanguage(spec.Language, detected) {
+		add("text", CodeLanguage, "question is written in %s, expected %s", detected, spec.Language)
+	}
+
+	return violations
+}
+
+// validateExplanations checks that every MCQ option explains why it is right or wrong, and
+// that every distractor names the misconception it targets.
+func validateExplanations(detail *suggest.SuggestExamQuestionResponseV2_Detail, add func(field, code, format string, args ...interface{})) {
+	explanations := detail.GetOptionExplanations()
+	if len(explanations) != len(detail.GetOptions()) {
+		add("detail.optionExplanations", CodeExplanation, "expected an explanation for each of the %d options, got %d", len(detail.GetOptions()), len(explanations))
+	}
+	for i, explanation := range explanations {
+		if i >= len(detail.GetOptions()) {
+			break
+		}
+		if strings.TrimSpace(explanation.GetExplanation()) == "" {
+			add("detail.optionExplanations", CodeExplanation, "option %d has no explanation", i)
+		}
+		if int32(i) != detail.GetCorrectOption() && strings.TrimSpace(explanation.GetMisconception()) == "" {
+			add("detail.optionExplanations", CodeExplanation, "distractor %d has no misconception tag", i)
+		}
+	}
+}
+
+func questionText(question *suggest.SuggestExamQuestionResponseV2_Quetion) string {
+	texts := append([]string{question.GetText()}, question.GetDetail().GetOptions()...)
+	for _, explanation := range question.GetDetail().GetOptionExplanations() {
+		texts = append(texts, explanation.GetExplanation())
+	}
+	return strings.Join(append(texts, typeTexts(question.GetDetail())...), " ")
+}
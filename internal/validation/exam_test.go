@@ -0,0 +1,206 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mcq(id int32, text string, options []string, correct int32) *suggest.SuggestExamQuestionResponseV2_Quetion {
+	explanations := make([]*suggest.SuggestExamQuestionResponseV2_OptionExplanation, len(options))
+	for i, option := range options {
+		explanations[i] = &suggest.SuggestExamQuestionResponseV2_OptionExplanation{Explanation: option, Misconception: "confuses-primitives"}
+	}
+	return &suggest.SuggestExamQuestionResponseV2_Quetion{
+		Id:               id,
+		Text:             text,
+		Type:             QuestionTypeMCQ,
+		BloomLevel:       BloomRemember,
+		EstimatedMinutes: 1,
+		Detail: &suggest.SuggestExamQuestionResponseV2_Detail{
+			Type:               QuestionTypeMCQ,
+			Options:            options,
+			CorrectOption:      correct,
+			OptionExplanations: explanations,
+		},
+	}
+}
+
+func codes(violations []Violation) []string {
+	var result []string
+	for _, violation := range violations {
+		result = append(result, violation.Code)
+	}
+	return result
+}
+
+func TestExamSpecFromRequest(t *testing.T) {
+	spec := ExamSpecFromRequest(&suggest.SuggestExamQuestionRequest{
+		Language:     "English",
+		QuestionType: "MCQ",
+		Topics: []*suggest.Topic{
+			{Name: "Go", DifficultyDistribution: &suggest.DifficultyDistribution{Junior: 2, Senior: 1}},
+			{Name: "SQL", DifficultyDistribution: &suggest.DifficultyDistribution{Intern: 1}},
+		},
+		Exemplars:         []*suggest.SuggestExamQuestionResponseV2_Quetion{{Text: "What is a goroutine?"}},
+		ExcludedQuestions: []*suggest.SuggestExamQuestionResponseV2_Quetion{{Text: "What is a channel?"}},
+		BloomDistribution: &suggest.BloomDistribution{Remember: 3, Apply: 1},
+	})
+	assert.Equal(t, ExamSpec{
+		QuestionCount: 4,
+		Cells:         []ExamCell{{"Go", "Junior", 2}, {"Go", "Senior", 1}, {"SQL", "Intern", 1}},
+		QuestionType:  "MCQ",
+		Language:      "English",
+		Excluded:      []string{"What is a goroutine?", "What is a channel?"},
+		BloomCounts:   map[string]int{BloomRemember: 3, BloomApply: 1},
+	}, spec)
+}
+
+func TestValidateExam(t *testing.T) {
+	spec := ExamSpec{QuestionCount: 3, QuestionType: QuestionTypeMixed, Language: "English"}
+	options := []string{"A goroutine", "A channel", "A mutex", "A wait group"}
+
+	tests := []struct {
+		name      string
+		questions []*suggest.SuggestExamQuestionResponseV2_Quetion
+		want      []string
+	}{
+		{
+			name: "valid",
+			questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{
+				mcq(1, "Which primitive starts concurrent work in Go?", options, 0),
+				mcq(2, "Which primitive passes values between goroutines?", options, 1),
+				{
+					Id:               3,
+					Text:             "Explain how the Go scheduler multiplexes goroutines onto threads.",
+					Type:             QuestionTypeLongAnswer,
+					BloomLevel:       BloomUnderstand,
+					EstimatedMinutes: 8,
+					Detail:           &suggest.SuggestExamQuestionResponseV2_Detail{Type: QuestionTypeLongAnswer, CorrectAnswer: "M:N scheduling..."},
+				},
+			},
+		},
+		{
+			name: "wrong count and duplicate id",
+			questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{
+				mcq(1, "Which primitive starts concurrent work in Go?", options, 0),
+				mcq(1, "Which primitive passes values between goroutines?", options, 1),
+			},
+			want: []string{CodeQuestionCount, CodeDuplicateId},
+		},
+		{
+			name: "broken mcq",
+			questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{
+				mcq(1, "Which primitive starts concurrent work in Go?", []string{"A goroutine", "a  goroutine", "A mutex"}, 3),
+				mcq(2, "Which primitive passes values between goroutines?", options, 1),
+				mcq(3, "Which primitive guards shared memory in Go?", options, 2),
+			},
+			want: []string{CodeOptionCount, CodeDuplicateOption, CodeCorrectOption},
+		},
+		{
+			name: "type mismatch and missing answer",
+			questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{
+				mcq(1, "Which primitive starts concurrent work in Go?", options, 0),
+				mcq(2, "Which primitive passes values between goroutines?", options, 1),
+				{
+					Id:               3,
+					Text:             "Explain how the Go scheduler multiplexes goroutines onto threads.",
+					Type:             QuestionTypeLongAnswer,
+					BloomLevel:       BloomUnderstand,
+					EstimatedMinutes: 8,
+					Detail:           &suggest.SuggestExamQuestionResponseV2_Detail{Type: QuestionTypeMCQ},
+				},
+			},
+			want: []string{CodeTypeMismatch, CodeMissingAnswer},
+		},
+		{
+			name: "wrong language",
+			questions: []*suggest.SuggestExamQuestionResponseV2_Quetion{
+				mcq(1, "Which primitive starts concurrent work in Go?", options, 0),
+				mcq(2, "Which primitive passes values between goroutines?", options, 1),
+				mcq(3, "Cơ chế nào được dùng để bảo vệ vùng nhớ dùng chung trong Go?", []string{"Khóa mutex", "Kênh", "Hàm", "Biến toàn cục"}, 0),
+			},
+			want: []string{CodeLanguage},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, codes(ValidateExam(tt.questions, spec)))
+		})
+	}
+}
+
+func TestValidateQuestion_Explanations(t *testing.T) {
+	question := mcq(1, "Which primitive starts concurrent work in Go?", []string{"A goroutine", "A channel", "A mutex", "A wait group"}, 0)
+	assert.Empty(t, ValidateQuestion(question, ExamSpec{}))
+
+	question.Detail.OptionExplanations[0].Misconception = ""
+	question.Detail.OptionExplanations[1] = &suggest.SuggestExamQuestionResponseV2_OptionExplanation{Explanation: " "}
+	question.Detail.OptionExplanations = question.Detail.OptionExplanations[:3]
+	violations := ValidateQuestion(question, ExamSpec{})
+	assert.Equal(t, []string{CodeExplanation, CodeExplanation, CodeExplanation}, codes(violations))
+	assert.Equal(t, "expected an explanation for each of the 4 options, got 3", violations[0].Message)
+	assert.Equal(t, "option 1 has no explanation", violations[1].Message)
+	assert.Equal(t, "distractor 1 has no misconception tag", violations[2].Message)
+}
+
+func TestValidateQuestion_Tags(t *testing.T) {
+	question := mcq(1, "Which primitive starts concurrent work in Go?", []string{"A goroutine", "A channel", "A mutex", "A wait group"}, 0)
+	question.BloomLevel, question.EstimatedMinutes = "Apply", 0
+	violations := ValidateQuestion(question, ExamSpec{})
+	assert.Equal(t, []string{CodeBloomLevel, CodeSolveTime}, codes(violations))
+	assert.Equal(t, `bloomLevel "Apply" is not one of REMEMBER, UNDERSTAND, APPLY, ANALYZE, EVALUATE, CREATE`, violations[0].Message)
+
+	// Banked questions may have been saved before questions were tagged or explained.
+	question.Detail.OptionExplanations = nil
+	assert.Equal(t, []string{CodeBloomLevel, CodeSolveTime, CodeExplanation}, codes(ValidateQuestion(question, ExamSpec{Banked: map[int32]bool{2: true}})))
+	assert.Empty(t, ValidateQuestion(question, ExamSpec{Banked: map[int32]bool{1: true}}))
+}
+
+func TestValidateExam_DisallowedType(t *testing.T) {
+	questions := []*suggest.SuggestExamQuestionResponseV2_Quetion{
+		mcq(1, "Which primitive starts concurrent work in Go?", []string{"A goroutine", "A channel", "A mutex", "A wait group"}, 0),
+	}
+	violations := ValidateExam(questions, ExamSpec{QuestionType: QuestionTypeLongAnswer})
+	assert.Equal(t, []string{CodeDisallowedType}, codes(violations))
+	assert.Equal(t, int32(1), violations[0].QuestionId)
+}
+
+func TestValidateDuplicates(t *testing.T) {
+	options := []string{"A goroutine", "A channel", "A mutex", "A wait group"}
+	questions := []*suggest.SuggestExamQuestionResponseV2_Quetion{
+		mcq(1, "Which primitive starts concurrent work in Go?", options, 0),
+		mcq(2, "Which primitive passes values between goroutines?", options, 1),
+		mcq(3, "In Go, which primitive starts concurrent work?", options, 0),
+	}
+
+	assert.Equal(t, []Violation{{
+		QuestionId: 3,
+		Field:      "text",
+		Code:       CodeNearDuplicate,
+		Message:    "question repeats question 1",
+	}}, ValidateDuplicates(questions, 0.8))
+	assert.Empty(t, ValidateDuplicates(questions, 0))
+}
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+		ok   bool
+	}{
+		{text: "Which primitive starts concurrent work in Go?", want: "English", ok: true},
+		{text: "Goroutine là gì và nó khác gì so với thread của hệ điều hành?", want: "Vietnamese", ok: true},
+		{text: "Goroutineとは何ですか？スレッドとの違いを説明してください。", want: "Japanese", ok: true},
+		{text: "什么是 goroutine？它与操作系统线程有什么区别？请详细说明。", want: "Chinese", ok: true},
+		{text: "Qu'est-ce qu'une goroutine et en quoi diffère-t-elle d'un thread ?", ok: false},
+		{text: "Too short", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := DetectLanguage(tt.text)
+		assert.Equal(t, tt.ok, ok, tt.text)
+		assert.Equal(t, tt.want, got, tt.text)
+	}
+}
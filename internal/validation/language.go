@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minLetters is the amount of text below which the language of a question is not judged.
+const minLetters = 20
+
+var languageAliases = map[string]string{
+	"english":    "English",
+	"en":         "English",
+	"vietnamese": "Vietnamese",
+	"vi":         "Vietnamese",
+	"tiếng việt": "Vietnamese",
+	"chinese":    "Chinese",
+	"zh":         "Chinese",
+	"japanese":   "Japanese",
+	"ja":         "Japanese",
+	"korean":     "Korean",
+	"ko":         "Korean",
+	"russian":    "Russian",
+	"ru":         "Russian",
+	"thai":       "Thai",
+	"th":         "Thai",
+	"arabic":     "Arabic",
+	"ar":         "Arabic",
+}
+
+// vietnameseLetters are the Latin letters only Vietnamese uses among the languages we tell apart.
+const vietnameseLetters = "ăâđêôơưàảãạằẳẵặầẩẫậèẻẽẹềểễệìỉĩịòỏõọồổỗộờởỡợùủũụừửữựỳỷỹỵ"
+
+// DetectLanguage guesses the language of a text from its script, telling Vietnamese from
+// English by its diacritics. It reports false when the text is too short or the script
+// alone can't tell, such as French or Spanish.
+func DetectLanguage(text string) (string, bool) {
+	var letters, latin, vietnamese, nonASCIILatin, han, kana, hangul, cyrillic, thai, arabic int
+	for _, r := range strings.ToLower(text) {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+			if strings.ContainsRune(vietnameseLetters, r) {
+				vietnamese++
+			}
+			if r > unicode.MaxASCII {
+				nonASCIILatin++
+			}
+		case unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r):
+			kana++
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.Is(unicode.Hangul, r):
+			hangul++
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Thai, r):
+			thai++
+		case unicode.Is(unicode.Arabic, r):
+			arabic++
+		}
+	}
+	if letters < minLetters {
+		return "", false
+	}
+
+	// Technical questions mix in Latin identifiers, so a script only has to make up a
+	// good share of the letters rather than most of them.
+	share := func(count int) bool { return count*4 >= letters }
+	switch {
+	case share(kana):
+		return "Japanese", true
+	case share(hangul):
+		return "Korean", true
+	case share(han):
+		return "Chinese", true
+	case share(cyrillic):
+		return "Russian", true
+	case share(thai):
+		return "Thai", true
+	case share(arabic):
+		return "Arabic", true
+	case latin*2 < letters:
+		return "", false
+	case vietnamese*30 >= latin:
+		return "Vietnamese", true
+	case nonASCIILatin == 0:
+		return "English", true
+	}
+	return "", false
+}
+
+// SameLanguage reports whether a detected language matches the one requested. Requests
+// for a language DetectLanguage can't recognise always match.
+func SameLanguage(requested, detected string) bool {
+	canonical, ok := languageAliases[strings.ToLower(strings.TrimSpace(requested))]
+	if !ok {
+		return true
+	}
+	return canonical == detected
+}
@@ -0,0 +1,357 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	QuestionTypeTrueFalse   = "TRUE_FALSE"
+	QuestionTypeMultiSelect = "MULTI_SELECT"
+	QuestionTypeFillInBlank = "FILL_IN_BLANK"
+	QuestionTypeMatching    = "MATCHING"
+	QuestionTypeOrdering    = "ORDERING"
+	QuestionTypeCode        = "CODE"
+
+	MultiSelectMinOptions = 4
+	MatchingMinPairs      = 3
+	OrderingMinItems      = 3
+	CodeMinVisibleTests   = 1
+	CodeMinHiddenTests    = 2
+
+	// DefaultCodeLanguage is the programming language of CODE questions when none is requested.
+	DefaultCodeLanguage = "python"
+)
+
+// QuestionTypes are the question types an exam can have, in the order they are listed.
+var QuestionTypes = []string{
+	QuestionTypeMCQ,
+	QuestionTypeLongAnswer,
+	QuestionTypeTrueFalse,
+	QuestionTypeMultiSelect,
+	QuestionTypeFillInBlank,
+	QuestionTypeMatching,
+	QuestionTypeOrdering,
+	QuestionTypeCode,
+}
+
+// mixedDefaultTypes make up a MIXED exam that has no type ratio.
+var mixedDefaultTypes = []string{QuestionTypeMCQ, QuestionTypeLongAnswer}
+
+// blank is how a fill-in-the-blank question marks the gap in its text.
+var blank = regexp.MustCompile(`_{3,}`)
+
+// IsQuestionType reports whether t is one of QuestionTypes.
+func IsQuestionType(t string) bool {
+	for _, questionType := range QuestionTypes {
+		if questionType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckQuestionType rejects a requested question type that is neither MIXED nor one of
+// QuestionTypes, and a type ratio naming unknown types or giving none a share.
+func CheckQuestionType(questionType string, ratio map[string]int32) error {
+	if questionType != "" && questionType != QuestionTypeMixed && !IsQuestionType(questionType) {
+		return fmt.Errorf("unknown question type %q", questionType)
+	}
+	total := int32(0)
+	for t, weight := range ratio {
+		if !IsQuestionType(strings.ToUpper(t)) {
+			return fmt.Errorf("unknown question type %q in type ratio", t)
+		}
+		if weight < 0 {
+			return fmt.Errorf("negative share for %s in type ratio", t)
+		}
+		total += weight
+	}
+	if len(ratio) > 0 && total == 0 {
+		return fmt.Errorf("type ratio gives no type a share")
+	}
+	return nil
+}
+
+// TypeCounts splits total questions over the types of a ratio such as {"MCQ": 3,
+// "TRUE_FALSE": 1}, giving the questions left after rounding down to the types with the
+// largest remainders. Types without a share are left out.
+func TypeCounts(ratio map[string]int32, total int) map[string]int {
+	weights := make(map[string]int)
+	sum := 0
+	for t, weight := range ratio {
+		if weight > 0 {
+			weights[strings.ToUpper(t)] += int(weight)
+			sum += int(weight)
+		}
+	}
+	if sum == 0 || total <= 0 {
+		return nil
+	}
+	return apportion(weights, total, orderedTypes(weights))
+}
+
+// apportion splits total over the keys of weights in proportion to their weight, giving
+// what is left after rounding down to the largest remainders, earlier keys of order first.
+func apportion(weights map[string]int, total int, order []string) map[string]int {
+	sum := 0
+	for _, weight := range weights {
+		sum += weight
+	}
+	if sum == 0 || total <= 0 {
+		return nil
+	}
+
+	counts := make(map[string]int, len(weights))
+	remainders := make(map[string]int, len(weights))
+	assigned := 0
+	for key, weight := range weights {
+		counts[key] = total * weight / sum
+		remainders[key] = total * weight % sum
+		assigned += counts[key]
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for i := 0; assigned < total; i++ {
+		counts[order[i%len(order)]]++
+		assigned++
+	}
+	return counts
+}
+
+// AllowedTypes are the question types the exam may contain.
+func (s ExamSpec) AllowedTypes() []string {
+	if s.QuestionType != "" && s.QuestionType != QuestionTypeMixed {
+		return []string{s.QuestionType}
+	}
+	if len(s.TypeCounts) > 0 {
+		return orderedTypes(s.TypeCounts)
+	}
+	return mixedDefaultTypes
+}
+
+// Allows reports whether the exam may contain questions of the given type.
+func (s ExamSpec) Allows(questionType string) bool {
+	for _, allowed := range s.AllowedTypes() {
+		if allowed == questionType {
+			return true
+		}
+	}
+	return false
+}
+
+// MissingTypes counts, per type, how many questions of that type the exam lacks to match
+// the spec's type counts.
+func MissingTypes(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec) map[string]int {
+	if len(spec.TypeCounts) == 0 {
+		return nil
+	}
+	have := countTypes(questions)
+	missing := make(map[string]int)
+	for t, want := range spec.TypeCounts {
+		if have[t] < want {
+			missing[t] = want - have[t]
+		}
+	}
+	return missing
+}
+
+// validateTypeCounts flags surplus questions of a type when another type is short, asking
+// for them to be rewritten as the short type, and reports any type still short.
+func validateTypeCounts(questions []*suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec) []Violation {
+	if len(spec.TypeCounts) == 0 {
+		return nil
+	}
+	have := countTypes(questions)
+
+	var short []string
+	for _, t := range orderedTypes(spec.TypeCounts) {
+		for i := have[t]; i < spec.TypeCounts[t]; i++ {
+			short = append(short, t)
+		}
+	}
+
+	var violations []Violation
+	surplus := make(map[string]int)
+	for t, count := range have {
+		if count > spec.TypeCounts[t] {
+			surplus[t] = count - spec.TypeCounts[t]
+		}
+	}
+	for i := len(questions) - 1; i >= 0 && len(short) > 0; i-- {
+		question := questions[i]
+		if surplus[question.GetType()] == 0 || !IsQuestionType(question.GetType()) || !spec.Allows(question.GetType()) {
+			continue
+		}
+		surplus[question.GetType()]--
+		violations = append(violations, Violation{
+			QuestionId: question.GetId(),
+			Field:      "type",
+			Code:       CodeTypeCount,
+			Message:    fmt.Sprintf("the exam has too many %s questions and too few %s questions, rewrite this one as a %s question", question.GetType(), short[0], short[0]),
+		})
+		short = short[1:]
+	}
+
+	for _, t := range orderedTypes(spec.TypeCounts) {
+		if have[t] < spec.TypeCounts[t] {
+			violations = append(violations, Violation{
+				Field:   "questions",
+				Code:    CodeTypeCount,
+				Message: fmt.Sprintf("expected %d %s questions, got %d", spec.TypeCounts[t], t, have[t]),
+			})
+		}
+	}
+	return violations
+}
+
+// validateTypeDetail checks the answer key of a question of one of the newer types.
+func validateTypeDetail(question *suggest.SuggestExamQuestionResponseV2_Quetion, spec ExamSpec, add func(field, code, format string, args ...interface{})) {
+	detail := question.GetDetail()
+	switch question.GetType() {
+	case QuestionTypeTrueFalse:
+		if detail.GetTrueFalse() == nil {
+			add("detail.trueFalse", CodeMissingAnswer, "true/false question does not say whether the statement is true")
+		}
+
+	case QuestionTypeMultiSelect:
+		multiSelect := detail.GetMultiSelect()
+		if multiSelect == nil {
+			add("detail.multiSelect", CodeMissingAnswer, "multi-select question has no options")
+			return
+		}
+		options := multiSelect.GetOptions()
+		if len(options) < MultiSelectMinOptions {
+			add("detail.multiSelect.options", CodeOptionCount, "expected at least %d options, got %d", MultiSelectMinOptions, len(options))
+		}
+		checkRepeated(options, func(i, first int) {
+			add("detail.multiSelect.options", CodeDuplicateOption, "option %d repeats option %d", i, first)
+		})
+		if len(multiSelect.GetCorrectOptions()) == 0 {
+			add("detail.multiSelect.correctOptions", CodeMissingAnswer, "multi-select question has no correct options")
+		}
+		seen := make(map[int32]bool)
+		for _, correct := range multiSelect.GetCorrectOptions() {
+			if correct < 0 || int(correct) >= len(options) {
+				add("detail.multiSelect.correctOptions", CodeCorrectOption, "correct option %d is not an index of the %d options", correct, len(options))
+			} else if seen[correct] {
+				add("detail.multiSelect.correctOptions", CodeDuplicateOption, "correct option %d is listed twice", correct)
+			}
+			seen[correct] = true
+		}
+
+	case QuestionTypeFillInBlank:
+		if blanks := len(blank.FindAllString(question.GetText(), -1)); blanks != 1 {
+			add("text", CodeBlankCount, "expected the text to have exactly one blank (___), got %d", blanks)
+		}
+		answers := detail.GetFillInBlank().GetAcceptedAnswers()
+		if len(answers) == 0 {
+			add("detail.fillInBlank.acceptedAnswers", CodeMissingAnswer, "fill-in-the-blank question has no accepted answers")
+		}
+		for i, answer := range answers {
+			if strings.TrimSpace(answer) == "" {
+				add("detail.fillInBlank.acceptedAnswers", CodeMissingAnswer, "accepted answer %d is empty", i)
+			}
+		}
+
+	case QuestionTypeMatching:
+		pairs := detail.GetMatching().GetPairs()
+		if len(pairs) < MatchingMinPairs {
+			add("detail.matching.pairs", CodeOptionCount, "expected at least %d pairs, got %d", MatchingMinPairs, len(pairs))
+		}
+		lefts, rights := make([]string, len(pairs)), make([]string, len(pairs))
+		for i, pair := range pairs {
+			lefts[i], rights[i] = pair.GetLeft(), pair.GetRight()
+			if strings.TrimSpace(pair.GetLeft()) == "" || strings.TrimSpace(pair.GetRight()) == "" {
+				add("detail.matching.pairs", CodeMissingAnswer, "pair %d has an empty side", i)
+			}
+		}
+		checkRepeated(lefts, func(i, first int) {
+			add("detail.matching.pairs", CodeDuplicateOption, "left side of pair %d repeats pair %d", i, first)
+		})
+		checkRepeated(rights, func(i, first int) {
+			add("detail.matching.pairs", CodeDuplicateOption, "right side of pair %d repeats pair %d", i, first)
+		})
+
+	case QuestionTypeOrdering:
+		items := detail.GetOrdering().GetItems()
+		if len(items) < OrderingMinItems {
+			add("detail.ordering.items", CodeOptionCount, "expected at least %d items, got %d", OrderingMinItems, len(items))
+		}
+		checkRepeated(items, func(i, first int) {
+			add("detail.ordering.items", CodeDuplicateOption, "item %d repeats item %d", i, first)
+		})
+
+	case QuestionTypeCode:
+		code := detail.GetCode()
+		if code == nil {
+			add("detail.code", CodeMissingAnswer, "code question has no code detail")
+			return
+		}
+		if spec.CodeLanguage != "" && !strings.EqualFold(code.GetLanguage(), spec.CodeLanguage) {
+			add("detail.code.language", CodeCodeLanguage, "code is written in %q, expected %q", code.GetLanguage(), spec.CodeLanguage)
+		}
+		if strings.TrimSpace(code.GetStarterCode()) == "" {
+			add("detail.code.starterCode", CodeMissingAnswer, "code question has no starter code")
+		}
+		if strings.TrimSpace(code.GetReferenceSolution()) == "" {
+			add("detail.code.referenceSolution", CodeMissingAnswer, "code question has no reference solution")
+		}
+		visible, hidden := 0, 0
+		for _, test := range code.GetTestCases() {
+			if test.GetHidden() {
+				hidden++
+			} else {
+				visible++
+			}
+		}
+		if visible < CodeMinVisibleTests || hidden < CodeMinHiddenTests {
+			add("detail.code.testCases", CodeTestCount, "expected at least %d visible and %d hidden test cases, got %d and %d", CodeMinVisibleTests, CodeMinHiddenTests, visible, hidden)
+		}
+	}
+}
+
+// checkRepeated calls repeated for every value that equals an earlier one, ignoring case
+// and spacing.
+func checkRepeated(values []string, repeated func(i, first int)) {
+	seen := make(map[string]int, len(values))
+	for i, value := range values {
+		normalized := strings.ToLower(strings.Join(strings.Fields(value), " "))
+		if first, ok := seen[normalized]; ok {
+			repeated(i, first)
+			continue
+		}
+		seen[normalized] = i
+	}
+}
+
+// typeTexts is the text of a question's answer key shown to candidates, for the language check.
+func typeTexts(detail *suggest.SuggestExamQuestionResponseV2_Detail) []string {
+	texts := append([]string(nil), detail.GetMultiSelect().GetOptions()...)
+	for _, pair := range detail.GetMatching().GetPairs() {
+		texts = append(texts, pair.GetLeft(), pair.GetRight())
+	}
+	return append(texts, detail.GetOrdering().GetItems()...)
+}
+
+func countTypes(questions []*suggest.SuggestExamQuestionResponseV2_Quetion) map[string]int {
+	counts := make(map[string]int)
+	for _, question := range questions {
+		counts[question.GetType()]++
+	}
+	return counts
+}
+
+// orderedTypes lists the types of a map in QuestionTypes order.
+func orderedTypes[V any](types map[string]V) []string {
+	var ordered []string
+	for _, t := range QuestionTypes {
+		if _, ok := types[t]; ok {
+			ordered = append(ordered, t)
+		}
+	}
+	return ordered
+}
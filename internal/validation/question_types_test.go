@@ -0,0 +1,178 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func typed(id int32, questionType, text string, detail *suggest.SuggestExamQuestionResponseV2_Detail) *suggest.SuggestExamQuestionResponseV2_Quetion {
+	detail.Type = questionType
+	return &suggest.SuggestExamQuestionResponseV2_Quetion{Id: id, Text: text, Type: questionType, BloomLevel: BloomApply, EstimatedMinutes: 2, Detail: detail}
+}
+
+func TestTypeCounts(t *testing.T) {
+	assert.Equal(t, map[string]int{"MCQ": 8, "TRUE_FALSE": 2}, TypeCounts(map[string]int32{"MCQ": 4, "true_false": 1}, 10))
+	assert.Equal(t, map[string]int{"MCQ": 2, "MATCHING": 1, "ORDERING": 1}, TypeCounts(map[string]int32{"MCQ": 1, "MATCHING": 1, "ORDERING": 1}, 4))
+	assert.Equal(t, map[string]int{"MCQ": 3}, TypeCounts(map[string]int32{"MCQ": 1, "ORDERING": 0}, 3))
+	assert.Nil(t, TypeCounts(nil, 3))
+}
+
+func TestCheckQuestionType(t *testing.T) {
+	assert.NoError(t, CheckQuestionType("", nil))
+	assert.NoError(t, CheckQuestionType(QuestionTypeMixed, map[string]int32{"mcq": 2, "FILL_IN_BLANK": 1}))
+	assert.NoError(t, CheckQuestionType(QuestionTypeOrdering, nil))
+	assert.Error(t, CheckQuestionType("ESSAY", nil))
+	assert.Error(t, CheckQuestionType(QuestionTypeMixed, map[string]int32{"ESSAY": 1}))
+	assert.Error(t, CheckQuestionType(QuestionTypeMixed, map[string]int32{"MCQ": 0}))
+}
+
+func TestValidateQuestion_Types(t *testing.T) {
+	spec := ExamSpec{QuestionType: QuestionTypeMixed, TypeCounts: map[string]int{
+		QuestionTypeTrueFalse: 1, QuestionTypeMultiSelect: 1, QuestionTypeFillInBlank: 1, QuestionTypeMatching: 1, QuestionTypeOrdering: 1,
+	}}
+
+	tests := []struct {
+		name     string
+		question *suggest.SuggestExamQuestionResponseV2_Quetion
+		want     []string
+	}{
+		{
+			name: "true/false",
+			question: typed(1, QuestionTypeTrueFalse, "A nil map can be read from in Go.", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_TrueFalse{TrueFalse: &suggest.SuggestExamQuestionResponseV2_TrueFalseDetail{CorrectAnswer: true}},
+			}),
+		},
+		{
+			name:     "true/false without an answer",
+			question: typed(1, QuestionTypeTrueFalse, "A nil map can be read from in Go.", &suggest.SuggestExamQuestionResponseV2_Detail{}),
+			want:     []string{CodeMissingAnswer},
+		},
+		{
+			name: "multi-select",
+			question: typed(1, QuestionTypeMultiSelect, "Which of these are reference types in Go?", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_MultiSelect{MultiSelect: &suggest.SuggestExamQuestionResponseV2_MultiSelectDetail{
+					Options:        []string{"map", "slice", "array", "struct"},
+					CorrectOptions: []int32{0, 1},
+				}},
+			}),
+		},
+		{
+			name: "broken multi-select",
+			question: typed(1, QuestionTypeMultiSelect, "Which of these are reference types in Go?", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_MultiSelect{MultiSelect: &suggest.SuggestExamQuestionResponseV2_MultiSelectDetail{
+					Options:        []string{"map", "Map", "array"},
+					CorrectOptions: []int32{0, 0, 5},
+				}},
+			}),
+			want: []string{CodeOptionCount, CodeDuplicateOption, CodeDuplicateOption, CodeCorrectOption},
+		},
+		{
+			name: "fill in the blank",
+			question: typed(1, QuestionTypeFillInBlank, "The ___ statement runs a function when the surrounding function returns.", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_FillInBlank{FillInBlank: &suggest.SuggestExamQuestionResponseV2_FillInBlankDetail{AcceptedAnswers: []string{"defer"}}},
+			}),
+		},
+		{
+			name: "fill in the blank without a blank or answers",
+			question: typed(1, QuestionTypeFillInBlank, "Which statement runs a function when the surrounding function returns?", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_FillInBlank{FillInBlank: &suggest.SuggestExamQuestionResponseV2_FillInBlankDetail{AcceptedAnswers: []string{" "}}},
+			}),
+			want: []string{CodeBlankCount, CodeMissingAnswer},
+		},
+		{
+			name: "matching",
+			question: typed(1, QuestionTypeMatching, "Match each keyword with what it does.", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_Matching{Matching: &suggest.SuggestExamQuestionResponseV2_MatchingDetail{
+					Pairs: []*suggest.SuggestExamQuestionResponseV2_MatchingDetail_Pair{
+						{Left: "go", Right: "starts a goroutine"},
+						{Left: "defer", Right: "delays a call"},
+						{Left: "select", Right: "waits on channels"},
+					},
+				}},
+			}),
+		},
+		{
+			name: "broken matching",
+			question: typed(1, QuestionTypeMatching, "Match each keyword with what it does.", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_Matching{Matching: &suggest.SuggestExamQuestionResponseV2_MatchingDetail{
+					Pairs: []*suggest.SuggestExamQuestionResponseV2_MatchingDetail_Pair{
+						{Left: "go", Right: "starts a goroutine"},
+						{Left: "go", Right: ""},
+					},
+				}},
+			}),
+			want: []string{CodeOptionCount, CodeMissingAnswer, CodeDuplicateOption},
+		},
+		{
+			name: "ordering",
+			question: typed(1, QuestionTypeOrdering, "Put the steps of a TCP handshake in order.", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_Ordering{Ordering: &suggest.SuggestExamQuestionResponseV2_OrderingDetail{Items: []string{"SYN", "SYN-ACK", "ACK"}}},
+			}),
+		},
+		{
+			name: "ordering with a repeated item",
+			question: typed(1, QuestionTypeOrdering, "Put the steps of a TCP handshake in order.", &suggest.SuggestExamQuestionResponseV2_Detail{
+				Answer: &suggest.SuggestExamQuestionResponseV2_Detail_Ordering{Ordering: &suggest.SuggestExamQuestionResponseV2_OrderingDetail{Items: []string{"SYN", "ACK", "ack"}}},
+			}),
+			want: []string{CodeDuplicateOption},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, codes(ValidateQuestion(tt.question, spec)))
+		})
+	}
+}
+
+func TestValidateQuestion_Code(t *testing.T) {
+	code := func(language string, tests ...*suggest.SuggestExamQuestionResponseV2_CodeDetail_TestCase) *suggest.SuggestExamQuestionResponseV2_Quetion {
+		return typed(1, QuestionTypeCode, "Read two numbers and print their sum.", &suggest.SuggestExamQuestionResponseV2_Detail{
+			Answer: &suggest.SuggestExamQuestionResponseV2_Detail_Code{Code: &suggest.SuggestExamQuestionResponseV2_CodeDetail{
+				Language:          language,
+				StarterCode:       "a, b = map(int, input().split())\n",
+				ReferenceSolution: "a, b = map(int, input().split())\nprint(a + b)\n",
+				TestCases:         tests,
+			}},
+		})
+	}
+	visible := &suggest.SuggestExamQuestionResponseV2_CodeDetail_TestCase{Input: "1 2", ExpectedOutput: "3"}
+	hidden := &suggest.SuggestExamQuestionResponseV2_CodeDetail_TestCase{Input: "-1 1", ExpectedOutput: "0", Hidden: true}
+	spec := ExamSpecFromRequest(&suggest.SuggestExamQuestionRequest{QuestionType: QuestionTypeCode})
+	assert.Equal(t, DefaultCodeLanguage, spec.CodeLanguage)
+
+	assert.Empty(t, ValidateQuestion(code("Python", visible, hidden, hidden), spec))
+	assert.Equal(t, []string{CodeCodeLanguage, CodeTestCount}, codes(ValidateQuestion(code("javascript", visible, hidden), spec)))
+	assert.Equal(t, []string{CodeMissingAnswer}, codes(ValidateQuestion(typed(1, QuestionTypeCode, "Print the sum.", &suggest.SuggestExamQuestionResponseV2_Detail{}), spec)))
+	assert.Empty(t, ExamSpecFromRequest(&suggest.SuggestExamQuestionRequest{QuestionType: QuestionTypeMCQ, CodeLanguage: "go"}).CodeLanguage)
+}
+
+func TestValidateExam_TypeRatio(t *testing.T) {
+	options := []string{"A goroutine", "A channel", "A mutex", "A wait group"}
+	trueFalse := typed(3, QuestionTypeTrueFalse, "A nil map can be read from in Go.", &suggest.SuggestExamQuestionResponseV2_Detail{
+		Answer: &suggest.SuggestExamQuestionResponseV2_Detail_TrueFalse{TrueFalse: &suggest.SuggestExamQuestionResponseV2_TrueFalseDetail{}},
+	})
+	spec := ExamSpec{QuestionCount: 3, QuestionType: QuestionTypeMixed, TypeCounts: map[string]int{QuestionTypeMCQ: 1, QuestionTypeTrueFalse: 2}}
+
+	// One MCQ too many: the last one is asked to become the missing TRUE_FALSE question.
+	violations := ValidateExam([]*suggest.SuggestExamQuestionResponseV2_Quetion{
+		mcq(1, "Which primitive starts concurrent work in Go?", options, 0),
+		mcq(2, "Which primitive passes values between goroutines?", options, 1),
+		trueFalse,
+	}, spec)
+	assert.Equal(t, []string{CodeTypeCount, CodeTypeCount}, codes(violations))
+	assert.Equal(t, int32(2), violations[0].QuestionId)
+	assert.Contains(t, violations[0].Message, "rewrite this one as a TRUE_FALSE question")
+	assert.Equal(t, int32(0), violations[1].QuestionId)
+
+	// Types outside the ratio aren't allowed.
+	violations = ValidateExam([]*suggest.SuggestExamQuestionResponseV2_Quetion{
+		mcq(1, "Which primitive starts concurrent work in Go?", options, 0),
+		trueFalse,
+		typed(4, QuestionTypeLongAnswer, "Explain how the Go scheduler works.", &suggest.SuggestExamQuestionResponseV2_Detail{CorrectAnswer: "M:N scheduling"}),
+	}, spec)
+	assert.Equal(t, []string{CodeDisallowedType, CodeTypeCount}, codes(violations))
+	assert.Equal(t, map[string]int{QuestionTypeTrueFalse: 1}, MissingTypes([]*suggest.SuggestExamQuestionResponseV2_Quetion{trueFalse}, ExamSpec{TypeCounts: map[string]int{QuestionTypeTrueFalse: 2}}))
+}
@@ -0,0 +1,177 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	CodeMissingTranslation = "missing_translation"
+	CodeStructure          = "translation_structure"
+	CodeCodeSpan           = "code_span"
+	CodeGlossary           = "glossary_term"
+)
+
+// codeSpan matches the fenced blocks and inline `code` of a text, which translations keep
+// as they are.
+var codeSpan = regexp.MustCompile("(?s)```.*?```|`[^`\n]+`")
+
+// TranslationSpec is what a translation was asked for: the target language and the terms
+// that must stay untranslated.
+type TranslationSpec struct {
+	Language string
+	Glossary []string
+}
+
+// textField is one translatable string of a question, named like a violation field.
+type textField struct {
+	field, text string
+}
+
+// ValidateTranslation checks that every question of an exam survived translation with its
+// structure intact. translated[i] is the translation of original[i], nil when the model
+// left it out. Only the strings listed by textFields may change; ids, types, points,
+// answer indexes, images and code must be the same, code spans and glossary terms must
+// stay untranslated and the text must be in the target language.
+func ValidateTranslation(original, translated []*suggest.SuggestExamQuestionResponseV2_Quetion, spec TranslationSpec) []Violation {
+	var violations []Violation
+	for i, question := range original {
+		if i >= len(translated) || translated[i] == nil {
+			violations = append(violations, Violation{
+				QuestionId: question.GetId(),
+				Field:      "questions",
+				Code:       CodeMissingTranslation,
+				Message:    "question was not translated",
+			})
+			continue
+		}
+		violations = append(violations, validateQuestionTranslation(question, translated[i], spec)...)
+	}
+	return violations
+}
+
+// validateQuestionTranslation checks a single translated question against the original.
+func validateQuestionTranslation(original, translated *suggest.SuggestExamQuestionResponseV2_Quetion, spec TranslationSpec) []Violation {
+	var violations []Violation
+	add := func(field, code, format string, args ...interface{}) {
+		violations = append(violations, Violation{
+			QuestionId: original.GetId(),
+			Field:      field,
+			Code:       code,
+			Message:    fmt.Sprintf(format, args...),
+		})
+	}
+
+	switch {
+	case translated.GetId() != original.GetId():
+		add("id", CodeStructure, "id changed from %d to %d", original.GetId(), translated.GetId())
+	case translated.GetType() != original.GetType():
+		add("type", CodeStructure, "type changed from %s to %s", original.GetType(), translated.GetType())
+	case translated.GetPoints() != original.GetPoints():
+		add("points", CodeStructure, "points changed from %d to %d", original.GetPoints(), translated.GetPoints())
+	case translated.GetDetail().GetCorrectOption() != original.GetDetail().GetCorrectOption():
+		add("detail.correctOption", CodeStructure, "correctOption changed from %d to %d", original.GetDetail().GetCorrectOption(), translated.GetDetail().GetCorrectOption())
+	case !proto.Equal(skeleton(original), skeleton(translated)):
+		add("detail", CodeStructure, "only the text may change; keep every list the same length and ids, answer indexes, images, misconception tags and code exactly as they are")
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+
+	originalFields, translatedFields := textFields(original), textFields(translated)
+	for i, field := range originalFields {
+		text := translatedFields[i].text
+		if strings.TrimSpace(field.text) != "" && strings.TrimSpace(text) == "" {
+			add(field.field, CodeEmptyText, "translation is empty")
+			continue
+		}
+		for _, span := range codeSpan.FindAllString(field.text, -1) {
+			if !strings.Contains(text, span) {
+				add(field.field, CodeCodeSpan, "code %s must be kept exactly as it is", span)
+			}
+		}
+		for _, term := range spec.Glossary {
+			if term = strings.TrimSpace(term); term != "" && containsFold(field.text, term) && !containsFold(text, term) {
+				add(field.field, CodeGlossary, "glossary term %q must stay untranslated", term)
+			}
+		}
+	}
+	if len(blank.FindAllString(original.GetText(), -1)) != len(blank.FindAllString(translated.GetText(), -1)) {
+		add("text", CodeBlankCount, "keep every ___ blank of the question text")
+	}
+
+	if detected, ok := DetectLanguage(questionText(translated)); ok && !SameLanguage(spec.Language, detected) {
+		add("text", CodeLanguage, "question is written in %s, expected %s", detected, spec.Language)
+	}
+	return violations
+}
+
+// textFields lists the translatable strings of a question in a fixed order, so the fields
+// of an original and its translation line up.
+func textFields(question *suggest.SuggestExamQuestionResponseV2_Quetion) []textField {
+	detail := question.GetDetail()
+	fields := []textField{{"text", question.GetText()}}
+	list := func(name string, texts []string) {
+		for i, text := range texts {
+			fields = append(fields, textField{fmt.Sprintf("%s[%d]", name, i), text})
+		}
+	}
+
+	list("detail.options", detail.GetOptions())
+	fields = append(fields, textField{"detail.extraText", detail.GetExtraText()}, textField{"detail.correctAnswer", detail.GetCorrectAnswer()})
+	for i, explanation := range detail.GetOptionExplanations() {
+		fields = append(fields, textField{fmt.Sprintf("detail.optionExplanations[%d].explanation", i), explanation.GetExplanation()})
+	}
+	list("detail.multiSelect.options", detail.GetMultiSelect().GetOptions())
+	list("detail.fillInBlank.acceptedAnswers", detail.GetFillInBlank().GetAcceptedAnswers())
+	for i, pair := range detail.GetMatching().GetPairs() {
+		fields = append(fields,
+			textField{fmt.Sprintf("detail.matching.pairs[%d].left", i), pair.GetLeft()},
+			textField{fmt.Sprintf("detail.matching.pairs[%d].right", i), pair.GetRight()})
+	}
+	list("detail.ordering.items", detail.GetOrdering().GetItems())
+	return fields
+}
+
+// skeleton is a copy of the question with every translatable string blanked out, and
+// without the testId and the key, tag and code checks, which callers copy over from the
+// original. Lists keep their length.
+func skeleton(question *suggest.SuggestExamQuestionResponseV2_Quetion) *suggest.SuggestExamQuestionResponseV2_Quetion {
+	s := proto.Clone(question).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+	s.Text, s.TestId, s.KeyCheck, s.TagCheck, s.CodeCheck = "", "", nil, nil, nil
+	if s.Detail == nil {
+		return s
+	}
+	blankAll := func(texts []string) {
+		for i := range texts {
+			texts[i] = ""
+		}
+	}
+	detail := s.Detail
+	blankAll(detail.Options)
+	detail.ExtraText, detail.CorrectAnswer = "", ""
+	for _, explanation := range detail.OptionExplanations {
+		explanation.Explanation = ""
+	}
+	if multiSelect := detail.GetMultiSelect(); multiSelect != nil {
+		blankAll(multiSelect.Options)
+	}
+	if fillInBlank := detail.GetFillInBlank(); fillInBlank != nil {
+		blankAll(fillInBlank.AcceptedAnswers)
+	}
+	for _, pair := range detail.GetMatching().GetPairs() {
+		pair.Left, pair.Right = "", ""
+	}
+	if ordering := detail.GetOrdering(); ordering != nil {
+		blankAll(ordering.Items)
+	}
+	return s
+}
+
+func containsFold(text, term string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(term))
+}
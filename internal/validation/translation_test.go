@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"darius/pkg/proto/suggest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateTranslation(t *testing.T) {
+	original := mcq(1, "What does `len(nil)` return for a nil slice in a goroutine?", []string{"0", "nil", "It panics", "-1"}, 0)
+	original.Points = 2
+	translated := func(edit func(q *suggest.SuggestExamQuestionResponseV2_Quetion)) *suggest.SuggestExamQuestionResponseV2_Quetion {
+		q := proto.Clone(original).(*suggest.SuggestExamQuestionResponseV2_Quetion)
+		q.Text = "Hàm `len(nil)` trả về giá trị gì với một slice nil bên trong goroutine?"
+		q.Detail.Options[2] = "Chương trình bị lỗi"
+		for _, explanation := range q.Detail.OptionExplanations {
+			explanation.Explanation = "Giải thích bằng tiếng Việt"
+		}
+		if edit != nil {
+			edit(q)
+		}
+		return q
+	}
+	spec := TranslationSpec{Language: "Vietnamese", Glossary: []string{"goroutine"}}
+
+	tests := []struct {
+		name       string
+		translated *suggest.SuggestExamQuestionResponseV2_Quetion
+		want       []string
+	}{
+		{name: "valid", translated: translated(nil)},
+		{name: "missing", want: []string{CodeMissingTranslation}},
+		{
+			name:       "moved correct option",
+			translated: translated(func(q *suggest.SuggestExamQuestionResponseV2_Quetion) { q.Detail.CorrectOption = 2 }),
+			want:       []string{CodeStructure},
+		},
+		{
+			name:       "dropped option",
+			translated: translated(func(q *suggest.SuggestExamQuestionResponseV2_Quetion) { q.Detail.Options = q.Detail.Options[:3] }),
+			want:       []string{CodeStructure},
+		},
+		{
+			name: "translated code and glossary term",
+			translated: translated(func(q *suggest.SuggestExamQuestionResponseV2_Quetion) {
+				q.Text = "Hàm `độ_dài(nil)` trả về giá trị gì với một slice nil bên trong luồng nhẹ?"
+			}),
+			want: []string{CodeCodeSpan, CodeGlossary},
+		},
+		{
+			name: "still in English",
+			translated: translated(func(q *suggest.SuggestExamQuestionResponseV2_Quetion) {
+				q.Text = original.Text
+				q.Detail.Options[2] = ""
+				for _, explanation := range q.Detail.OptionExplanations {
+					explanation.Explanation = "An explanation in English"
+				}
+			}),
+			want: []string{CodeEmptyText, CodeLanguage},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := ValidateTranslation([]*suggest.SuggestExamQuestionResponseV2_Quetion{original}, []*suggest.SuggestExamQuestionResponseV2_Quetion{tt.translated}, spec)
+			assert.Equal(t, tt.want, codes(violations))
+		})
+	}
+}
@@ -0,0 +1,461 @@
+package managers
+
+import (
+	"context"
+	ctxdata "darius/ctx"
+	"darius/internal/errors"
+	databaseService "darius/internal/services/repo"
+	"darius/metrics"
+	"darius/models"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Handler runs one attempt of a job. The context carries the user id of the request
+// that enqueued it.
+type Handler func(ctx context.Context, job *models.Job) error
+
+type Manager interface {
+	Register(kind string, handler Handler)
+	Enqueue(ctx context.Context, kind, requestKey, payload string, opts ...EnqueueOption) (*models.Job, error)
+	Get(ctx context.Context, requestKey string) (*models.Job, error)
+	// Watch calls onUpdate with the current state of the job and again on every change,
+	// until the job succeeds or fails, onUpdate errors or ctx is done.
+	Watch(ctx context.Context, requestKey string, onUpdate func(*models.Job) error) error
+	Start(ctx context.Context)
+}
+
+// EnqueueOption sets optional fields of a job before it is stored.
+type EnqueueOption func(*models.Job)
+
+func WithCallback(url, secret string) EnqueueOption {
+	return func(job *models.Job) {
+		job.CallbackUrl = url
+		job.CallbackSecret = secret
+	}
+}
+
+type Config struct {
+	Workers      int           `mapstructure:"workers"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// StaleAfter is how long a job may stay running before it is considered abandoned by
+	// a worker that died, and is picked up again. Workers refresh the jobs they run a few
+	// times per StaleAfter, so a long job is not taken for abandoned.
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+	Webhook    WebhookConfig `mapstructure:"webhook"`
+}
+
+type manager struct {
+	config     Config
+	jobService databaseService.JobService
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+
+	queue chan string
+
+	// running holds the request keys this process is executing, which are never requeued.
+	runningMu sync.Mutex
+	running   map[string]bool
+
+	subMu       sync.Mutex
+	subscribers map[string][]chan models.Job
+
+	notifier *notifier
+}
+
+func NewManager(jobService databaseService.JobService, config Config) Manager {
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 1
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = 10 * time.Second
+	}
+	if config.StaleAfter <= 0 {
+		config.StaleAfter = 10 * time.Minute
+	}
+
+	return &manager{
+		config:      config,
+		jobService:  jobService,
+		handlers:    make(map[string]Handler),
+		queue:       make(chan string, config.Workers*4),
+		running:     make(map[string]bool),
+		subscribers: make(map[string][]chan models.Job),
+		notifier:    newNotifier(config.Webhook),
+	}
+}
+
+func (m *manager) Register(kind string, handler Handler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[kind] = handler
+}
+
+// Enqueue persists a pending job and wakes a worker. The job survives a restart even if
+// no worker got to it. A callback url that doesn't point to a public address fails with
+// ErrInvalidInput, and the callback secret is stored encrypted.
+func (m *manager) Enqueue(ctx context.Context, kind, requestKey, payload string, opts ...EnqueueOption) (*models.Job, error) {
+	userId, _ := ctxdata.GetUserIdFromContext(ctx)
+	job := &models.Job{
+		RequestKey: requestKey,
+		Kind:       kind,
+		UserId:     userId,
+		Payload:    payload,
+		Status:     models.JobStatusPending,
+	}
+	for _, opt := range opts {
+		opt(job)
+	}
+	if job.CallbackUrl != "" {
+		if err := m.notifier.checkUrl(ctx, job.CallbackUrl); err != nil {
+			log.Printf("[Enqueue] Rejecting callback of job %s: %v", requestKey, err)
+			return nil, errors.Error(errors.ErrInvalidInput)
+		}
+		secret, err := m.notifier.seal(job.CallbackSecret)
+		if err != nil {
+			log.Printf("[Enqueue] Error sealing callback secret of job %s: %v", requestKey, err)
+			return nil, errors.Error(errors.ErrGeneral)
+		}
+		job.CallbackSecret = secret
+	}
+	if err := m.jobService.CreateJob(ctx, job); err != nil {
+		log.Printf("[Enqueue] Error creating job %s: %v", requestKey, err)
+		return nil, err
+	}
+
+	select {
+	case m.queue <- requestKey:
+	default:
+		// Every worker is busy, the poller will pick the job up.
+	}
+	return job, nil
+}
+
+func (m *manager) Get(ctx context.Context, requestKey string) (*models.Job, error) {
+	return m.jobService.GetJob(ctx, requestKey)
+}
+
+// Start runs the workers and the poller until ctx is done. The poller resumes pending
+// jobs, retries and jobs abandoned by a previous process.
+func (m *manager) Start(ctx context.Context) {
+	for i := 0; i < m.config.Workers; i++ {
+		go m.work(ctx)
+	}
+	go m.poll(ctx)
+}
+
+func (m *manager) poll(ctx context.Context) {
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		m.requeue(ctx, models.JobStatusPending, time.Now())
+		m.requeue(ctx, models.JobStatusRunning, time.Now().Add(-m.config.StaleAfter))
+		m.resumeCallbacks(ctx, time.Now().Add(-m.config.StaleAfter))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *manager) requeue(ctx context.Context, status string, updatedBefore time.Time) {
+	jobs, err := m.jobService.ListJobs(ctx, status, updatedBefore)
+	if err != nil {
+		log.Printf("[Job] Error listing %s jobs: %v", status, err)
+		return
+	}
+	for _, job := range jobs {
+		if m.isRunning(job.RequestKey) {
+			continue
+		}
+		select {
+		case m.queue <- job.RequestKey:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *manager) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case requestKey := <-m.queue:
+			m.run(ctx, requestKey)
+		}
+	}
+}
+
+func (m *manager) run(ctx context.Context, requestKey string) {
+	job, err := m.jobService.GetJob(ctx, requestKey)
+	if err != nil {
+		log.Printf("[Job] Error loading job %s: %v", requestKey, err)
+		return
+	}
+	if isFinished(job) {
+		return
+	}
+	// A running job is only taken over once its worker stopped refreshing it.
+	if job.Status == models.JobStatusRunning && time.Since(job.UpdatedAt) < m.config.StaleAfter {
+		return
+	}
+	if !m.startRunning(requestKey) {
+		return
+	}
+	defer m.stopRunning(requestKey)
+
+	claimed, err := m.jobService.ClaimJob(ctx, job)
+	if err != nil || !claimed {
+		// Another worker got there first.
+		return
+	}
+	// Re-read to get the attempt count and timestamps written by the claim.
+	if job, err = m.jobService.GetJob(ctx, requestKey); err != nil {
+		log.Printf("[Job] Error reloading job %s: %v", requestKey, err)
+		return
+	}
+	m.publish(job)
+
+	stopHeartbeat := m.heartbeat(ctx, job)
+	err = m.execute(ctx, job)
+	stopHeartbeat()
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		job.Status = models.JobStatusSucceeded
+		job.ErrorCode = ""
+		job.ErrorMessage = ""
+		job.FinishedAt = &now
+	case isRetryable(err) && job.Attempts < m.config.MaxAttempts:
+		log.Printf("[Job] %s attempt %d/%d failed, retrying: %v", requestKey, job.Attempts, m.config.MaxAttempts, err)
+		job.Status = models.JobStatusPending
+		job.ErrorCode = errors.GetHTTPStatusCode(err)
+		job.ErrorMessage = err.Error()
+	default:
+		log.Printf("[Job] %s failed after %d attempts: %v", requestKey, job.Attempts, err)
+		job.Status = models.JobStatusFailed
+		job.ErrorCode = errors.GetHTTPStatusCode(err)
+		job.ErrorMessage = err.Error()
+		job.FinishedAt = &now
+	}
+	metrics.JobCounter.WithLabelValues(job.Kind, job.Status).Inc()
+	if isFinished(job) && job.CallbackUrl != "" {
+		job.CallbackStatus = models.CallbackStatusPending
+	}
+
+	if err := m.jobService.UpdateJob(ctx, job); err != nil {
+		log.Printf("[Job] Error saving job %s: %v", requestKey, err)
+	}
+	m.publish(job)
+
+	if job.CallbackStatus == models.CallbackStatusPending {
+		go m.deliver(ctx, job)
+	}
+}
+
+func (m *manager) isRunning(requestKey string) bool {
+	m.runningMu.Lock()
+	defer m.runningMu.Unlock()
+	return m.running[requestKey]
+}
+
+// startRunning marks a job as executed by this process. It reports false when a worker of
+// this process already runs it.
+func (m *manager) startRunning(requestKey string) bool {
+	m.runningMu.Lock()
+	defer m.runningMu.Unlock()
+	if m.running[requestKey] {
+		return false
+	}
+	m.running[requestKey] = true
+	return true
+}
+
+func (m *manager) stopRunning(requestKey string) {
+	m.runningMu.Lock()
+	defer m.runningMu.Unlock()
+	delete(m.running, requestKey)
+}
+
+// heartbeat keeps the updated_at of a running job fresh until the returned function is
+// called, so workers of other instances don't resume it while it is still being executed.
+func (m *manager) heartbeat(ctx context.Context, job *models.Job) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		interval := m.config.StaleAfter / 3
+		if interval <= 0 {
+			interval = m.config.StaleAfter
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := m.jobService.TouchJob(ctx, job); err != nil {
+					log.Printf("[Job] Error refreshing running job %s: %v", job.RequestKey, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// resumeCallbacks delivers the callbacks of finished jobs whose delivery was cut short, long
+// enough ago that it can't still be in progress.
+func (m *manager) resumeCallbacks(ctx context.Context, updatedBefore time.Time) {
+	jobs, err := m.jobService.ListPendingCallbacks(ctx, updatedBefore)
+	if err != nil {
+		log.Printf("[Job] Error listing pending callbacks: %v", err)
+		return
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		claimed, err := m.jobService.ClaimCallback(ctx, job)
+		if err != nil || !claimed {
+			// Another instance got there first.
+			continue
+		}
+		go m.deliver(ctx, job)
+	}
+}
+
+// deliver posts the final state of a job to its callback url and records whether it got
+// through. A delivery interrupted by shutdown stays pending for the next process.
+func (m *manager) deliver(ctx context.Context, job *models.Job) {
+	err := m.notifier.notify(ctx, job)
+	if ctx.Err() != nil {
+		return
+	}
+	job.CallbackStatus = models.CallbackStatusDelivered
+	if err != nil {
+		job.CallbackStatus = models.CallbackStatusFailed
+	}
+	if err := m.jobService.UpdateJob(ctx, job); err != nil {
+		log.Printf("[Job] Error saving callback status of job %s: %v", job.RequestKey, err)
+	}
+}
+
+func (m *manager) execute(ctx context.Context, job *models.Job) (err error) {
+	m.mu.RLock()
+	handler, ok := m.handlers[job.Kind]
+	m.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("no handler registered for job kind %q", job.Kind)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[Job] %s panicked: %v", job.RequestKey, r)
+			err = errors.Error(errors.ErrGeneral)
+		}
+	}()
+	return handler(ctxdata.WithUserId(ctx, job.UserId), job)
+}
+
+func (m *manager) Watch(ctx context.Context, requestKey string, onUpdate func(*models.Job) error) error {
+	updates, unsubscribe := m.subscribe(requestKey)
+	defer unsubscribe()
+
+	// Transitions made by workers of other instances only show up in the database.
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	var last *models.Job
+	emit := func(job *models.Job) error {
+		if last != nil && last.Status == job.Status && last.Attempts == job.Attempts {
+			return nil
+		}
+		last = job
+		return onUpdate(job)
+	}
+
+	job, err := m.jobService.GetJob(ctx, requestKey)
+	if err != nil {
+		return err
+	}
+	for {
+		if err := emit(job); err != nil {
+			return err
+		}
+		if isFinished(job) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update := <-updates:
+			job = &update
+		case <-ticker.C:
+			if job, err = m.jobService.GetJob(ctx, requestKey); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *manager) subscribe(requestKey string) (<-chan models.Job, func()) {
+	updates := make(chan models.Job, 8)
+
+	m.subMu.Lock()
+	m.subscribers[requestKey] = append(m.subscribers[requestKey], updates)
+	m.subMu.Unlock()
+
+	return updates, func() {
+		m.subMu.Lock()
+		defer m.subMu.Unlock()
+		subscribers := m.subscribers[requestKey]
+		for i, subscriber := range subscribers {
+			if subscriber == updates {
+				subscribers = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		if len(subscribers) == 0 {
+			delete(m.subscribers, requestKey)
+		} else {
+			m.subscribers[requestKey] = subscribers
+		}
+	}
+}
+
+func (m *manager) publish(job *models.Job) {
+	m.subMu.Lock()
+	defer m.subMu.Unlock()
+	for _, subscriber := range m.subscribers[job.RequestKey] {
+		select {
+		case subscriber <- *job:
+		default:
+			// A slow watcher catches up on its next database poll.
+		}
+	}
+}
+
+func isFinished(job *models.Job) bool {
+	return job.Status == models.JobStatusSucceeded || job.Status == models.JobStatusFailed
+}
+
+// isRetryable reports whether a failure is worth another attempt. Outages are, while bad
+// input, parsing and billing failures would fail the same way again.
+func isRetryable(err error) bool {
+	return errors.GetHTTPStatusCode(err) == "503"
+}
@@ -0,0 +1,353 @@
+package managers
+
+import (
+	"context"
+	ctxdata "darius/ctx"
+	"darius/internal/errors"
+	"darius/models"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockJobService struct {
+	mu   sync.Mutex
+	jobs map[string]models.Job
+}
+
+func newMockJobService() *mockJobService {
+	return &mockJobService{jobs: make(map[string]models.Job)}
+}
+
+func (m *mockJobService) CreateJob(ctx context.Context, job *models.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job.ID = uint(len(m.jobs) + 1)
+	job.CreatedAt = time.Now()
+	job.UpdatedAt = job.CreatedAt
+	m.jobs[job.RequestKey] = *job
+	return nil
+}
+
+func (m *mockJobService) GetJob(ctx context.Context, requestKey string) (*models.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[requestKey]
+	if !ok {
+		return nil, errors.Error(errors.ErrNotFound)
+	}
+	return &job, nil
+}
+
+func (m *mockJobService) ListJobs(ctx context.Context, status string, updatedBefore time.Time) ([]models.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var jobs []models.Job
+	for _, job := range m.jobs {
+		if job.Status == status && job.UpdatedAt.Before(updatedBefore) {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (m *mockJobService) ClaimJob(ctx context.Context, job *models.Job) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := m.jobs[job.RequestKey]
+	if stored.Status != job.Status || !stored.UpdatedAt.Equal(job.UpdatedAt) {
+		return false, nil
+	}
+	now := time.Now()
+	stored.Status = models.JobStatusRunning
+	stored.Attempts++
+	stored.StartedAt = &now
+	stored.UpdatedAt = now
+	m.jobs[job.RequestKey] = stored
+	return true, nil
+}
+
+func (m *mockJobService) TouchJob(ctx context.Context, job *models.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := m.jobs[job.RequestKey]
+	if stored.Status == models.JobStatusRunning {
+		stored.UpdatedAt = time.Now()
+		m.jobs[job.RequestKey] = stored
+	}
+	return nil
+}
+
+func (m *mockJobService) ListPendingCallbacks(ctx context.Context, updatedBefore time.Time) ([]models.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var jobs []models.Job
+	for _, job := range m.jobs {
+		if job.CallbackStatus == models.CallbackStatusPending && job.UpdatedAt.Before(updatedBefore) {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (m *mockJobService) ClaimCallback(ctx context.Context, job *models.Job) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := m.jobs[job.RequestKey]
+	if stored.CallbackStatus != models.CallbackStatusPending || !stored.UpdatedAt.Equal(job.UpdatedAt) {
+		return false, nil
+	}
+	stored.UpdatedAt = time.Now()
+	m.jobs[job.RequestKey] = stored
+	return true, nil
+}
+
+func (m *mockJobService) UpdateJob(ctx context.Context, job *models.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job.UpdatedAt = time.Now()
+	m.jobs[job.RequestKey] = *job
+	return nil
+}
+
+// testSecretKey is a webhook secret key for tests, 32 zero bytes.
+var testSecretKey = base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+func waitForStatus(t *testing.T, m Manager, requestKey, status string) *models.Job {
+	var job *models.Job
+	assert.Eventually(t, func() bool {
+		job, _ = m.Get(context.Background(), requestKey)
+		return job != nil && job.Status == status
+	}, time.Second, 5*time.Millisecond)
+	return job
+}
+
+func TestManager_RunsJobWithUserContext(t *testing.T) {
+	m := NewManager(newMockJobService(), Config{Workers: 1, PollInterval: 10 * time.Millisecond})
+
+	var userId string
+	m.Register("test_kind", func(ctx context.Context, job *models.Job) error {
+		userId, _ = ctxdata.GetUserIdFromContext(ctx)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	userCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "42"))
+	_, err := m.Enqueue(userCtx, "test_kind", "key-1", "{}")
+	assert.NoError(t, err)
+
+	job := waitForStatus(t, m, "key-1", models.JobStatusSucceeded)
+	assert.Equal(t, 1, job.Attempts)
+	assert.NotNil(t, job.FinishedAt)
+	assert.Equal(t, "42", userId)
+}
+
+func TestManager_RetriesOutagesOnly(t *testing.T) {
+	m := NewManager(newMockJobService(), Config{Workers: 1, MaxAttempts: 3, PollInterval: 10 * time.Millisecond})
+
+	calls := map[string]int{}
+	var mu sync.Mutex
+	m.Register("test_kind", func(ctx context.Context, job *models.Job) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[job.RequestKey]++
+		if job.RequestKey == "charge" {
+			return errors.Error(errors.ErrChargingFailed)
+		}
+		if calls[job.RequestKey] < 2 {
+			return errors.Error(errors.ErrNetworkConnection)
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	m.Enqueue(context.Background(), "test_kind", "outage", "{}")
+	m.Enqueue(context.Background(), "test_kind", "charge", "{}")
+
+	job := waitForStatus(t, m, "outage", models.JobStatusSucceeded)
+	assert.Equal(t, 2, job.Attempts)
+
+	job = waitForStatus(t, m, "charge", models.JobStatusFailed)
+	assert.Equal(t, 1, job.Attempts)
+	assert.Equal(t, "402", job.ErrorCode)
+	assert.Equal(t, errors.ErrChargingFailed, job.ErrorMessage)
+}
+
+func TestManager_ResumesAbandonedJobs(t *testing.T) {
+	jobService := newMockJobService()
+	jobService.CreateJob(context.Background(), &models.Job{RequestKey: "pending", Kind: "test_kind", Status: models.JobStatusPending})
+	jobService.CreateJob(context.Background(), &models.Job{RequestKey: "running", Kind: "test_kind", Status: models.JobStatusRunning, Attempts: 1})
+
+	m := NewManager(jobService, Config{Workers: 2, MaxAttempts: 3, PollInterval: 10 * time.Millisecond, StaleAfter: time.Nanosecond})
+	m.Register("test_kind", func(ctx context.Context, job *models.Job) error { return nil })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	waitForStatus(t, m, "pending", models.JobStatusSucceeded)
+	job := waitForStatus(t, m, "running", models.JobStatusSucceeded)
+	assert.Equal(t, 2, job.Attempts)
+}
+
+func TestManager_KeepsLongJobsRunningOnce(t *testing.T) {
+	jobService := newMockJobService()
+	m := NewManager(jobService, Config{Workers: 2, PollInterval: 5 * time.Millisecond, StaleAfter: 30 * time.Millisecond})
+
+	var mu sync.Mutex
+	runs := 0
+	var stale []models.Job
+	m.Register("test_kind", func(ctx context.Context, job *models.Job) error {
+		mu.Lock()
+		runs++
+		mu.Unlock()
+		// Several times StaleAfter, without a heartbeat the job would be resumed.
+		time.Sleep(150 * time.Millisecond)
+		// Other instances only see the database, where the job must look alive.
+		jobs, _ := jobService.ListJobs(ctx, models.JobStatusRunning, time.Now().Add(-30*time.Millisecond))
+		mu.Lock()
+		stale = jobs
+		mu.Unlock()
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	_, err := m.Enqueue(context.Background(), "test_kind", "key-1", "{}")
+	assert.NoError(t, err)
+	job := waitForStatus(t, m, "key-1", models.JobStatusSucceeded)
+	assert.Equal(t, 1, job.Attempts)
+	mu.Lock()
+	assert.Equal(t, 1, runs)
+	assert.Empty(t, stale)
+	mu.Unlock()
+}
+
+func TestManager_WatchAndCallback(t *testing.T) {
+	callbacks := make(chan *http.Request, 1)
+	signed := make(chan bool, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		signed <- Sign("secret", body) == r.Header.Get(SignatureHeader)
+		callbacks <- r
+	}))
+	defer server.Close()
+
+	jobs := newMockJobService()
+	m := NewManager(jobs, Config{Workers: 1, PollInterval: 10 * time.Millisecond, Webhook: WebhookConfig{AllowPrivate: true, SecretKey: testSecretKey}})
+	release := make(chan struct{})
+	m.Register("test_kind", func(ctx context.Context, job *models.Job) error {
+		<-release
+		return errors.Error(errors.ErrChargingFailed)
+	})
+
+	_, err := m.Enqueue(context.Background(), "test_kind", "key-1", "{}", WithCallback(server.URL, "secret"))
+	assert.NoError(t, err)
+	assert.NotContains(t, jobs.jobs["key-1"].CallbackSecret, "secret", "the secret is stored encrypted")
+
+	var statuses []string
+	done := make(chan error)
+	go func() {
+		done <- m.Watch(context.Background(), "key-1", func(job *models.Job) error {
+			statuses = append(statuses, job.Status)
+			if job.Status == models.JobStatusRunning {
+				close(release)
+			}
+			return nil
+		})
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	assert.NoError(t, <-done)
+	// The watcher may start after a worker already picked the job up.
+	assert.Equal(t, []string{models.JobStatusRunning, models.JobStatusFailed}, statuses[len(statuses)-2:])
+
+	select {
+	case r := <-callbacks:
+		assert.Equal(t, "job.failed", r.Header.Get(EventHeader))
+		assert.True(t, <-signed, "the callback is signed with the secret it was enqueued with")
+	case <-time.After(time.Second):
+		t.Fatal("callback was not delivered")
+	}
+	assert.Eventually(t, func() bool {
+		job, _ := m.Get(context.Background(), "key-1")
+		return job.CallbackStatus == models.CallbackStatusDelivered
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestManager_ResumesPendingCallback(t *testing.T) {
+	callbacks := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callbacks <- r
+	}))
+	defer server.Close()
+
+	// The process that finished the job stopped before delivering its callback.
+	jobs := newMockJobService()
+	finished := time.Now().Add(-time.Hour)
+	jobs.jobs["key-1"] = models.Job{
+		ID:             1,
+		RequestKey:     "key-1",
+		Status:         models.JobStatusSucceeded,
+		FinishedAt:     &finished,
+		CallbackUrl:    server.URL,
+		CallbackStatus: models.CallbackStatusPending,
+		UpdatedAt:      finished,
+	}
+
+	m := NewManager(jobs, Config{Workers: 1, PollInterval: 10 * time.Millisecond, Webhook: WebhookConfig{AllowPrivate: true}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	select {
+	case r := <-callbacks:
+		assert.Equal(t, "job.succeeded", r.Header.Get(EventHeader))
+	case <-time.After(time.Second):
+		t.Fatal("callback was not resumed")
+	}
+	assert.Eventually(t, func() bool {
+		job, _ := m.Get(context.Background(), "key-1")
+		return job.CallbackStatus == models.CallbackStatusDelivered
+	}, time.Second, 5*time.Millisecond)
+	assert.Len(t, callbacks, 0, "the callback is delivered once")
+}
+
+func TestManager_RejectsInternalCallback(t *testing.T) {
+	jobs := newMockJobService()
+	m := NewManager(jobs, Config{})
+
+	_, err := m.Enqueue(context.Background(), "test_kind", "key-1", "{}", WithCallback("http://127.0.0.1:8080/hook", "secret"))
+	assert.EqualError(t, err, errors.ErrInvalidInput)
+	assert.Empty(t, jobs.jobs)
+}
+
+func TestManager_RefusesSecretWithoutKey(t *testing.T) {
+	jobs := newMockJobService()
+	m := NewManager(jobs, Config{Webhook: WebhookConfig{AllowPrivate: true}})
+
+	_, err := m.Enqueue(context.Background(), "test_kind", "key-1", "{}", WithCallback("http://127.0.0.1:8080/hook", "secret"))
+	assert.EqualError(t, err, errors.ErrGeneral)
+	assert.Empty(t, jobs.jobs)
+
+	_, err = m.Enqueue(context.Background(), "test_kind", "key-2", "{}", WithCallback("http://127.0.0.1:8080/hook", ""))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,270 @@
+package managers
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"darius/models"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Darius-Signature"
+	EventHeader     = "X-Darius-Event"
+)
+
+// sealedPrefix marks a callback secret encrypted with the webhook secret key. Secrets stored
+// before they were encrypted don't have it.
+const sealedPrefix = "sealed:"
+
+var errNoSecretKey = stdErrors.New("jobs.webhook.secret_key is not configured, callback secrets can't be stored")
+
+type WebhookConfig struct {
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	BaseDelay   time.Duration `mapstructure:"base_delay"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+	// AllowPrivate lets callbacks reach loopback, private and link-local addresses, for
+	// receivers running next to a development instance.
+	AllowPrivate bool `mapstructure:"allow_private"`
+	// SecretKey is the base64 AES-256 key callback secrets are encrypted with before they
+	// are stored on the job. Callbacks with a secret are refused without it.
+	SecretKey string `mapstructure:"secret_key"`
+}
+
+// WebhookPayload is the body POSTed to the callback url of a finished job.
+type WebhookPayload struct {
+	RequestKey   string     `json:"requestKey"`
+	Kind         string     `json:"kind"`
+	Status       string     `json:"status"`
+	ErrorCode    string     `json:"errorCode,omitempty"`
+	ErrorMessage string     `json:"errorMessage,omitempty"`
+	Attempts     int        `json:"attempts"`
+	FinishedAt   *time.Time `json:"finishedAt,omitempty"`
+	Timestamp    time.Time  `json:"timestamp"`
+}
+
+type notifier struct {
+	config     WebhookConfig
+	httpClient *http.Client
+	secrets    cipher.AEAD // nil without a secret key
+}
+
+func newNotifier(config WebhookConfig) *notifier {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.BaseDelay <= 0 {
+		config.BaseDelay = time.Second
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	// Addresses are checked again when connecting, so a host that resolves to a private
+	// address after its callback was accepted, or a redirect, can't reach internal services.
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivate {
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternalAddress(ip) {
+				return fmt.Errorf("callback address %s is not public", host)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	secrets, err := newSecretCipher(config.SecretKey)
+	if err != nil {
+		log.Printf("[Webhook] Invalid secret key, callbacks with a secret will be refused: %v", err)
+	}
+	return &notifier{
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout, Transport: transport},
+		secrets:    secrets,
+	}
+}
+
+func newSecretCipher(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("secret key is %d bytes, expected 32", len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts a callback secret to be stored on its job.
+func (n *notifier) seal(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	if n.secrets == nil {
+		return "", errNoSecretKey
+	}
+	nonce := make([]byte, n.secrets.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := n.secrets.Seal(nonce, nonce, []byte(secret), nil)
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts a callback secret stored by seal.
+func (n *notifier) open(stored string) (string, error) {
+	if !strings.HasPrefix(stored, sealedPrefix) {
+		return stored, nil
+	}
+	if n.secrets == nil {
+		return "", errNoSecretKey
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, sealedPrefix))
+	if err != nil {
+		return "", err
+	}
+	size := n.secrets.NonceSize()
+	if len(sealed) < size {
+		return "", stdErrors.New("sealed callback secret is too short")
+	}
+	secret, err := n.secrets.Open(nil, sealed[:size], sealed[size:], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// checkUrl makes sure a callback url is http(s) and, unless private addresses are allowed,
+// that every address its host resolves to is public.
+func (n *notifier) checkUrl(ctx context.Context, rawUrl string) error {
+	callbackUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+	if (callbackUrl.Scheme != "http" && callbackUrl.Scheme != "https") || callbackUrl.Hostname() == "" {
+		return fmt.Errorf("callback url %q is not an http(s) url", rawUrl)
+	}
+	if n.config.AllowPrivate {
+		return nil
+	}
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, callbackUrl.Hostname())
+	if err != nil {
+		return err
+	}
+	for _, address := range addresses {
+		if isInternalAddress(address.IP) {
+			return fmt.Errorf("callback host %s resolves to %s, which is not public", callbackUrl.Hostname(), address.IP)
+		}
+	}
+	return nil
+}
+
+// isInternalAddress reports whether the address belongs to this host or its network
+// rather than to the internet.
+func isInternalAddress(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// Sign returns the signature header value of a webhook body, "sha256=" followed by the
+// hex HMAC-SHA256 of the body keyed with the callback secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// notify delivers the final state of a job, retrying with exponential backoff until the
+// receiver answers 2xx or the attempts run out.
+func (n *notifier) notify(ctx context.Context, job *models.Job) error {
+	secret, err := n.open(job.CallbackSecret)
+	if err != nil {
+		log.Printf("[Webhook] Can't read the callback secret of %s: %v", job.RequestKey, err)
+		return err
+	}
+	body, err := json.Marshal(&WebhookPayload{
+		RequestKey:   job.RequestKey,
+		Kind:         job.Kind,
+		Status:       job.Status,
+		ErrorCode:    job.ErrorCode,
+		ErrorMessage: job.ErrorMessage,
+		Attempts:     job.Attempts,
+		FinishedAt:   job.FinishedAt,
+		Timestamp:    time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	delay := n.config.BaseDelay
+	for attempt := 1; ; attempt++ {
+		err = n.post(ctx, job, secret, body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= n.config.MaxAttempts {
+			log.Printf("[Webhook] Giving up on %s after %d attempts: %v", job.RequestKey, attempt, err)
+			return err
+		}
+
+		log.Printf("[Webhook] Attempt %d/%d for %s failed, retrying in %v: %v", attempt, n.config.MaxAttempts, job.RequestKey, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		delay *= 2
+	}
+}
+
+func (n *notifier) post(ctx context.Context, job *models.Job, secret string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.CallbackUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, "job."+job.Status)
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("callback answered with status code: %d", resp.StatusCode)
+	}
+	return nil
+}
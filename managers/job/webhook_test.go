@@ -0,0 +1,83 @@
+package managers
+
+import (
+	"context"
+	"darius/models"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifier_SignsAndRetries(t *testing.T) {
+	var mu sync.Mutex
+	attempts := 0
+	var payload WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, Sign("secret", body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, "job.succeeded", r.Header.Get(EventHeader))
+		json.Unmarshal(body, &payload)
+	}))
+	defer server.Close()
+
+	n := newNotifier(WebhookConfig{MaxAttempts: 3, BaseDelay: time.Millisecond, AllowPrivate: true})
+	err := n.notify(context.Background(), &models.Job{
+		RequestKey:     "key-1",
+		Kind:           "test_kind",
+		Status:         models.JobStatusSucceeded,
+		Attempts:       1,
+		CallbackUrl:    server.URL,
+		CallbackSecret: "secret",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "key-1", payload.RequestKey)
+	assert.Equal(t, models.JobStatusSucceeded, payload.Status)
+}
+
+func TestNotifier_GivesUp(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n := newNotifier(WebhookConfig{MaxAttempts: 2, BaseDelay: time.Millisecond, AllowPrivate: true})
+	err := n.notify(context.Background(), &models.Job{RequestKey: "key-1", Status: models.JobStatusFailed, CallbackUrl: server.URL})
+	assert.Error(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestNotifier_RefusesInternalAddresses(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+	}))
+	defer server.Close()
+
+	n := newNotifier(WebhookConfig{MaxAttempts: 1})
+	for _, callbackUrl := range []string{server.URL, "http://localhost/hook", "http://10.0.0.1/hook", "http://169.254.169.254/latest", "http://[::1]/hook", "ftp://example.com/hook", "/hook"} {
+		assert.Error(t, n.checkUrl(context.Background(), callbackUrl), callbackUrl)
+	}
+	assert.NoError(t, n.checkUrl(context.Background(), "https://93.184.216.34/hook"))
+
+	// A host that only resolves to an internal address once the job is done is refused too.
+	err := n.notify(context.Background(), &models.Job{RequestKey: "key-1", Status: models.JobStatusSucceeded, CallbackUrl: server.URL})
+	assert.Error(t, err)
+	assert.Zero(t, attempts)
+}
@@ -0,0 +1,116 @@
+package managers
+
+import (
+	"context"
+	"darius/internal/constants"
+	"darius/internal/llmjson"
+	llm_grpc "darius/internal/services/llm-grpc"
+	"darius/metrics"
+	arceus "darius/pkg/proto/deps/arceus"
+	"log"
+	"regexp"
+	"strings"
+)
+
+// Overlap bounds for stitching. A model asked to continue often repeats the tail of its
+// last answer; shorter matches than minOverlap are too likely to be coincidence.
+const (
+	minOverlap = 8
+	maxOverlap = 2000
+)
+
+const continuePrompt = `Your previous answer was cut off because it was too long. Continue it exactly where it stopped, starting with the very next character. Do not repeat anything you already wrote, do not start the JSON over, and do not add any explanation or markdown.`
+
+var leadingFence = regexp.MustCompile("^\\s*```[A-Za-z0-9_-]*[ \t]*\n")
+
+// continueTruncated keeps asking the model to carry on, on the conversation that produced
+// resp, while its answer is cut off, and stitches the pieces into one response. onChunk,
+// when set, receives every stitched piece. A failed continuation leaves the answer as far
+// as it got.
+func (m *manager) continueTruncated(ctx context.Context, entryPoint, provider string, llmReq *arceus.GenerateTextRequest, resp *arceus.GenerateTextResponse, route constants.LLMRoute, onChunk func(string) error) (*arceus.GenerateTextResponse, error) {
+	maxContinuations := 0
+	if route.MaxContinuations != nil {
+		maxContinuations = *route.MaxContinuations
+	}
+
+	content := resp.GetContent()
+	usage := &arceus.Usage{
+		PromptTokens:     resp.GetUsage().GetPromptTokens(),
+		CompletionTokens: resp.GetUsage().GetCompletionTokens(),
+		TotalTokens:      resp.GetUsage().GetTotalTokens(),
+	}
+	last := resp
+
+	for round := 0; round < maxContinuations && needsContinuation(last, content); round++ {
+		metrics.LLMContinuationCounter.WithLabelValues(entryPoint, provider, llmReq.GetModel()).Inc()
+		log.Printf("[Generate] %s/%s answer is truncated (finish reason %q), continuing %d/%d", provider, llmReq.GetModel(), last.GetFinishReason(), round+1, maxContinuations)
+
+		conversationId := last.GetConversationId()
+		next, err := m.attemptWithTimeout(ctx, provider, &arceus.GenerateTextRequest{
+			Content:        continuePrompt,
+			Model:          llmReq.GetModel(),
+			ConversationId: &conversationId,
+			Temperature:    llmReq.Temperature,
+			MaxTokens:      llmReq.MaxTokens,
+		}, route.Timeout, func(ctx context.Context, provider string, req *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+			return m.llmService.Generate(ctx, provider, req)
+		})
+		if err != nil {
+			log.Printf("[Generate] Error continuing truncated answer: %v", err)
+			break
+		}
+
+		usage.PromptTokens += next.GetUsage().GetPromptTokens()
+		usage.CompletionTokens += next.GetUsage().GetCompletionTokens()
+		usage.TotalTokens += next.GetUsage().GetTotalTokens()
+		last = next
+
+		piece := stitch(content, next.GetContent())
+		if piece == "" {
+			break
+		}
+		if onChunk != nil {
+			if err := onChunk(piece); err != nil {
+				return nil, &streamInterruptedError{err: err}
+			}
+		}
+		content += piece
+	}
+
+	return &arceus.GenerateTextResponse{
+		Content:        content,
+		ConversationId: last.GetConversationId(),
+		CreatedAt:      resp.GetCreatedAt(),
+		Usage:          usage,
+		FinishReason:   last.GetFinishReason(),
+	}, nil
+}
+
+// needsContinuation reports whether an answer was cut off: its JSON never closes, or the
+// backend hit the token limit before the JSON even started.
+func needsContinuation(resp *arceus.GenerateTextResponse, content string) bool {
+	if llmjson.Truncated(content) {
+		return true
+	}
+	return resp.GetFinishReason() == llm_grpc.FinishReasonLength && !strings.ContainsAny(content, "{[")
+}
+
+// stitch returns the part of a continuation that extends content, without the markdown
+// fence the model may open again or the text it repeats from the end of content.
+func stitch(content, continuation string) string {
+	continuation = leadingFence.ReplaceAllString(continuation, "")
+
+	longest := len(continuation)
+	if len(content) < longest {
+		longest = len(content)
+	}
+	if longest > maxOverlap {
+		longest = maxOverlap
+	}
+	for size := longest; size >= minOverlap; size-- {
+		if strings.HasSuffix(content, continuation[:size]) {
+			return continuation[size:]
+		}
+	}
+	return continuation
+}
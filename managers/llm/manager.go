@@ -2,50 +2,251 @@ package managers
 
 import (
 	"context"
+	"darius/internal/constants"
 	llm_grpc "darius/internal/services/llm-grpc"
 	databaseService "darius/internal/services/repo"
 	"darius/metrics"
+	arceus "darius/pkg/proto/deps/arceus"
+	"errors"
 	"log"
+	"sync"
+	"time"
 )
 
 type Manager interface {
-	Generate(context.Context, string, string, string, *uint64) (*uint64, string, error)
+	Generate(context.Context, string, string, string, *uint64, ...GenerateOption) (*uint64, string, error)
+	GenerateStream(context.Context, string, string, string, *uint64, func(string) error, ...GenerateOption) (*uint64, string, error)
 	GetByRequestKey(context.Context, string) (string, error)
 }
 
+// GenerateOption overrides the routed generation parameters for a single call.
+type GenerateOption func(*arceus.GenerateTextRequest)
+
+func WithModel(model string) GenerateOption {
+	return func(req *arceus.GenerateTextRequest) {
+		req.Model = model
+	}
+}
+
+func WithTemperature(temperature float32) GenerateOption {
+	return func(req *arceus.GenerateTextRequest) {
+		req.Temperature = &temperature
+	}
+}
+
+func WithMaxTokens(maxTokens int32) GenerateOption {
+	return func(req *arceus.GenerateTextRequest) {
+		req.MaxTokens = &maxTokens
+	}
+}
+
+const maxTrackedConversations = 1000
+
 type manager struct {
 	llmService      llm_grpc.Service
 	databaseService databaseService.Service
+
+	// conversationOwners remembers the provider and model that produced each conversation
+	// so follow-up prompts go back to the backend that holds its history.
+	mu                 sync.Mutex
+	conversationOwners map[uint64]constants.LLMTarget
 }
 
 func NewManager(llmService llm_grpc.Service, databaseService databaseService.Service) Manager {
 	return &manager{
-		llmService:      llmService,
-		databaseService: databaseService,
+		llmService:         llmService,
+		databaseService:    databaseService,
+		conversationOwners: make(map[uint64]constants.LLMTarget),
 	}
 }
 
-func (m *manager) Generate(ctx context.Context, entryPoint string, req string, requestKey string, conversationId *uint64) (*uint64, string, error) {
-	resp, err := m.llmService.Generate(ctx, req, conversationId)
+// Generate walks the failover chain of the feature route. Timeouts, outages and rate
+// limits move on to the next target; any other error is returned straight away. An
+// answer cut off at the token limit is continued on its conversation and stitched back
+// together before it is returned.
+func (m *manager) Generate(ctx context.Context, entryPoint string, req string, requestKey string, conversationId *uint64, opts ...GenerateOption) (*uint64, string, error) {
+	return m.generate(ctx, entryPoint, req, requestKey, conversationId, opts, nil, func(ctx context.Context, provider string, llmReq *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+		return m.llmService.Generate(ctx, provider, llmReq)
+	})
+}
 
-	if err != nil {
-		log.Printf("[Generate] Error generating text: %v", err)
-		return nil, "", err
+// GenerateStream is Generate with the answer handed to onChunk as it is produced. Once a
+// chunk has reached the caller the stream can't be replayed, so failover only happens
+// while nothing has been emitted yet.
+func (m *manager) GenerateStream(ctx context.Context, entryPoint string, req string, requestKey string, conversationId *uint64, onChunk func(string) error, opts ...GenerateOption) (*uint64, string, error) {
+	return m.generate(ctx, entryPoint, req, requestKey, conversationId, opts, onChunk, func(ctx context.Context, provider string, llmReq *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+		emitted := false
+		resp, err := m.llmService.GenerateStream(ctx, provider, llmReq, func(chunk string) error {
+			emitted = true
+			return onChunk(chunk)
+		})
+		if err != nil && emitted {
+			return nil, &streamInterruptedError{err: err}
+		}
+		return resp, err
+	})
+}
+
+// streamInterruptedError marks a stream that failed after part of it reached the caller.
+type streamInterruptedError struct {
+	err error
+}
+
+func (e *streamInterruptedError) Error() string {
+	return "stream interrupted: " + e.err.Error()
+}
+
+func (e *streamInterruptedError) Unwrap() error {
+	return e.err
+}
+
+type attemptFunc func(context.Context, string, *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error)
+
+// generate runs the failover loop with attempt. onChunk, when set, also receives the
+// continuations of a truncated answer.
+func (m *manager) generate(ctx context.Context, entryPoint string, req string, requestKey string, conversationId *uint64, opts []GenerateOption, onChunk func(string) error, attempt attemptFunc) (*uint64, string, error) {
+	route := constants.GetLLMRoute(entryPoint)
+	targets := route.Targets()
+	for i := range targets {
+		targets[i].Provider = m.provider(targets[i])
+	}
+	primary := targets[0]
+	owner, pinned := m.conversationOwner(conversationId)
+	if pinned {
+		targets = pinTarget(targets, owner)
+	} else if conversationId != nil {
+		// A conversation this instance doesn't know about, say after a restart, is assumed to
+		// live on the default provider.
+		owner = constants.LLMTarget{Provider: m.llmService.DefaultProvider()}
+		targets = orderTargets(targets, owner.Provider)
 	}
 
-	err = m.databaseService.CreateLLMCallReport(ctx, entryPoint, req, resp.GetContent(), requestKey, float64(resp.GetUsage().GetTotalTokens()))
+	var lastErr error
+	for i, target := range targets {
+		provider := target.Provider
+		llmReq := &arceus.GenerateTextRequest{
+			Content:     req,
+			Model:       target.Model,
+			Temperature: route.Temperature,
+			MaxTokens:   route.MaxTokens,
+		}
+		for _, opt := range opts {
+			opt(llmReq)
+		}
+		if pinned || target != primary {
+			// Per-call model overrides only apply to the primary target of a new
+			// conversation; a continued one stays with the model that produced it.
+			llmReq.Model = target.Model
+		}
+		if conversationId != nil && provider == owner.Provider && (!pinned || target.Model == owner.Model) {
+			llmReq.ConversationId = conversationId
+		}
+
+		resp, err := m.attemptWithTimeout(ctx, provider, llmReq, route.Timeout, attempt)
+		if err == nil {
+			resp, err = m.continueTruncated(ctx, entryPoint, provider, llmReq, resp, route, onChunk)
+			if err != nil {
+				log.Printf("[Generate] Error streaming continuation: %v", err)
+				return nil, "", err
+			}
+			return m.report(ctx, entryPoint, req, requestKey, provider, llmReq.GetModel(), resp)
+		}
+
+		lastErr = err
+		errorClass := llm_grpc.ClassifyError(err)
+		var interrupted *streamInterruptedError
+		if !errorClass.ShouldFailover() || ctx.Err() != nil || errors.As(err, &interrupted) {
+			log.Printf("[Generate] Error generating text with %s/%s: %v", provider, llmReq.GetModel(), err)
+			return nil, "", err
+		}
+
+		metrics.LLMFailoverCounter.WithLabelValues(entryPoint, provider, llmReq.GetModel(), string(errorClass)).Inc()
+		log.Printf("[Generate] %s/%s failed (%s) on attempt %d/%d: %v", provider, llmReq.GetModel(), errorClass, i+1, len(targets), err)
+	}
+
+	log.Printf("[Generate] Error generating text, all %d targets failed: %v", len(targets), lastErr)
+	return nil, "", lastErr
+}
+
+func (m *manager) attemptWithTimeout(ctx context.Context, provider string, llmReq *arceus.GenerateTextRequest, timeout time.Duration, attempt attemptFunc) (*arceus.GenerateTextResponse, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return attempt(ctx, provider, llmReq)
+}
+
+func (m *manager) report(ctx context.Context, entryPoint, req, requestKey, provider, model string, resp *arceus.GenerateTextResponse) (*uint64, string, error) {
+	err := m.databaseService.CreateLLMCallReport(ctx, entryPoint, req, resp.GetContent(), requestKey, provider, model, float64(resp.GetUsage().GetTotalTokens()))
 	if err != nil {
 		log.Printf("[Generate] Error creating LLM call report: %v", err)
 		return nil, "", err
 	}
 
-	metrics.LLMRequestCounter.WithLabelValues(entryPoint).Inc()
-	metrics.LLMTokenCounter.WithLabelValues(entryPoint).Add(float64(resp.GetUsage().GetTotalTokens()))
+	metrics.LLMRequestCounter.WithLabelValues(entryPoint, provider, model).Inc()
+	metrics.LLMTokenCounter.WithLabelValues(entryPoint, provider, model).Add(float64(resp.GetUsage().GetTotalTokens()))
 
 	conID := resp.GetConversationId()
+	m.setConversationOwner(conID, constants.LLMTarget{Provider: provider, Model: model})
 	return &conID, resp.GetContent(), nil
 }
 
+// provider returns the provider of a target, the default one when the route leaves it out.
+func (m *manager) provider(target constants.LLMTarget) string {
+	if target.Provider == "" {
+		return m.llmService.DefaultProvider()
+	}
+	return target.Provider
+}
+
+// pinTarget puts the provider and model that produced a conversation first, whether or not
+// the route still lists them, so a re-prompt keeps its history whenever they are healthy.
+// The other targets follow as fallbacks.
+func pinTarget(targets []constants.LLMTarget, owner constants.LLMTarget) []constants.LLMTarget {
+	pinned := []constants.LLMTarget{owner}
+	for _, target := range targets {
+		if target != owner {
+			pinned = append(pinned, target)
+		}
+	}
+	return pinned
+}
+
+// orderTargets moves the targets served by the provider owning the conversation to the
+// front, so a re-prompt keeps its history whenever that provider is still healthy.
+func orderTargets(targets []constants.LLMTarget, owner string) []constants.LLMTarget {
+	ordered := make([]constants.LLMTarget, 0, len(targets))
+	var others []constants.LLMTarget
+	for _, target := range targets {
+		if target.Provider == owner {
+			ordered = append(ordered, target)
+		} else {
+			others = append(others, target)
+		}
+	}
+	return append(ordered, others...)
+}
+
+func (m *manager) conversationOwner(conversationId *uint64) (constants.LLMTarget, bool) {
+	if conversationId == nil {
+		return constants.LLMTarget{}, false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	owner, ok := m.conversationOwners[*conversationId]
+	return owner, ok
+}
+
+func (m *manager) setConversationOwner(conversationId uint64, owner constants.LLMTarget) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.conversationOwners) >= maxTrackedConversations {
+		m.conversationOwners = make(map[uint64]constants.LLMTarget)
+	}
+	m.conversationOwners[conversationId] = owner
+}
+
 func (m *manager) GetByRequestKey(ctx context.Context, requestKey string) (string, error) {
 	report, err := m.databaseService.GetByRequestKey(ctx, requestKey)
 	if err != nil {
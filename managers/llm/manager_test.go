@@ -0,0 +1,349 @@
+package managers
+
+import (
+	"context"
+	"darius/internal/constants"
+	llm_grpc "darius/internal/services/llm-grpc"
+	arceus "darius/pkg/proto/deps/arceus"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockCall struct {
+	provider       string
+	model          string
+	conversationId *uint64
+	content        string
+}
+
+type mockLLMService struct {
+	errors map[string]error
+	// midStream marks targets that emit a chunk before failing.
+	midStream map[string]bool
+	// answers, when set, are returned in order instead of the default answer.
+	answers []*arceus.GenerateTextResponse
+	calls   []mockCall
+}
+
+func (m *mockLLMService) DefaultProvider() string {
+	return llm_grpc.ProviderArceus
+}
+
+func (m *mockLLMService) Generate(ctx context.Context, provider string, req *arceus.GenerateTextRequest) (*arceus.GenerateTextResponse, error) {
+	if req.GetModel() == "" {
+		req.Model = "default-model"
+	}
+	m.calls = append(m.calls, mockCall{provider: provider, model: req.GetModel(), conversationId: req.ConversationId, content: req.GetContent()})
+	if err := m.errors[provider+"/"+req.GetModel()]; err != nil {
+		return nil, err
+	}
+	if len(m.answers) > 0 {
+		answer := m.answers[0]
+		m.answers = m.answers[1:]
+		answer.ConversationId = 42
+		return answer, nil
+	}
+	return &arceus.GenerateTextResponse{
+		Content:        "answer from " + provider + "/" + req.GetModel(),
+		ConversationId: uint64(len(m.calls)),
+		Usage:          &arceus.Usage{TotalTokens: 10},
+	}, nil
+}
+
+func (m *mockLLMService) GenerateStream(ctx context.Context, provider string, req *arceus.GenerateTextRequest, onChunk func(string) error) (*arceus.GenerateTextResponse, error) {
+	target := provider + "/" + req.GetModel()
+	if m.midStream[target] {
+		m.calls = append(m.calls, mockCall{provider: provider, model: req.GetModel(), conversationId: req.ConversationId})
+		onChunk("partial ")
+		return nil, m.errors[target]
+	}
+
+	resp, err := m.Generate(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := onChunk(resp.GetContent()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type mockReport struct {
+	provider string
+	model    string
+}
+
+type mockDatabaseService struct {
+	reports []mockReport
+	amounts []float64
+}
+
+func (m *mockDatabaseService) CreateLLMCallReport(ctx context.Context, entry, res, resp, requestKey, provider, model string, amount float64) error {
+	m.reports = append(m.reports, mockReport{provider: provider, model: model})
+	m.amounts = append(m.amounts, amount)
+	return nil
+}
+
+func (m *mockDatabaseService) GetByRequestKey(ctx context.Context, requestKey string) (string, error) {
+	return "", nil
+}
+
+func withRoute(t *testing.T, key string, route constants.LLMRoute) {
+	previous, existed := constants.RouteMap[key]
+	constants.RouteMap[key] = route
+	t.Cleanup(func() {
+		if existed {
+			constants.RouteMap[key] = previous
+		} else {
+			delete(constants.RouteMap, key)
+		}
+	})
+}
+
+func TestGenerate_FailsOverOnTimeoutAndUnavailable(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{
+		Model: "primary",
+		Fallbacks: []constants.LLMTarget{
+			{Model: "cheaper"},
+			{Provider: llm_grpc.ProviderOpenAI, Model: "secondary"},
+		},
+	})
+
+	llmService := &mockLLMService{errors: map[string]error{
+		"arceus/primary": status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+		"arceus/cheaper": &llm_grpc.StatusError{StatusCode: 503},
+	}}
+	dbService := &mockDatabaseService{}
+	m := NewManager(llmService, dbService)
+
+	_, content, err := m.Generate(context.Background(), "test_feature", "prompt", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "answer from openai/secondary", content)
+	assert.Len(t, llmService.calls, 3)
+	assert.Equal(t, []mockReport{{provider: llm_grpc.ProviderOpenAI, model: "secondary"}}, dbService.reports)
+}
+
+func TestGenerate_DoesNotFailOverOnClientError(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{
+		Model:     "primary",
+		Fallbacks: []constants.LLMTarget{{Model: "cheaper"}},
+	})
+
+	llmService := &mockLLMService{errors: map[string]error{
+		"arceus/primary": status.Error(codes.InvalidArgument, "bad request"),
+	}}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	_, _, err := m.Generate(context.Background(), "test_feature", "prompt", "", nil)
+	assert.Error(t, err)
+	assert.Len(t, llmService.calls, 1)
+}
+
+func TestGenerate_KeepsConversationOnOwningProvider(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{
+		Model:     "primary",
+		Fallbacks: []constants.LLMTarget{{Provider: llm_grpc.ProviderOpenAI, Model: "secondary"}},
+	})
+
+	llmService := &mockLLMService{errors: map[string]error{
+		"arceus/primary": status.Error(codes.Unavailable, "down"),
+	}}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	conversationId, _, err := m.Generate(context.Background(), "test_feature", "prompt", "", nil)
+	assert.NoError(t, err)
+
+	// The re-prompt goes straight to the provider holding the conversation.
+	llmService.calls = nil
+	_, content, err := m.Generate(context.Background(), "test_feature", "try again", "", conversationId)
+	assert.NoError(t, err)
+	assert.Equal(t, "answer from openai/secondary", content)
+	assert.Equal(t, llm_grpc.ProviderOpenAI, llmService.calls[0].provider)
+	assert.Equal(t, conversationId, llmService.calls[0].conversationId)
+}
+
+func TestGenerate_PinsConversationToItsModel(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{
+		Model:     "primary",
+		Fallbacks: []constants.LLMTarget{{Model: "cheaper"}},
+	})
+
+	llmService := &mockLLMService{errors: map[string]error{
+		"arceus/primary": status.Error(codes.Unavailable, "down"),
+	}}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	conversationId, _, err := m.Generate(context.Background(), "test_feature", "prompt", "", nil)
+	assert.NoError(t, err)
+
+	// The primary model is back, but the conversation lives on the model that answered.
+	delete(llmService.errors, "arceus/primary")
+	llmService.calls = nil
+	_, content, err := m.Generate(context.Background(), "test_feature", "try again", "", conversationId)
+	assert.NoError(t, err)
+	assert.Equal(t, "answer from arceus/cheaper", content)
+	assert.Len(t, llmService.calls, 1)
+	assert.Equal(t, conversationId, llmService.calls[0].conversationId)
+
+	// A conversation started with a per-call model goes on with it.
+	conversationId, _, err = m.Generate(context.Background(), "test_feature", "prompt", "", nil, WithModel("override"))
+	assert.NoError(t, err)
+	llmService.calls = nil
+	_, content, err = m.Generate(context.Background(), "test_feature", "try again", "", conversationId)
+	assert.NoError(t, err)
+	assert.Equal(t, "answer from arceus/override", content)
+	assert.Equal(t, conversationId, llmService.calls[0].conversationId)
+}
+
+func TestGenerate_AppliesModelOverrideToPrimaryOnly(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{
+		Provider:  llm_grpc.ProviderOpenAI,
+		Model:     "primary",
+		Fallbacks: []constants.LLMTarget{{Provider: llm_grpc.ProviderArceus, Model: "secondary"}},
+	})
+
+	llmService := &mockLLMService{errors: map[string]error{
+		"arceus/secondary": status.Error(codes.Unavailable, "down"),
+	}}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	// An unknown conversation moves the default provider's fallback first, which still
+	// doesn't make it the target the override was meant for.
+	unknown := uint64(99)
+	_, content, err := m.Generate(context.Background(), "test_feature", "prompt", "", &unknown, WithModel("override"))
+	assert.NoError(t, err)
+	assert.Equal(t, "answer from openai/override", content)
+	assert.Equal(t, "secondary", llmService.calls[0].model)
+	assert.Equal(t, &unknown, llmService.calls[0].conversationId)
+	assert.Nil(t, llmService.calls[1].conversationId)
+}
+
+func TestGenerate_AppliesRouteAndOptions(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{Model: "primary"})
+
+	llmService := &mockLLMService{}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	_, content, err := m.Generate(context.Background(), "test_feature", "prompt", "", nil, WithModel("override"))
+	assert.NoError(t, err)
+	assert.Equal(t, "answer from arceus/override", content)
+}
+
+func TestGenerateStream_FailsOverBeforeFirstChunk(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{
+		Model:     "primary",
+		Fallbacks: []constants.LLMTarget{{Provider: llm_grpc.ProviderOpenAI, Model: "secondary"}},
+	})
+
+	llmService := &mockLLMService{errors: map[string]error{
+		"arceus/primary": status.Error(codes.Unavailable, "down"),
+	}}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	var chunks []string
+	_, content, err := m.GenerateStream(context.Background(), "test_feature", "prompt", "", nil, func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "answer from openai/secondary", content)
+	assert.Equal(t, []string{"answer from openai/secondary"}, chunks)
+}
+
+func TestGenerateStream_DoesNotFailOverAfterFirstChunk(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{
+		Model:     "primary",
+		Fallbacks: []constants.LLMTarget{{Provider: llm_grpc.ProviderOpenAI, Model: "secondary"}},
+	})
+
+	llmService := &mockLLMService{
+		errors:    map[string]error{"arceus/primary": status.Error(codes.Unavailable, "connection reset")},
+		midStream: map[string]bool{"arceus/primary": true},
+	}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	_, _, err := m.GenerateStream(context.Background(), "test_feature", "prompt", "", nil, func(chunk string) error { return nil })
+	assert.Error(t, err)
+	assert.Len(t, llmService.calls, 1)
+}
+
+func TestGenerate_ContinuesTruncatedAnswer(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{Model: "primary", MaxContinuations: intPtr(2)})
+
+	llmService := &mockLLMService{answers: []*arceus.GenerateTextResponse{
+		{Content: "```json\n{\"questions\": [{\"id\": 1}, {\"id\": 2, \"te", FinishReason: llm_grpc.FinishReasonLength, Usage: &arceus.Usage{TotalTokens: 100}},
+		{Content: "```json\n{\"id\": 2, \"text\": \"b\"}]}\n```", FinishReason: llm_grpc.FinishReasonStop, Usage: &arceus.Usage{TotalTokens: 30}},
+	}}
+	dbService := &mockDatabaseService{}
+	m := NewManager(llmService, dbService)
+
+	conversationId, content, err := m.Generate(context.Background(), "test_feature", "prompt", "key", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "```json\n{\"questions\": [{\"id\": 1}, {\"id\": 2, \"text\": \"b\"}]}\n```", content)
+	assert.Equal(t, uint64(42), *conversationId)
+
+	assert.Len(t, llmService.calls, 2)
+	assert.Equal(t, continuePrompt, llmService.calls[1].content)
+	assert.Equal(t, uint64(42), *llmService.calls[1].conversationId)
+	assert.Equal(t, []float64{130}, dbService.amounts)
+}
+
+func TestGenerate_StopsContinuingAtLimit(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{Model: "primary", MaxContinuations: intPtr(1)})
+
+	llmService := &mockLLMService{answers: []*arceus.GenerateTextResponse{
+		{Content: `{"questions": [{"id": 1}, `, FinishReason: llm_grpc.FinishReasonLength},
+		{Content: `{"id": 2}, `, FinishReason: llm_grpc.FinishReasonLength},
+	}}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	_, content, err := m.Generate(context.Background(), "test_feature", "prompt", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"questions": [{"id": 1}, {"id": 2}, `, content)
+	assert.Len(t, llmService.calls, 2)
+}
+
+func TestGenerateStream_EmitsContinuation(t *testing.T) {
+	withRoute(t, "test_feature", constants.LLMRoute{Model: "primary", MaxContinuations: intPtr(1)})
+
+	llmService := &mockLLMService{answers: []*arceus.GenerateTextResponse{
+		{Content: `[{"id": 1}, {"id"`},
+		{Content: `: 2}]`},
+	}}
+	m := NewManager(llmService, &mockDatabaseService{})
+
+	var chunks []string
+	_, content, err := m.GenerateStream(context.Background(), "test_feature", "prompt", "", nil, func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"id": 1}, {"id": 2}]`, content)
+	assert.Equal(t, []string{`[{"id": 1}, {"id"`, `: 2}]`}, chunks)
+}
+
+func Test_stitch(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		continuation string
+		want         string
+	}{
+		{name: "clean", content: `{"text": "Explain the differ`, continuation: `ence"}`, want: `ence"}`},
+		{name: "repeats the tail", content: `[{"id": 1}, {"id": 2, "text": "Wh`, continuation: `{"id": 2, "text": "What is Go?"}]`, want: `at is Go?"}]`},
+		{name: "opens a fence again", content: "```json\n[{\"id\": 1}, ", continuation: "```json\n{\"id\": 2}]\n```", want: "{\"id\": 2}]\n```"},
+		{name: "short overlap is not trusted", content: `{"a": "b", `, continuation: `"b": 1}`, want: `"b": 1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, stitch(tt.content, tt.continuation))
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -9,17 +9,67 @@ var LLMRequestCounter = prometheus.NewCounterVec(
 		Name: "llm_request_counter",
 		Help: "The number of LLM requests made",
 	},
-	[]string{"feature"},
+	[]string{"feature", "provider", "model"},
 )
 var LLMTokenCounter = prometheus.NewCounterVec(
 	prometheus.CounterOpts{
 		Name: "llm_token_counter",
 		Help: "The number of tokens used in LLM requests",
 	},
-	[]string{"feature"},
+	[]string{"feature", "provider", "model"},
+)
+var LLMFailoverCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "llm_failover_counter",
+		Help: "The number of LLM attempts that failed over to the next provider or model",
+	},
+	[]string{"feature", "provider", "model", "reason"},
+)
+
+var LLMContinuationCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "llm_continuation_counter",
+		Help: "The number of follow-up prompts sent to continue a truncated LLM answer",
+	},
+	[]string{"feature", "provider", "model"},
+)
+var DependencyCircuitState = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "dependency_circuit_state",
+		Help: "Circuit breaker state of an outbound dependency (0 closed, 1 half-open, 2 open)",
+	},
+	[]string{"dependency"},
+)
+var DependencyInFlight = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "dependency_in_flight",
+		Help: "The number of calls currently running against an outbound dependency",
+	},
+	[]string{"dependency"},
+)
+var DependencyRejectedCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dependency_rejected_counter",
+		Help: "The number of calls to an outbound dependency rejected without being sent",
+	},
+	[]string{"dependency", "reason"},
+)
+
+var JobCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "job_counter",
+		Help: "The number of async job runs by final status of the run",
+	},
+	[]string{"kind", "status"},
 )
 
 func init() {
 	prometheus.MustRegister(LLMRequestCounter)
 	prometheus.MustRegister(LLMTokenCounter)
+	prometheus.MustRegister(LLMFailoverCounter)
+	prometheus.MustRegister(LLMContinuationCounter)
+	prometheus.MustRegister(DependencyCircuitState)
+	prometheus.MustRegister(DependencyInFlight)
+	prometheus.MustRegister(DependencyRejectedCounter)
+	prometheus.MustRegister(JobCounter)
 }
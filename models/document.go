@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Document is course material a trainer uploaded to ground generated questions in. It is
+// kept as the chunks it was split into, which are retrieved per topic and cited by the
+// questions.
+type Document struct {
+	ID        uint            `gorm:"primaryKey"`
+	Title     string          `gorm:"size:255"`
+	Format    string          `gorm:"size:16;not null"`
+	AuthorId  string          `gorm:"index;size:64"`
+	Chunks    []DocumentChunk `gorm:"foreignKey:DocumentID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time       `gorm:"autoCreateTime"`
+}
+
+type DocumentChunk struct {
+	ID         uint   `gorm:"primaryKey"`
+	DocumentID uint   `gorm:"index;not null"`
+	Position   int    `gorm:"not null"` // order of the chunk in the document, from 0
+	Section    string `gorm:"size:1024"`
+	Page       int
+	Text       string `gorm:"type:text;not null"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+const (
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	JobStatusFailed    = "failed"
+)
+
+const (
+	CallbackStatusPending   = "pending"
+	CallbackStatusDelivered = "delivered"
+	CallbackStatusFailed    = "failed"
+)
+
+type Job struct {
+	ID           uint   `gorm:"primaryKey"`
+	RequestKey   string `gorm:"uniqueIndex;size:64;not null"`
+	Kind         string `gorm:"index;not null"`
+	UserId       string
+	Payload      string `gorm:"type:longtext"`
+	Status       string `gorm:"index;not null"`
+	ErrorCode    string
+	ErrorMessage string
+	Attempts     int
+	// Result is the output of a succeeded job, for the kinds that keep it on the job.
+	Result string `gorm:"type:longtext"`
+	// CallbackUrl receives a signed POST once the job succeeds or fails.
+	CallbackUrl    string
+	CallbackSecret string
+	// CallbackStatus stays pending from the moment the job finishes until the callback is
+	// delivered or runs out of attempts, so a delivery cut short by a restart is resumed.
+	CallbackStatus string    `gorm:"index"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+	StartedAt      *time.Time
+	FinishedAt     *time.Time
+}
@@ -7,6 +7,8 @@ type LLMCallReport struct {
 	Entry      string
 	Res        string
 	Resp       string
+	Provider   string
+	Model      string
 	Amount     float64
 	CreatedAt  time.Time `gorm:"autoCreateTime"`
 	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+const (
+	QuestionStatusDraft    = "draft"
+	QuestionStatusApproved = "approved"
+	QuestionStatusRejected = "rejected"
+)
+
+// Question is a question kept in the question bank, one row per question. Content holds
+// the whole question as protojson; the other columns are copies of it and of the exam it
+// came from, for searching.
+type Question struct {
+	ID         uint   `gorm:"primaryKey"`
+	Text       string `gorm:"type:text;not null;index:idx_questions_text,class:FULLTEXT"`
+	Type       string `gorm:"index;size:32;not null"`
+	Topic      string `gorm:"index;size:255"`
+	Level      string `gorm:"index;size:32"`
+	Language   string `gorm:"index;size:32"`
+	Points     int32
+	Content    string        `gorm:"type:longtext;not null"`
+	RequestKey string        `gorm:"index;size:64"`
+	AuthorId   string        `gorm:"index;size:64"`
+	Status     string        `gorm:"index;size:16;not null"`
+	Tags       []QuestionTag `gorm:"foreignKey:QuestionID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time     `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time     `gorm:"autoUpdateTime"`
+}
+
+type QuestionTag struct {
+	ID         uint   `gorm:"primaryKey"`
+	QuestionID uint   `gorm:"index;not null"`
+	Tag        string `gorm:"index;size:64;not null"`
+}
+
+// QuestionExposure records that a candidate pool was shown a question of the bank, so
+// exams assembled for the same pool can avoid it for a while.
+type QuestionExposure struct {
+	ID            uint      `gorm:"primaryKey"`
+	QuestionID    uint      `gorm:"index;not null"`
+	CandidatePool string    `gorm:"index;size:64;not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime;index"`
+}
+
+// QuestionFilter selects questions of the bank. Empty fields match everything; a question
+// must carry every one of Tags.
+type QuestionFilter struct {
+	Query    string
+	Topic    string
+	Level    string
+	Type     string
+	Language string
+	Status   string
+	AuthorId string
+	Tags     []string
+	// UnseenBy drops the questions that candidate pool was shown after SeenSince.
+	UnseenBy  string
+	SeenSince time.Time
+	Offset    int
+	Limit     int
+}
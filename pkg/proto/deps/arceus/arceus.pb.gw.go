@@ -59,6 +59,26 @@ func local_request_Arceus_GenerateText_0(ctx context.Context, marshaler runtime.
 	return msg, metadata, err
 }
 
+func request_Arceus_GenerateTextStream_0(ctx context.Context, marshaler runtime.Marshaler, client ArceusClient, req *http.Request, pathParams map[string]string) (Arceus_GenerateTextStreamClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq GenerateTextRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.GenerateTextStream(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 // RegisterArceusHandlerServer registers the http handlers for service Arceus to "mux".
 // UnaryRPC     :call ArceusServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -86,6 +106,13 @@ func RegisterArceusHandlerServer(ctx context.Context, mux *runtime.ServeMux, ser
 		forward_Arceus_GenerateText_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 
+	mux.Handle(http.MethodPost, pattern_Arceus_GenerateTextStream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	return nil
 }
 
@@ -142,13 +169,32 @@ func RegisterArceusHandlerClient(ctx context.Context, mux *runtime.ServeMux, cli
 		}
 		forward_Arceus_GenerateText_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_Arceus_GenerateTextStream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/arceus.Arceus/GenerateTextStream", runtime.WithHTTPPathPattern("/api/chat/completions/stream"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Arceus_GenerateTextStream_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Arceus_GenerateTextStream_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_Arceus_GenerateText_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "chat", "completions"}, ""))
+	pattern_Arceus_GenerateText_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "chat", "completions"}, ""))
+	pattern_Arceus_GenerateTextStream_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "chat", "completions", "stream"}, ""))
 )
 
 var (
-	forward_Arceus_GenerateText_0 = runtime.ForwardResponseMessage
+	forward_Arceus_GenerateText_0       = runtime.ForwardResponseMessage
+	forward_Arceus_GenerateTextStream_0 = runtime.ForwardResponseStream
 )
@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ArceusClient interface {
 	GenerateText(ctx context.Context, in *GenerateTextRequest, opts ...grpc.CallOption) (*GenerateTextResponse, error)
+	GenerateTextStream(ctx context.Context, in *GenerateTextRequest, opts ...grpc.CallOption) (Arceus_GenerateTextStreamClient, error)
 }
 
 type arceusClient struct {
@@ -42,11 +43,44 @@ func (c *arceusClient) GenerateText(ctx context.Context, in *GenerateTextRequest
 	return out, nil
 }
 
+func (c *arceusClient) GenerateTextStream(ctx context.Context, in *GenerateTextRequest, opts ...grpc.CallOption) (Arceus_GenerateTextStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Arceus_ServiceDesc.Streams[0], "/arceus.Arceus/GenerateTextStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arceusGenerateTextStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Arceus_GenerateTextStreamClient interface {
+	Recv() (*GenerateTextStreamResponse, error)
+	grpc.ClientStream
+}
+
+type arceusGenerateTextStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *arceusGenerateTextStreamClient) Recv() (*GenerateTextStreamResponse, error) {
+	m := new(GenerateTextStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ArceusServer is the server API for Arceus service.
 // All implementations must embed UnimplementedArceusServer
 // for forward compatibility
 type ArceusServer interface {
 	GenerateText(context.Context, *GenerateTextRequest) (*GenerateTextResponse, error)
+	GenerateTextStream(*GenerateTextRequest, Arceus_GenerateTextStreamServer) error
 	mustEmbedUnimplementedArceusServer()
 }
 
@@ -57,6 +91,9 @@ type UnimplementedArceusServer struct {
 func (UnimplementedArceusServer) GenerateText(context.Context, *GenerateTextRequest) (*GenerateTextResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateText not implemented")
 }
+func (UnimplementedArceusServer) GenerateTextStream(*GenerateTextRequest, Arceus_GenerateTextStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateTextStream not implemented")
+}
 func (UnimplementedArceusServer) mustEmbedUnimplementedArceusServer() {}
 
 // UnsafeArceusServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +125,27 @@ func _Arceus_GenerateText_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Arceus_GenerateTextStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateTextRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArceusServer).GenerateTextStream(m, &arceusGenerateTextStreamServer{stream})
+}
+
+type Arceus_GenerateTextStreamServer interface {
+	Send(*GenerateTextStreamResponse) error
+	grpc.ServerStream
+}
+
+type arceusGenerateTextStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *arceusGenerateTextStreamServer) Send(m *GenerateTextStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Arceus_ServiceDesc is the grpc.ServiceDesc for Arceus service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -100,6 +158,12 @@ var Arceus_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Arceus_GenerateText_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateTextStream",
+			Handler:       _Arceus_GenerateTextStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/deps/arceus/arceus.proto",
 }
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -33,8 +34,10 @@ type SuggestExamQuestionResponseV2 struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Questions  []*SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`   // List of questions in the response
-	RequestKey string                                   `protobuf:"bytes,2,opt,name=requestKey,proto3" json:"requestKey,omitempty"` // Unique key for the request, used for tracking
+	Questions   []*SuggestExamQuestionResponseV2_Quetion   `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`     // List of questions in the response
+	RequestKey  string                                     `protobuf:"bytes,2,opt,name=requestKey,proto3" json:"requestKey,omitempty"`   // Unique key for the request, used for tracking
+	Violations  []*SuggestExamQuestionResponseV2_Violation `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`   // Validation problems the repair pass could not fix
+	BloomReport *SuggestExamQuestionResponseV2_BloomReport `protobuf:"bytes,4,opt,name=bloomReport,proto3" json:"bloomReport,omitempty"` // How close the exam came to the requested Bloom distribution, set when one was requested
 }
 
 func (x *SuggestExamQuestionResponseV2) Reset() {
@@ -83,6 +86,20 @@ func (x *SuggestExamQuestionResponseV2) GetRequestKey() string {
 	return ""
 }
 
+func (x *SuggestExamQuestionResponseV2) GetViolations() []*SuggestExamQuestionResponseV2_Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2) GetBloomReport() *SuggestExamQuestionResponseV2_BloomReport {
+	if x != nil {
+		return x.BloomReport
+	}
+	return nil
+}
+
 type DifficultyDistribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -170,6 +187,94 @@ func (x *DifficultyDistribution) GetExpert() int32 {
 	return 0
 }
 
+// Number of questions per cognitive level of Bloom's taxonomy.
+type BloomDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remember   int32 `protobuf:"varint,1,opt,name=Remember,proto3" json:"Remember,omitempty"`
+	Understand int32 `protobuf:"varint,2,opt,name=Understand,proto3" json:"Understand,omitempty"`
+	Apply      int32 `protobuf:"varint,3,opt,name=Apply,proto3" json:"Apply,omitempty"`
+	Analyze    int32 `protobuf:"varint,4,opt,name=Analyze,proto3" json:"Analyze,omitempty"`
+	Evaluate   int32 `protobuf:"varint,5,opt,name=Evaluate,proto3" json:"Evaluate,omitempty"`
+	Create     int32 `protobuf:"varint,6,opt,name=Create,proto3" json:"Create,omitempty"`
+}
+
+func (x *BloomDistribution) Reset() {
+	*x = BloomDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BloomDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloomDistribution) ProtoMessage() {}
+
+func (x *BloomDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloomDistribution.ProtoReflect.Descriptor instead.
+func (*BloomDistribution) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BloomDistribution) GetRemember() int32 {
+	if x != nil {
+		return x.Remember
+	}
+	return 0
+}
+
+func (x *BloomDistribution) GetUnderstand() int32 {
+	if x != nil {
+		return x.Understand
+	}
+	return 0
+}
+
+func (x *BloomDistribution) GetApply() int32 {
+	if x != nil {
+		return x.Apply
+	}
+	return 0
+}
+
+func (x *BloomDistribution) GetAnalyze() int32 {
+	if x != nil {
+		return x.Analyze
+	}
+	return 0
+}
+
+func (x *BloomDistribution) GetEvaluate() int32 {
+	if x != nil {
+		return x.Evaluate
+	}
+	return 0
+}
+
+func (x *BloomDistribution) GetCreate() int32 {
+	if x != nil {
+		return x.Create
+	}
+	return 0
+}
+
 type Topic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -182,7 +287,7 @@ type Topic struct {
 func (x *Topic) Reset() {
 	*x = Topic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[2]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -195,7 +300,7 @@ func (x *Topic) String() string {
 func (*Topic) ProtoMessage() {}
 
 func (x *Topic) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[2]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +313,7 @@ func (x *Topic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Topic.ProtoReflect.Descriptor instead.
 func (*Topic) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{2}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Topic) GetName() string {
@@ -230,21 +335,27 @@ type SuggestExamQuestionRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title        string                              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description  string                              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Language     string                              `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`   // English, Vietnamese, ...
-	Seniority    string                              `protobuf:"bytes,4,opt,name=seniority,proto3" json:"seniority,omitempty"` // Intern, Junior, Middle, Senior, Lead, ...
-	Topics       []*Topic                            `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
-	Creativity   int32                               `protobuf:"varint,6,opt,name=creativity,proto3" json:"creativity,omitempty"` // Creativity level from 1 to 10
-	Context      *SuggestExamQuestionRequest_Context `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
-	QuestionType string                              `protobuf:"bytes,8,opt,name=questionType,proto3" json:"questionType,omitempty"`
-	RequestKey   string                              `protobuf:"bytes,9,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
+	Title             string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Language          string                                   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`   // English, Vietnamese, ...
+	Seniority         string                                   `protobuf:"bytes,4,opt,name=seniority,proto3" json:"seniority,omitempty"` // Intern, Junior, Middle, Senior, Lead, ...
+	Topics            []*Topic                                 `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
+	Creativity        int32                                    `protobuf:"varint,6,opt,name=creativity,proto3" json:"creativity,omitempty"` // Creativity level from 1 to 10
+	Context           *SuggestExamQuestionRequest_Context      `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+	QuestionType      string                                   `protobuf:"bytes,8,opt,name=questionType,proto3" json:"questionType,omitempty"`
+	RequestKey        string                                   `protobuf:"bytes,9,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
+	TypeRatio         map[string]int32                         `protobuf:"bytes,10,rep,name=typeRatio,proto3" json:"typeRatio,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // With MIXED, relative share of each question type, e.g. {"MCQ": 3, "TRUE_FALSE": 1}
+	CodeLanguage      string                                   `protobuf:"bytes,11,opt,name=codeLanguage,proto3" json:"codeLanguage,omitempty"`                                                                                    // Programming language of CODE questions, python by default
+	DocumentIds       []uint64                                 `protobuf:"varint,12,rep,packed,name=documentIds,proto3" json:"documentIds,omitempty"`                                                                              // Uploaded documents the questions are grounded in and cite
+	Exemplars         []*SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,13,rep,name=exemplars,proto3" json:"exemplars,omitempty"`                                                                                          // Existing questions, e.g. imported ones, whose style the new ones follow
+	ExcludedQuestions []*SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,14,rep,name=excludedQuestions,proto3" json:"excludedQuestions,omitempty"`                                                                          // Existing questions the new ones must not repeat or overlap with
+	BloomDistribution *BloomDistribution                       `protobuf:"bytes,15,opt,name=bloomDistribution,proto3" json:"bloomDistribution,omitempty"`                                                                          // Target questions per Bloom level, adding up to the questions of the topics
 }
 
 func (x *SuggestExamQuestionRequest) Reset() {
 	*x = SuggestExamQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[3]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -257,7 +368,7 @@ func (x *SuggestExamQuestionRequest) String() string {
 func (*SuggestExamQuestionRequest) ProtoMessage() {}
 
 func (x *SuggestExamQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[3]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,7 +381,7 @@ func (x *SuggestExamQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestExamQuestionRequest.ProtoReflect.Descriptor instead.
 func (*SuggestExamQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{3}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SuggestExamQuestionRequest) GetTitle() string {
@@ -336,6 +447,48 @@ func (x *SuggestExamQuestionRequest) GetRequestKey() string {
 	return ""
 }
 
+func (x *SuggestExamQuestionRequest) GetTypeRatio() map[string]int32 {
+	if x != nil {
+		return x.TypeRatio
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionRequest) GetCodeLanguage() string {
+	if x != nil {
+		return x.CodeLanguage
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionRequest) GetDocumentIds() []uint64 {
+	if x != nil {
+		return x.DocumentIds
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionRequest) GetExemplars() []*SuggestExamQuestionResponseV2_Quetion {
+	if x != nil {
+		return x.Exemplars
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionRequest) GetExcludedQuestions() []*SuggestExamQuestionResponseV2_Quetion {
+	if x != nil {
+		return x.ExcludedQuestions
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionRequest) GetBloomDistribution() *BloomDistribution {
+	if x != nil {
+		return x.BloomDistribution
+	}
+	return nil
+}
+
 type SuggestExamQuestionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -348,7 +501,7 @@ type SuggestExamQuestionResponse struct {
 func (x *SuggestExamQuestionResponse) Reset() {
 	*x = SuggestExamQuestionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[4]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -361,7 +514,7 @@ func (x *SuggestExamQuestionResponse) String() string {
 func (*SuggestExamQuestionResponse) ProtoMessage() {}
 
 func (x *SuggestExamQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[4]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +527,7 @@ func (x *SuggestExamQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestExamQuestionResponse.ProtoReflect.Descriptor instead.
 func (*SuggestExamQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{4}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SuggestExamQuestionResponse) GetQuestions() []*Question {
@@ -406,7 +559,7 @@ type SuggestOutlinesRequest struct {
 func (x *SuggestOutlinesRequest) Reset() {
 	*x = SuggestOutlinesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[5]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -419,7 +572,7 @@ func (x *SuggestOutlinesRequest) String() string {
 func (*SuggestOutlinesRequest) ProtoMessage() {}
 
 func (x *SuggestOutlinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[5]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +585,7 @@ func (x *SuggestOutlinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestOutlinesRequest.ProtoReflect.Descriptor instead.
 func (*SuggestOutlinesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{5}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SuggestOutlinesRequest) GetTitle() string {
@@ -481,7 +634,7 @@ type SuggestOutlinesResponse struct {
 func (x *SuggestOutlinesResponse) Reset() {
 	*x = SuggestOutlinesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[6]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -494,7 +647,7 @@ func (x *SuggestOutlinesResponse) String() string {
 func (*SuggestOutlinesResponse) ProtoMessage() {}
 
 func (x *SuggestOutlinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[6]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +660,7 @@ func (x *SuggestOutlinesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestOutlinesResponse.ProtoReflect.Descriptor instead.
 func (*SuggestOutlinesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{6}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SuggestOutlinesResponse) GetOutlines() []string {
@@ -530,7 +683,7 @@ type SuggestInterviewQuestionRequest struct {
 func (x *SuggestInterviewQuestionRequest) Reset() {
 	*x = SuggestInterviewQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[7]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -543,7 +696,7 @@ func (x *SuggestInterviewQuestionRequest) String() string {
 func (*SuggestInterviewQuestionRequest) ProtoMessage() {}
 
 func (x *SuggestInterviewQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[7]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +709,7 @@ func (x *SuggestInterviewQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestInterviewQuestionRequest.ProtoReflect.Descriptor instead.
 func (*SuggestInterviewQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{7}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SuggestInterviewQuestionRequest) GetContext() *SuggestInterviewQuestionRequest_Context {
@@ -591,7 +744,7 @@ type SuggestInterviewQuestionResponse struct {
 func (x *SuggestInterviewQuestionResponse) Reset() {
 	*x = SuggestInterviewQuestionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[8]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -604,7 +757,7 @@ func (x *SuggestInterviewQuestionResponse) String() string {
 func (*SuggestInterviewQuestionResponse) ProtoMessage() {}
 
 func (x *SuggestInterviewQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[8]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +770,7 @@ func (x *SuggestInterviewQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestInterviewQuestionResponse.ProtoReflect.Descriptor instead.
 func (*SuggestInterviewQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{8}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SuggestInterviewQuestionResponse) GetQuestions() []string {
@@ -642,7 +795,7 @@ type GeneralInfo struct {
 func (x *GeneralInfo) Reset() {
 	*x = GeneralInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[9]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -655,7 +808,7 @@ func (x *GeneralInfo) String() string {
 func (*GeneralInfo) ProtoMessage() {}
 
 func (x *GeneralInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[9]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +821,7 @@ func (x *GeneralInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneralInfo.ProtoReflect.Descriptor instead.
 func (*GeneralInfo) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{9}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GeneralInfo) GetTitle() string {
@@ -718,7 +871,7 @@ type CriteriaEleRequest struct {
 func (x *CriteriaEleRequest) Reset() {
 	*x = CriteriaEleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[10]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -731,7 +884,7 @@ func (x *CriteriaEleRequest) String() string {
 func (*CriteriaEleRequest) ProtoMessage() {}
 
 func (x *CriteriaEleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[10]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +897,7 @@ func (x *CriteriaEleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CriteriaEleRequest.ProtoReflect.Descriptor instead.
 func (*CriteriaEleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{10}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CriteriaEleRequest) GetCriteria() string {
@@ -773,7 +926,7 @@ type SuggestCriteriaRequest struct {
 func (x *SuggestCriteriaRequest) Reset() {
 	*x = SuggestCriteriaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[11]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -786,7 +939,7 @@ func (x *SuggestCriteriaRequest) String() string {
 func (*SuggestCriteriaRequest) ProtoMessage() {}
 
 func (x *SuggestCriteriaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[11]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,7 +952,7 @@ func (x *SuggestCriteriaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestCriteriaRequest.ProtoReflect.Descriptor instead.
 func (*SuggestCriteriaRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{11}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SuggestCriteriaRequest) GetGeneralInfo() *GeneralInfo {
@@ -828,7 +981,7 @@ type CriteriaEleResponse struct {
 func (x *CriteriaEleResponse) Reset() {
 	*x = CriteriaEleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[12]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -841,7 +994,7 @@ func (x *CriteriaEleResponse) String() string {
 func (*CriteriaEleResponse) ProtoMessage() {}
 
 func (x *CriteriaEleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[12]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1007,7 @@ func (x *CriteriaEleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CriteriaEleResponse.ProtoReflect.Descriptor instead.
 func (*CriteriaEleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{12}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CriteriaEleResponse) GetCriteria() string {
@@ -882,7 +1035,7 @@ type SuggestCriteriaResponse struct {
 func (x *SuggestCriteriaResponse) Reset() {
 	*x = SuggestCriteriaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[13]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +1048,7 @@ func (x *SuggestCriteriaResponse) String() string {
 func (*SuggestCriteriaResponse) ProtoMessage() {}
 
 func (x *SuggestCriteriaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[13]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1061,7 @@ func (x *SuggestCriteriaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestCriteriaResponse.ProtoReflect.Descriptor instead.
 func (*SuggestCriteriaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{13}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SuggestCriteriaResponse) GetCriteriaList() []*CriteriaEleResponse {
@@ -931,7 +1084,7 @@ type SuggestOptionsRequest struct {
 func (x *SuggestOptionsRequest) Reset() {
 	*x = SuggestOptionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[14]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -944,7 +1097,7 @@ func (x *SuggestOptionsRequest) String() string {
 func (*SuggestOptionsRequest) ProtoMessage() {}
 
 func (x *SuggestOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[14]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,7 +1110,7 @@ func (x *SuggestOptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestOptionsRequest.ProtoReflect.Descriptor instead.
 func (*SuggestOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{14}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SuggestOptionsRequest) GetGeneralInfo() *GeneralInfo {
@@ -992,7 +1145,7 @@ type SuggestOptionsResponse struct {
 func (x *SuggestOptionsResponse) Reset() {
 	*x = SuggestOptionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[15]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1005,7 +1158,7 @@ func (x *SuggestOptionsResponse) String() string {
 func (*SuggestOptionsResponse) ProtoMessage() {}
 
 func (x *SuggestOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[15]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1171,7 @@ func (x *SuggestOptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestOptionsResponse.ProtoReflect.Descriptor instead.
 func (*SuggestOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{15}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SuggestOptionsResponse) GetCriteriaList() *CriteriaEleResponse {
@@ -1040,7 +1193,7 @@ type AnswerOption struct {
 func (x *AnswerOption) Reset() {
 	*x = AnswerOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[16]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1053,7 +1206,7 @@ func (x *AnswerOption) String() string {
 func (*AnswerOption) ProtoMessage() {}
 
 func (x *AnswerOption) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[16]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,7 +1219,7 @@ func (x *AnswerOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerOption.ProtoReflect.Descriptor instead.
 func (*AnswerOption) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{16}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AnswerOption) GetOptionContent() string {
@@ -1097,7 +1250,7 @@ type Question struct {
 func (x *Question) Reset() {
 	*x = Question{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[17]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1110,7 +1263,7 @@ func (x *Question) String() string {
 func (*Question) ProtoMessage() {}
 
 func (x *Question) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[17]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,7 +1276,7 @@ func (x *Question) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Question.ProtoReflect.Descriptor instead.
 func (*Question) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{17}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Question) GetText() string {
@@ -1166,7 +1319,7 @@ type SuggestQuestionsResponse struct {
 func (x *SuggestQuestionsResponse) Reset() {
 	*x = SuggestQuestionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[18]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1179,7 +1332,7 @@ func (x *SuggestQuestionsResponse) String() string {
 func (*SuggestQuestionsResponse) ProtoMessage() {}
 
 func (x *SuggestQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[18]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1345,7 @@ func (x *SuggestQuestionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestQuestionsResponse.ProtoReflect.Descriptor instead.
 func (*SuggestQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{18}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SuggestQuestionsResponse) GetQuestions() []*Question {
@@ -1214,23 +1367,27 @@ type SuggestQuestionsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title             string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description       string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	MinutesToAnswer   int32    `protobuf:"varint,3,opt,name=minutesToAnswer,proto3" json:"minutesToAnswer,omitempty"`
-	Language          string   `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
-	Difficulty        string   `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	Tags              []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	Outlines          []string `protobuf:"bytes,7,rep,name=outlines,proto3" json:"outlines,omitempty"`
-	NumberOfQuestions int32    `protobuf:"varint,8,opt,name=numberOfQuestions,proto3" json:"numberOfQuestions,omitempty"`
-	NumberOfOptions   int32    `protobuf:"varint,9,opt,name=numberOfOptions,proto3" json:"numberOfOptions,omitempty"`
-	QuestionType      string   `protobuf:"bytes,10,opt,name=questionType,proto3" json:"questionType,omitempty"`
-	RequestKey        string   `protobuf:"bytes,11,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
+	Title             string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MinutesToAnswer   int32            `protobuf:"varint,3,opt,name=minutesToAnswer,proto3" json:"minutesToAnswer,omitempty"`
+	Language          string           `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Difficulty        string           `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Tags              []string         `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Outlines          []string         `protobuf:"bytes,7,rep,name=outlines,proto3" json:"outlines,omitempty"`
+	NumberOfQuestions int32            `protobuf:"varint,8,opt,name=numberOfQuestions,proto3" json:"numberOfQuestions,omitempty"`
+	NumberOfOptions   int32            `protobuf:"varint,9,opt,name=numberOfOptions,proto3" json:"numberOfOptions,omitempty"`
+	QuestionType      string           `protobuf:"bytes,10,opt,name=questionType,proto3" json:"questionType,omitempty"`
+	RequestKey        string           `protobuf:"bytes,11,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
+	CallbackUrl       string           `protobuf:"bytes,12,opt,name=callbackUrl,proto3" json:"callbackUrl,omitempty"`                                                                                      // Optional, receives a POST when the job succeeds or fails
+	CallbackSecret    string           `protobuf:"bytes,13,opt,name=callbackSecret,proto3" json:"callbackSecret,omitempty"`                                                                                // Signs the callback body with HMAC-SHA256 when set
+	TypeRatio         map[string]int32 `protobuf:"bytes,14,rep,name=typeRatio,proto3" json:"typeRatio,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // With MIXED, relative share of each question type, e.g. {"MCQ": 3, "TRUE_FALSE": 1}
+	ExcludedQuestions []string         `protobuf:"bytes,15,rep,name=excludedQuestions,proto3" json:"excludedQuestions,omitempty"`                                                                          // Texts of existing questions the generated ones must not repeat
 }
 
 func (x *SuggestQuestionsRequest) Reset() {
 	*x = SuggestQuestionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[19]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1243,7 +1400,7 @@ func (x *SuggestQuestionsRequest) String() string {
 func (*SuggestQuestionsRequest) ProtoMessage() {}
 
 func (x *SuggestQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[19]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1413,7 @@ func (x *SuggestQuestionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestQuestionsRequest.ProtoReflect.Descriptor instead.
 func (*SuggestQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{19}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SuggestQuestionsRequest) GetTitle() string {
@@ -1336,6 +1493,34 @@ func (x *SuggestQuestionsRequest) GetRequestKey() string {
 	return ""
 }
 
+func (x *SuggestQuestionsRequest) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *SuggestQuestionsRequest) GetCallbackSecret() string {
+	if x != nil {
+		return x.CallbackSecret
+	}
+	return ""
+}
+
+func (x *SuggestQuestionsRequest) GetTypeRatio() map[string]int32 {
+	if x != nil {
+		return x.TypeRatio
+	}
+	return nil
+}
+
+func (x *SuggestQuestionsRequest) GetExcludedQuestions() []string {
+	if x != nil {
+		return x.ExcludedQuestions
+	}
+	return nil
+}
+
 type ScoreInterviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1348,7 +1533,7 @@ type ScoreInterviewRequest struct {
 func (x *ScoreInterviewRequest) Reset() {
 	*x = ScoreInterviewRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[20]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +1546,7 @@ func (x *ScoreInterviewRequest) String() string {
 func (*ScoreInterviewRequest) ProtoMessage() {}
 
 func (x *ScoreInterviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[20]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1559,7 @@ func (x *ScoreInterviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreInterviewRequest.ProtoReflect.Descriptor instead.
 func (*ScoreInterviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{20}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ScoreInterviewRequest) GetSubmissions() []*ScoreInterviewRequest_Submission {
@@ -1407,7 +1592,7 @@ type ScoreInterviewResponse struct {
 func (x *ScoreInterviewResponse) Reset() {
 	*x = ScoreInterviewResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[21]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1420,7 +1605,7 @@ func (x *ScoreInterviewResponse) String() string {
 func (*ScoreInterviewResponse) ProtoMessage() {}
 
 func (x *ScoreInterviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[21]
+	mi := &file_proto_suggest_suggest_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,7 +1618,7 @@ func (x *ScoreInterviewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreInterviewResponse.ProtoReflect.Descriptor instead.
 func (*ScoreInterviewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{21}
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ScoreInterviewResponse) GetResult() []*ScoreInterviewResponse_Submission {
@@ -1478,36 +1663,31 @@ func (x *ScoreInterviewResponse) GetFinalComment() string {
 	return ""
 }
 
-type SuggestExamQuestionResponseV2_Quetion struct {
+type GetJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int32                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`         // Unique identifier for the question
-	TestId string                                `protobuf:"bytes,2,opt,name=testId,proto3" json:"testId,omitempty"`  // Identifier for the test this question belongs to
-	Text   string                                `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`      // The question text
-	Points int32                                 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"` // Points assigned to the question
-	Type   string                                `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`      // Type of question: "MCQ" for multiple choice, "LONG_ANSWER" for long answer
-	Detail *SuggestExamQuestionResponseV2_Detail `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`  // Detailed information about the question
+	RequestKey string `protobuf:"bytes,1,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) Reset() {
-	*x = SuggestExamQuestionResponseV2_Quetion{}
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[22]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) String() string {
+func (x *GetJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestExamQuestionResponseV2_Quetion) ProtoMessage() {}
+func (*GetJobRequest) ProtoMessage() {}
 
-func (x *SuggestExamQuestionResponseV2_Quetion) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[22]
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,83 +1698,162 @@ func (x *SuggestExamQuestionResponseV2_Quetion) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestExamQuestionResponseV2_Quetion.ProtoReflect.Descriptor instead.
-func (*SuggestExamQuestionResponseV2_Quetion) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) GetId() int32 {
+func (x *GetJobRequest) GetRequestKey() string {
 	if x != nil {
-		return x.Id
+		return x.RequestKey
 	}
-	return 0
+	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) GetTestId() string {
+type GetJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestKey   string                 `protobuf:"bytes,1,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
+	Kind         string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`           // Feature that produced the job, e.g. "f1_suggest_questions"
+	Status       string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`       // "pending", "running", "succeeded" or "failed"
+	ErrorCode    string                 `protobuf:"bytes,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"` // HTTP-style status code of the failure, empty unless failed
+	ErrorMessage string                 `protobuf:"bytes,5,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Attempts     int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetJobResponse) GetRequestKey() string {
 	if x != nil {
-		return x.TestId
+		return x.RequestKey
 	}
 	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) GetText() string {
+func (x *GetJobResponse) GetKind() string {
 	if x != nil {
-		return x.Text
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) GetPoints() int32 {
+func (x *GetJobResponse) GetStatus() string {
 	if x != nil {
-		return x.Points
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) GetType() string {
+func (x *GetJobResponse) GetErrorCode() string {
 	if x != nil {
-		return x.Type
+		return x.ErrorCode
 	}
 	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Quetion) GetDetail() *SuggestExamQuestionResponseV2_Detail {
+func (x *GetJobResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.Detail
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *GetJobResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GetJobResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type SuggestExamQuestionResponseV2_Detail struct {
+func (x *GetJobResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetJobResponse) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// The exam a single question belongs to, so an edited question still fits it.
+type QuestionContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type          string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                    // Type of question, e.g., "MCQ"
-	Options       []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`              // List of options for the MCQ
-	CorrectOption int32    `protobuf:"varint,3,opt,name=correctOption,proto3" json:"correctOption,omitempty"` // Index of the correct option, null if not applicable
-	ImageLinks    []string `protobuf:"bytes,4,rep,name=imageLinks,proto3" json:"imageLinks,omitempty"`        // Optional links to images related to the question
-	ExtraText     string   `protobuf:"bytes,5,opt,name=extraText,proto3" json:"extraText,omitempty"`          // Optional additional text for context
-	CorrectAnswer string   `protobuf:"bytes,6,opt,name=correctAnswer,proto3" json:"correctAnswer,omitempty"`  // The correct answer for long answer questions
+	Title          string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Language       string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // English, Vietnamese, ...
+	Topic          string   `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	Level          string   `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`                   // Intern, Junior, Middle, Senior, Lead or Expert
+	OtherQuestions []string `protobuf:"bytes,6,rep,name=otherQuestions,proto3" json:"otherQuestions,omitempty"` // Texts of the other exam questions, which must not be repeated
+	Creativity     int32    `protobuf:"varint,7,opt,name=creativity,proto3" json:"creativity,omitempty"`        // Creativity level from 1 to 10
+	CodeLanguage   string   `protobuf:"bytes,8,opt,name=codeLanguage,proto3" json:"codeLanguage,omitempty"`     // Programming language of CODE questions, python by default
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) Reset() {
-	*x = SuggestExamQuestionResponseV2_Detail{}
+func (x *QuestionContext) Reset() {
+	*x = QuestionContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[23]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) String() string {
+func (x *QuestionContext) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestExamQuestionResponseV2_Detail) ProtoMessage() {}
+func (*QuestionContext) ProtoMessage() {}
 
-func (x *SuggestExamQuestionResponseV2_Detail) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[23]
+func (x *QuestionContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,80 +1864,94 @@ func (x *SuggestExamQuestionResponseV2_Detail) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestExamQuestionResponseV2_Detail.ProtoReflect.Descriptor instead.
-func (*SuggestExamQuestionResponseV2_Detail) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use QuestionContext.ProtoReflect.Descriptor instead.
+func (*QuestionContext) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) GetType() string {
+func (x *QuestionContext) GetTitle() string {
 	if x != nil {
-		return x.Type
+		return x.Title
 	}
 	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) GetOptions() []string {
+func (x *QuestionContext) GetDescription() string {
 	if x != nil {
-		return x.Options
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) GetCorrectOption() int32 {
+func (x *QuestionContext) GetLanguage() string {
 	if x != nil {
-		return x.CorrectOption
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) GetImageLinks() []string {
+func (x *QuestionContext) GetTopic() string {
 	if x != nil {
-		return x.ImageLinks
+		return x.Topic
 	}
-	return nil
+	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) GetExtraText() string {
+func (x *QuestionContext) GetLevel() string {
 	if x != nil {
-		return x.ExtraText
+		return x.Level
 	}
 	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_Detail) GetCorrectAnswer() string {
+func (x *QuestionContext) GetOtherQuestions() []string {
 	if x != nil {
-		return x.CorrectAnswer
+		return x.OtherQuestions
+	}
+	return nil
+}
+
+func (x *QuestionContext) GetCreativity() int32 {
+	if x != nil {
+		return x.Creativity
+	}
+	return 0
+}
+
+func (x *QuestionContext) GetCodeLanguage() string {
+	if x != nil {
+		return x.CodeLanguage
 	}
 	return ""
 }
 
-type SuggestExamQuestionResponseV2_McqDetailCommonSchema struct {
+type RegenerateQuestionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type          string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                    // Type of question, e.g., "MCQ"
-	Options       []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`              // List of options for the MCQ
-	CorrectOption int32    `protobuf:"varint,3,opt,name=correctOption,proto3" json:"correctOption,omitempty"` // Index of the correct option, null if not applicable
+	Question   *SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Context    *QuestionContext                       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	RequestKey string                                 `protobuf:"bytes,3,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
 }
 
-func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) Reset() {
-	*x = SuggestExamQuestionResponseV2_McqDetailCommonSchema{}
+func (x *RegenerateQuestionRequest) Reset() {
+	*x = RegenerateQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[24]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) String() string {
+func (x *RegenerateQuestionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestExamQuestionResponseV2_McqDetailCommonSchema) ProtoMessage() {}
+func (*RegenerateQuestionRequest) ProtoMessage() {}
 
-func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[24]
+func (x *RegenerateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,60 +1962,60 @@ func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) ProtoReflect() pro
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestExamQuestionResponseV2_McqDetailCommonSchema.ProtoReflect.Descriptor instead.
-func (*SuggestExamQuestionResponseV2_McqDetailCommonSchema) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use RegenerateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) GetType() string {
+func (x *RegenerateQuestionRequest) GetQuestion() *SuggestExamQuestionResponseV2_Quetion {
 	if x != nil {
-		return x.Type
+		return x.Question
 	}
-	return ""
+	return nil
 }
 
-func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) GetOptions() []string {
+func (x *RegenerateQuestionRequest) GetContext() *QuestionContext {
 	if x != nil {
-		return x.Options
+		return x.Context
 	}
 	return nil
 }
 
-func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) GetCorrectOption() int32 {
+func (x *RegenerateQuestionRequest) GetRequestKey() string {
 	if x != nil {
-		return x.CorrectOption
+		return x.RequestKey
 	}
-	return 0
+	return ""
 }
 
-type SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema struct {
+type RewriteQuestionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type          string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                   // Type of question, e.g., "LONG_ANSWER"
-	ImageLinks    []string `protobuf:"bytes,2,rep,name=imageLinks,proto3" json:"imageLinks,omitempty"`       // Optional links to images related to the question
-	ExtraText     string   `protobuf:"bytes,3,opt,name=extraText,proto3" json:"extraText,omitempty"`         // Optional additional text for context
-	CorrectAnswer string   `protobuf:"bytes,4,opt,name=correctAnswer,proto3" json:"correctAnswer,omitempty"` // The correct answer for long answer questions
+	Question    *SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Context     *QuestionContext                       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Instruction string                                 `protobuf:"bytes,3,opt,name=instruction,proto3" json:"instruction,omitempty"` // What to change, e.g. "make harder" or "shorten"
+	RequestKey  string                                 `protobuf:"bytes,4,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
 }
 
-func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) Reset() {
-	*x = SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema{}
+func (x *RewriteQuestionRequest) Reset() {
+	*x = RewriteQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[25]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) String() string {
+func (x *RewriteQuestionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) ProtoMessage() {}
+func (*RewriteQuestionRequest) ProtoMessage() {}
 
-func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[25]
+func (x *RewriteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1753,65 +2026,67 @@ func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) ProtoReflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema.ProtoReflect.Descriptor instead.
-func (*SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use RewriteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*RewriteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetType() string {
+func (x *RewriteQuestionRequest) GetQuestion() *SuggestExamQuestionResponseV2_Quetion {
 	if x != nil {
-		return x.Type
+		return x.Question
 	}
-	return ""
+	return nil
 }
 
-func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetImageLinks() []string {
+func (x *RewriteQuestionRequest) GetContext() *QuestionContext {
 	if x != nil {
-		return x.ImageLinks
+		return x.Context
 	}
 	return nil
 }
 
-func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetExtraText() string {
+func (x *RewriteQuestionRequest) GetInstruction() string {
 	if x != nil {
-		return x.ExtraText
+		return x.Instruction
 	}
 	return ""
 }
 
-func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetCorrectAnswer() string {
+func (x *RewriteQuestionRequest) GetRequestKey() string {
 	if x != nil {
-		return x.CorrectAnswer
+		return x.RequestKey
 	}
 	return ""
 }
 
-type SuggestExamQuestionRequest_Context struct {
+type VaryQuestionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text  string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Links []string `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"` // External links
+	Question   *SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Context    *QuestionContext                       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Count      int32                                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // Number of variants, 1 to 10
+	RequestKey string                                 `protobuf:"bytes,4,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
 }
 
-func (x *SuggestExamQuestionRequest_Context) Reset() {
-	*x = SuggestExamQuestionRequest_Context{}
+func (x *VaryQuestionRequest) Reset() {
+	*x = VaryQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[26]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestExamQuestionRequest_Context) String() string {
+func (x *VaryQuestionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestExamQuestionRequest_Context) ProtoMessage() {}
+func (*VaryQuestionRequest) ProtoMessage() {}
 
-func (x *SuggestExamQuestionRequest_Context) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[26]
+func (x *VaryQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,59 +2097,65 @@ func (x *SuggestExamQuestionRequest_Context) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestExamQuestionRequest_Context.ProtoReflect.Descriptor instead.
-func (*SuggestExamQuestionRequest_Context) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use VaryQuestionRequest.ProtoReflect.Descriptor instead.
+func (*VaryQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SuggestExamQuestionRequest_Context) GetText() string {
+func (x *VaryQuestionRequest) GetQuestion() *SuggestExamQuestionResponseV2_Quetion {
 	if x != nil {
-		return x.Text
+		return x.Question
 	}
-	return ""
+	return nil
 }
 
-func (x *SuggestExamQuestionRequest_Context) GetLinks() []string {
+func (x *VaryQuestionRequest) GetContext() *QuestionContext {
 	if x != nil {
-		return x.Links
+		return x.Context
 	}
 	return nil
 }
 
-type SuggestInterviewQuestionRequest_Context struct {
+func (x *VaryQuestionRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VaryQuestionRequest) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+type QuestionEditResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position     string   `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	Experience   string   `protobuf:"bytes,2,opt,name=experience,proto3" json:"experience,omitempty"`
-	Language     string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	Models       string   `protobuf:"bytes,4,opt,name=models,proto3" json:"models,omitempty"`
-	Speed        int32    `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
-	Skills       []string `protobuf:"bytes,6,rep,name=skills,proto3" json:"skills,omitempty"`
-	MaxQuestions int32    `protobuf:"varint,7,opt,name=maxQuestions,proto3" json:"maxQuestions,omitempty"`
-	SkipIntro    bool     `protobuf:"varint,8,opt,name=skipIntro,proto3" json:"skipIntro,omitempty"`
-	SkipCode     bool     `protobuf:"varint,9,opt,name=skipCode,proto3" json:"skipCode,omitempty"`
-	InterviewId  string   `protobuf:"bytes,10,opt,name=interviewId,proto3" json:"interviewId,omitempty"`
+	Question   *SuggestExamQuestionResponseV2_Quetion     `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`     // Keeps the id and testId of the original question
+	Violations []*SuggestExamQuestionResponseV2_Violation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"` // Problems the repair pass could not fix
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) Reset() {
-	*x = SuggestInterviewQuestionRequest_Context{}
+func (x *QuestionEditResponse) Reset() {
+	*x = QuestionEditResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[27]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) String() string {
+func (x *QuestionEditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestInterviewQuestionRequest_Context) ProtoMessage() {}
+func (*QuestionEditResponse) ProtoMessage() {}
 
-func (x *SuggestInterviewQuestionRequest_Context) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[27]
+func (x *QuestionEditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,107 +2166,132 @@ func (x *SuggestInterviewQuestionRequest_Context) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestInterviewQuestionRequest_Context.ProtoReflect.Descriptor instead.
-func (*SuggestInterviewQuestionRequest_Context) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use QuestionEditResponse.ProtoReflect.Descriptor instead.
+func (*QuestionEditResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetPosition() string {
+func (x *QuestionEditResponse) GetQuestion() *SuggestExamQuestionResponseV2_Quetion {
 	if x != nil {
-		return x.Position
+		return x.Question
 	}
-	return ""
+	return nil
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetExperience() string {
+func (x *QuestionEditResponse) GetViolations() []*SuggestExamQuestionResponseV2_Violation {
 	if x != nil {
-		return x.Experience
+		return x.Violations
 	}
-	return ""
+	return nil
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetLanguage() string {
-	if x != nil {
-		return x.Language
-	}
-	return ""
+type TranslateExamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exam           *SuggestExamQuestionResponseV2 `protobuf:"bytes,1,opt,name=exam,proto3" json:"exam,omitempty"`
+	TargetLanguage string                         `protobuf:"bytes,2,opt,name=targetLanguage,proto3" json:"targetLanguage,omitempty"` // English, Vietnamese, ...
+	Glossary       []string                       `protobuf:"bytes,3,rep,name=glossary,proto3" json:"glossary,omitempty"`             // Terms kept exactly as written, e.g. product names or "goroutine"
+	RequestKey     string                         `protobuf:"bytes,4,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetModels() string {
-	if x != nil {
-		return x.Models
+func (x *TranslateExamRequest) Reset() {
+	*x = TranslateExamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetSpeed() int32 {
-	if x != nil {
-		return x.Speed
-	}
-	return 0
+func (x *TranslateExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetSkills() []string {
-	if x != nil {
-		return x.Skills
+func (*TranslateExamRequest) ProtoMessage() {}
+
+func (x *TranslateExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetMaxQuestions() int32 {
+// Deprecated: Use TranslateExamRequest.ProtoReflect.Descriptor instead.
+func (*TranslateExamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TranslateExamRequest) GetExam() *SuggestExamQuestionResponseV2 {
 	if x != nil {
-		return x.MaxQuestions
+		return x.Exam
 	}
-	return 0
+	return nil
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetSkipIntro() bool {
+func (x *TranslateExamRequest) GetTargetLanguage() string {
 	if x != nil {
-		return x.SkipIntro
+		return x.TargetLanguage
 	}
-	return false
+	return ""
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetSkipCode() bool {
+func (x *TranslateExamRequest) GetGlossary() []string {
 	if x != nil {
-		return x.SkipCode
+		return x.Glossary
 	}
-	return false
+	return nil
 }
 
-func (x *SuggestInterviewQuestionRequest_Context) GetInterviewId() string {
+func (x *TranslateExamRequest) GetRequestKey() string {
 	if x != nil {
-		return x.InterviewId
+		return x.RequestKey
 	}
 	return ""
 }
 
-type SuggestInterviewQuestionRequest_Submission struct {
+// A question of the question bank and where it came from.
+type BankQuestion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
-	Answer   string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
-}
-
-func (x *SuggestInterviewQuestionRequest_Submission) Reset() {
-	*x = SuggestInterviewQuestionRequest_Submission{}
+	Id         uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Question   *SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Topic      string                                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	Level      string                                 `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"` // Intern, Junior, Middle, Senior, Lead or Expert
+	Language   string                                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Tags       []string                               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	RequestKey string                                 `protobuf:"bytes,7,opt,name=requestKey,proto3" json:"requestKey,omitempty"` // Generation request the question came from
+	AuthorId   string                                 `protobuf:"bytes,8,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	Status     string                                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // "draft", "approved" or "rejected"
+	CreatedAt  *timestamppb.Timestamp                 `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp                 `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *BankQuestion) Reset() {
+	*x = BankQuestion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[28]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestInterviewQuestionRequest_Submission) String() string {
+func (x *BankQuestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestInterviewQuestionRequest_Submission) ProtoMessage() {}
+func (*BankQuestion) ProtoMessage() {}
 
-func (x *SuggestInterviewQuestionRequest_Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[28]
+func (x *BankQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,52 +2302,118 @@ func (x *SuggestInterviewQuestionRequest_Submission) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestInterviewQuestionRequest_Submission.ProtoReflect.Descriptor instead.
-func (*SuggestInterviewQuestionRequest_Submission) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{7, 1}
+// Deprecated: Use BankQuestion.ProtoReflect.Descriptor instead.
+func (*BankQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SuggestInterviewQuestionRequest_Submission) GetQuestion() string {
+func (x *BankQuestion) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BankQuestion) GetQuestion() *SuggestExamQuestionResponseV2_Quetion {
 	if x != nil {
 		return x.Question
 	}
+	return nil
+}
+
+func (x *BankQuestion) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
 	return ""
 }
 
-func (x *SuggestInterviewQuestionRequest_Submission) GetAnswer() string {
+func (x *BankQuestion) GetLevel() string {
 	if x != nil {
-		return x.Answer
+		return x.Level
 	}
 	return ""
 }
 
-type ScoreInterviewRequest_Submission struct {
+func (x *BankQuestion) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BankQuestion) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BankQuestion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SaveQuestionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index    int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Question string `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
-	Answer   string `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	Questions  []*SuggestExamQuestionResponseV2_Quetion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Topic      string                                   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Level      string                                   `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Language   string                                   `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Tags       []string                                 `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`             // Given to every saved question
+	RequestKey string                                   `protobuf:"bytes,6,opt,name=requestKey,proto3" json:"requestKey,omitempty"` // Generation request the questions came from
 }
 
-func (x *ScoreInterviewRequest_Submission) Reset() {
-	*x = ScoreInterviewRequest_Submission{}
+func (x *SaveQuestionsRequest) Reset() {
+	*x = SaveQuestionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[29]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScoreInterviewRequest_Submission) String() string {
+func (x *SaveQuestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoreInterviewRequest_Submission) ProtoMessage() {}
+func (*SaveQuestionsRequest) ProtoMessage() {}
 
-func (x *ScoreInterviewRequest_Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[29]
+func (x *SaveQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,59 +2424,78 @@ func (x *ScoreInterviewRequest_Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoreInterviewRequest_Submission.ProtoReflect.Descriptor instead.
-func (*ScoreInterviewRequest_Submission) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use SaveQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*SaveQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ScoreInterviewRequest_Submission) GetIndex() int32 {
+func (x *SaveQuestionsRequest) GetQuestions() []*SuggestExamQuestionResponseV2_Quetion {
 	if x != nil {
-		return x.Index
+		return x.Questions
 	}
-	return 0
+	return nil
 }
 
-func (x *ScoreInterviewRequest_Submission) GetQuestion() string {
+func (x *SaveQuestionsRequest) GetTopic() string {
 	if x != nil {
-		return x.Question
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *ScoreInterviewRequest_Submission) GetAnswer() string {
+func (x *SaveQuestionsRequest) GetLevel() string {
 	if x != nil {
-		return x.Answer
+		return x.Level
 	}
 	return ""
 }
 
-type ScoreInterviewResponse_Submission struct {
+func (x *SaveQuestionsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SaveQuestionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SaveQuestionsRequest) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+type SaveQuestionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index   int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	Score   string `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
+	Questions []*BankQuestion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
 }
 
-func (x *ScoreInterviewResponse_Submission) Reset() {
-	*x = ScoreInterviewResponse_Submission{}
+func (x *SaveQuestionsResponse) Reset() {
+	*x = SaveQuestionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[30]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScoreInterviewResponse_Submission) String() string {
+func (x *SaveQuestionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoreInterviewResponse_Submission) ProtoMessage() {}
+func (*SaveQuestionsResponse) ProtoMessage() {}
 
-func (x *ScoreInterviewResponse_Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[30]
+func (x *SaveQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,58 +2506,52 @@ func (x *ScoreInterviewResponse_Submission) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoreInterviewResponse_Submission.ProtoReflect.Descriptor instead.
-func (*ScoreInterviewResponse_Submission) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{21, 0}
-}
-
-func (x *ScoreInterviewResponse_Submission) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *ScoreInterviewResponse_Submission) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
+// Deprecated: Use SaveQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*SaveQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ScoreInterviewResponse_Submission) GetScore() string {
+func (x *SaveQuestionsResponse) GetQuestions() []*BankQuestion {
 	if x != nil {
-		return x.Score
+		return x.Questions
 	}
-	return ""
+	return nil
 }
 
-type ScoreInterviewResponse_SkillScore struct {
+type SearchQuestionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Skill string `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
-	Score string `protobuf:"bytes,2,opt,name=score,proto3" json:"score,omitempty"`
+	Query    string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Full-text search over the question text
+	Topic    string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Level    string   `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Type     string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Language string   `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Status   string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	AuthorId string   `protobuf:"bytes,7,opt,name=authorId,proto3" json:"authorId,omitempty"`   // Reviewers only; everyone else searches their own questions
+	Tags     []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`           // Questions must carry every tag
+	Page     int32    `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`          // Starts at 1
+	PageSize int32    `protobuf:"varint,10,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 20 by default, at most 100
 }
 
-func (x *ScoreInterviewResponse_SkillScore) Reset() {
-	*x = ScoreInterviewResponse_SkillScore{}
+func (x *SearchQuestionsRequest) Reset() {
+	*x = SearchQuestionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_suggest_suggest_proto_msgTypes[31]
+		mi := &file_proto_suggest_suggest_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScoreInterviewResponse_SkillScore) String() string {
+func (x *SearchQuestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoreInterviewResponse_SkillScore) ProtoMessage() {}
+func (*SearchQuestionsRequest) ProtoMessage() {}
 
-func (x *ScoreInterviewResponse_SkillScore) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_suggest_suggest_proto_msgTypes[31]
+func (x *SearchQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2177,489 +2562,3815 @@ func (x *ScoreInterviewResponse_SkillScore) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoreInterviewResponse_SkillScore.ProtoReflect.Descriptor instead.
-func (*ScoreInterviewResponse_SkillScore) Descriptor() ([]byte, []int) {
-	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{21, 1}
+// Deprecated: Use SearchQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ScoreInterviewResponse_SkillScore) GetSkill() string {
+func (x *SearchQuestionsRequest) GetQuery() string {
 	if x != nil {
-		return x.Skill
+		return x.Query
 	}
 	return ""
 }
 
-func (x *ScoreInterviewResponse_SkillScore) GetScore() string {
+func (x *SearchQuestionsRequest) GetTopic() string {
 	if x != nil {
-		return x.Score
+		return x.Topic
 	}
 	return ""
 }
 
-var File_proto_suggest_suggest_proto protoreflect.FileDescriptor
-
-var file_proto_suggest_suggest_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2f,
-	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x91, 0x06, 0x0a, 0x1d, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
-	0x32, 0x12, 0x4c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x51, 0x75, 0x65,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x1a,
-	0xb8, 0x01, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0xc0, 0x01, 0x0a, 0x06, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72,
-	0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x54, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0x6b, 0x0a,
-	0x15, 0x4d, 0x63, 0x71, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x96, 0x01, 0x0a, 0x1c, 0x4c,
-	0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x54, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
-	0x74, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x75, 0x6e, 0x69, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4a, 0x75, 0x6e, 0x69, 0x6f, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x69, 0x6f, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x65, 0x6e, 0x69, 0x6f, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x4c, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4c, 0x65,
-	0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x45, 0x78, 0x70, 0x65, 0x72, 0x74, 0x22, 0x74, 0x0a, 0x05, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x16, 0x64, 0x69, 0x66, 0x66, 0x69,
-	0x63, 0x75, 0x6c, 0x74, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x44, 0x69, 0x73, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
-	0x75, 0x6c, 0x74, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x96, 0x03, 0x0a, 0x1a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x12, 0x22, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b,
-	0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x4b, 0x65, 0x79, 0x1a, 0x33, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x6e, 0x0a, 0x1b, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x53, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x17,
-	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x22, 0xe0, 0x04, 0x0a, 0x1f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65,
-	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xa7, 0x02, 0x0a, 0x07, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6b,
-	0x69, 0x6c, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x70,
-	0x49, 0x6e, 0x74, 0x72, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69,
-	0x70, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x49,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69,
-	0x65, 0x77, 0x49, 0x64, 0x1a, 0x40, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x20, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x14,
-	0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x54, 0x0a, 0x12, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x45, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x63, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x13, 0x43, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5b, 0x0a,
-	0x17, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x72, 0x69, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x63, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x15, 0x53,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x75, 0x67, 0x67,
-	0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x0c,
-	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x6e, 0x65, 0x77, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22,
-	0x5a, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x63,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x0c, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x22,
-	0x76, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x18, 0x53, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x4b, 0x65, 0x79, 0x22, 0x83, 0x03, 0x0a, 0x17, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x75,
-	0x74, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
-	0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2c,
-	0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x15, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x75, 0x67, 0x67,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x1a, 0x56, 0x0a, 0x0a, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
-	0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x22, 0xbe, 0x04, 0x0a, 0x16, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x42, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x6b,
-	0x69, 0x6c, 0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46,
-	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x52, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x6b, 0x69,
-	0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x32, 0x8e, 0x07, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x67, 0x67,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67,
-	0x65, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x71, 0x0a, 0x0e,
-	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e,
-	0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x7e, 0x0a, 0x10, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x22, 0x20, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x9a, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x73,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65,
-	0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x69, 0x65, 0x77, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x71, 0x0a, 0x0e,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e,
-	0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x75, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
-	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a,
-	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x75,
-	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x32,
-	0x12, 0x23, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x22, 0x24, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x17, 0x5a, 0x15, 0x6d, 0x79, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+func (x *SearchQuestionsRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
 }
 
-var (
-	file_proto_suggest_suggest_proto_rawDescOnce sync.Once
-	file_proto_suggest_suggest_proto_rawDescData = file_proto_suggest_suggest_proto_rawDesc
-)
-
-func file_proto_suggest_suggest_proto_rawDescGZIP() []byte {
-	file_proto_suggest_suggest_proto_rawDescOnce.Do(func() {
-		file_proto_suggest_suggest_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_suggest_suggest_proto_rawDescData)
-	})
-	return file_proto_suggest_suggest_proto_rawDescData
+func (x *SearchQuestionsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-var file_proto_suggest_suggest_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
-var file_proto_suggest_suggest_proto_goTypes = []interface{}{
-	(*SuggestExamQuestionResponseV2)(nil),                              // 0: suggest.SuggestExamQuestionResponseV2
-	(*DifficultyDistribution)(nil),                                     // 1: suggest.DifficultyDistribution
-	(*Topic)(nil),                                                      // 2: suggest.Topic
-	(*SuggestExamQuestionRequest)(nil),                                 // 3: suggest.SuggestExamQuestionRequest
-	(*SuggestExamQuestionResponse)(nil),                                // 4: suggest.SuggestExamQuestionResponse
-	(*SuggestOutlinesRequest)(nil),                                     // 5: suggest.SuggestOutlinesRequest
-	(*SuggestOutlinesResponse)(nil),                                    // 6: suggest.SuggestOutlinesResponse
-	(*SuggestInterviewQuestionRequest)(nil),                            // 7: suggest.SuggestInterviewQuestionRequest
-	(*SuggestInterviewQuestionResponse)(nil),                           // 8: suggest.SuggestInterviewQuestionResponse
-	(*GeneralInfo)(nil),                                                // 9: suggest.GeneralInfo
-	(*CriteriaEleRequest)(nil),                                         // 10: suggest.CriteriaEleRequest
-	(*SuggestCriteriaRequest)(nil),                                     // 11: suggest.SuggestCriteriaRequest
-	(*CriteriaEleResponse)(nil),                                        // 12: suggest.CriteriaEleResponse
-	(*SuggestCriteriaResponse)(nil),                                    // 13: suggest.SuggestCriteriaResponse
-	(*SuggestOptionsRequest)(nil),                                      // 14: suggest.SuggestOptionsRequest
-	(*SuggestOptionsResponse)(nil),                                     // 15: suggest.SuggestOptionsResponse
-	(*AnswerOption)(nil),                                               // 16: suggest.AnswerOption
-	(*Question)(nil),                                                   // 17: suggest.Question
-	(*SuggestQuestionsResponse)(nil),                                   // 18: suggest.SuggestQuestionsResponse
-	(*SuggestQuestionsRequest)(nil),                                    // 19: suggest.SuggestQuestionsRequest
-	(*ScoreInterviewRequest)(nil),                                      // 20: suggest.ScoreInterviewRequest
-	(*ScoreInterviewResponse)(nil),                                     // 21: suggest.ScoreInterviewResponse
-	(*SuggestExamQuestionResponseV2_Quetion)(nil),                      // 22: suggest.SuggestExamQuestionResponseV2.Quetion
-	(*SuggestExamQuestionResponseV2_Detail)(nil),                       // 23: suggest.SuggestExamQuestionResponseV2.Detail
-	(*SuggestExamQuestionResponseV2_McqDetailCommonSchema)(nil),        // 24: suggest.SuggestExamQuestionResponseV2.McqDetailCommonSchema
-	(*SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema)(nil), // 25: suggest.SuggestExamQuestionResponseV2.LongAnswerDetailCommonSchema
-	(*SuggestExamQuestionRequest_Context)(nil),                         // 26: suggest.SuggestExamQuestionRequest.Context
-	(*SuggestInterviewQuestionRequest_Context)(nil),                    // 27: suggest.SuggestInterviewQuestionRequest.Context
-	(*SuggestInterviewQuestionRequest_Submission)(nil),                 // 28: suggest.SuggestInterviewQuestionRequest.Submission
-	(*ScoreInterviewRequest_Submission)(nil),                           // 29: suggest.ScoreInterviewRequest.Submission
-	(*ScoreInterviewResponse_Submission)(nil),                          // 30: suggest.ScoreInterviewResponse.Submission
-	(*ScoreInterviewResponse_SkillScore)(nil),                          // 31: suggest.ScoreInterviewResponse.SkillScore
-	nil, // 32: suggest.ScoreInterviewResponse.TotalScoreEntry
+func (x *SearchQuestionsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
 }
-var file_proto_suggest_suggest_proto_depIdxs = []int32{
-	22, // 0: suggest.SuggestExamQuestionResponseV2.questions:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
-	1,  // 1: suggest.Topic.difficultyDistribution:type_name -> suggest.DifficultyDistribution
-	2,  // 2: suggest.SuggestExamQuestionRequest.topics:type_name -> suggest.Topic
-	26, // 3: suggest.SuggestExamQuestionRequest.context:type_name -> suggest.SuggestExamQuestionRequest.Context
-	17, // 4: suggest.SuggestExamQuestionResponse.questions:type_name -> suggest.Question
-	27, // 5: suggest.SuggestInterviewQuestionRequest.context:type_name -> suggest.SuggestInterviewQuestionRequest.Context
-	28, // 6: suggest.SuggestInterviewQuestionRequest.submissions:type_name -> suggest.SuggestInterviewQuestionRequest.Submission
-	9,  // 7: suggest.SuggestCriteriaRequest.generalInfo:type_name -> suggest.GeneralInfo
-	10, // 8: suggest.SuggestCriteriaRequest.criteriaList:type_name -> suggest.CriteriaEleRequest
-	12, // 9: suggest.SuggestCriteriaResponse.criteriaList:type_name -> suggest.CriteriaEleResponse
-	9,  // 10: suggest.SuggestOptionsRequest.generalInfo:type_name -> suggest.GeneralInfo
-	10, // 11: suggest.SuggestOptionsRequest.criteriaList:type_name -> suggest.CriteriaEleRequest
-	12, // 12: suggest.SuggestOptionsResponse.criteriaList:type_name -> suggest.CriteriaEleResponse
-	17, // 13: suggest.SuggestQuestionsResponse.questions:type_name -> suggest.Question
-	29, // 14: suggest.ScoreInterviewRequest.submissions:type_name -> suggest.ScoreInterviewRequest.Submission
-	30, // 15: suggest.ScoreInterviewResponse.result:type_name -> suggest.ScoreInterviewResponse.Submission
-	31, // 16: suggest.ScoreInterviewResponse.skills:type_name -> suggest.ScoreInterviewResponse.SkillScore
-	32, // 17: suggest.ScoreInterviewResponse.totalScore:type_name -> suggest.ScoreInterviewResponse.TotalScoreEntry
-	23, // 18: suggest.SuggestExamQuestionResponseV2.Quetion.detail:type_name -> suggest.SuggestExamQuestionResponseV2.Detail
-	11, // 19: suggest.SuggestService.SuggestCriteria:input_type -> suggest.SuggestCriteriaRequest
-	14, // 20: suggest.SuggestService.SuggestOptions:input_type -> suggest.SuggestOptionsRequest
-	19, // 21: suggest.SuggestService.SuggestQuestions:input_type -> suggest.SuggestQuestionsRequest
-	7,  // 22: suggest.SuggestService.SuggestInterviewQuestion:input_type -> suggest.SuggestInterviewQuestionRequest
-	20, // 23: suggest.SuggestService.ScoreInterview:input_type -> suggest.ScoreInterviewRequest
-	5,  // 24: suggest.SuggestService.SuggestOutlines:input_type -> suggest.SuggestOutlinesRequest
-	3,  // 25: suggest.SuggestService.SuggestExamQuestionV2:input_type -> suggest.SuggestExamQuestionRequest
-	13, // 26: suggest.SuggestService.SuggestCriteria:output_type -> suggest.SuggestCriteriaResponse
-	15, // 27: suggest.SuggestService.SuggestOptions:output_type -> suggest.SuggestOptionsResponse
-	0,  // 28: suggest.SuggestService.SuggestQuestions:output_type -> suggest.SuggestExamQuestionResponseV2
-	8,  // 29: suggest.SuggestService.SuggestInterviewQuestion:output_type -> suggest.SuggestInterviewQuestionResponse
-	21, // 30: suggest.SuggestService.ScoreInterview:output_type -> suggest.ScoreInterviewResponse
-	6,  // 31: suggest.SuggestService.SuggestOutlines:output_type -> suggest.SuggestOutlinesResponse
-	0,  // 32: suggest.SuggestService.SuggestExamQuestionV2:output_type -> suggest.SuggestExamQuestionResponseV2
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+
+func (x *SearchQuestionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func init() { file_proto_suggest_suggest_proto_init() }
+func (x *SearchQuestionsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *SearchQuestionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchQuestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchQuestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchQuestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions []*BankQuestion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Total     int64           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Number of matching questions over all pages
+	Page      int32           `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32           `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+}
+
+func (x *SearchQuestionsResponse) Reset() {
+	*x = SearchQuestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQuestionsResponse) ProtoMessage() {}
+
+func (x *SearchQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchQuestionsResponse) GetQuestions() []*BankQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *SearchQuestionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchQuestionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchQuestionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UpdateQuestionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "draft", "approved" or "rejected"
+}
+
+func (x *UpdateQuestionStatusRequest) Reset() {
+	*x = UpdateQuestionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateQuestionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionStatusRequest) ProtoMessage() {}
+
+func (x *UpdateQuestionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateQuestionStatusRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateQuestionStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AssembleExamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blueprint       *SuggestExamQuestionRequest `protobuf:"bytes,1,opt,name=blueprint,proto3" json:"blueprint,omitempty"`              // Topics and their difficulty distribution, language and question types
+	TotalPoints     int32                       `protobuf:"varint,2,opt,name=totalPoints,proto3" json:"totalPoints,omitempty"`         // Points the exam should add up to, 0 for no target
+	MinutesToAnswer int32                       `protobuf:"varint,3,opt,name=minutesToAnswer,proto3" json:"minutesToAnswer,omitempty"` // Time budget of the exam in minutes, 0 for no budget
+	CandidatePool   string                      `protobuf:"bytes,4,opt,name=candidatePool,proto3" json:"candidatePool,omitempty"`      // Who takes the exam, e.g. a hiring campaign; questions the pool saw recently are not reused
+	Tags            []string                    `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                        // Bank questions must carry every tag
+}
+
+func (x *AssembleExamRequest) Reset() {
+	*x = AssembleExamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssembleExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssembleExamRequest) ProtoMessage() {}
+
+func (x *AssembleExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssembleExamRequest.ProtoReflect.Descriptor instead.
+func (*AssembleExamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AssembleExamRequest) GetBlueprint() *SuggestExamQuestionRequest {
+	if x != nil {
+		return x.Blueprint
+	}
+	return nil
+}
+
+func (x *AssembleExamRequest) GetTotalPoints() int32 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *AssembleExamRequest) GetMinutesToAnswer() int32 {
+	if x != nil {
+		return x.MinutesToAnswer
+	}
+	return 0
+}
+
+func (x *AssembleExamRequest) GetCandidatePool() string {
+	if x != nil {
+		return x.CandidatePool
+	}
+	return ""
+}
+
+func (x *AssembleExamRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Where an assembled question comes from.
+type AssembledItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId     int32   `protobuf:"varint,1,opt,name=questionId,proto3" json:"questionId,omitempty"`
+	Source         string  `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                  // "bank" or "generated"
+	BankQuestionId uint64  `protobuf:"varint,3,opt,name=bankQuestionId,proto3" json:"bankQuestionId,omitempty"` // Id in the question bank, 0 for generated questions
+	Topic          string  `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	Level          string  `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
+	Minutes        float32 `protobuf:"fixed32,6,opt,name=minutes,proto3" json:"minutes,omitempty"` // Estimated time to answer
+}
+
+func (x *AssembledItem) Reset() {
+	*x = AssembledItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssembledItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssembledItem) ProtoMessage() {}
+
+func (x *AssembledItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssembledItem.ProtoReflect.Descriptor instead.
+func (*AssembledItem) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AssembledItem) GetQuestionId() int32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *AssembledItem) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AssembledItem) GetBankQuestionId() uint64 {
+	if x != nil {
+		return x.BankQuestionId
+	}
+	return 0
+}
+
+func (x *AssembledItem) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AssembledItem) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *AssembledItem) GetMinutes() float32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type AssembleExamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions        []*SuggestExamQuestionResponseV2_Quetion   `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Items            []*AssembledItem                           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // One per question, in the same order
+	TotalPoints      int32                                      `protobuf:"varint,3,opt,name=totalPoints,proto3" json:"totalPoints,omitempty"`
+	EstimatedMinutes float32                                    `protobuf:"fixed32,4,opt,name=estimatedMinutes,proto3" json:"estimatedMinutes,omitempty"`
+	Violations       []*SuggestExamQuestionResponseV2_Violation `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"` // Includes points_total and time_budget when a target is missed
+	RequestKey       string                                     `protobuf:"bytes,6,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
+}
+
+func (x *AssembleExamResponse) Reset() {
+	*x = AssembleExamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssembleExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssembleExamResponse) ProtoMessage() {}
+
+func (x *AssembleExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssembleExamResponse.ProtoReflect.Descriptor instead.
+func (*AssembleExamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssembleExamResponse) GetQuestions() []*SuggestExamQuestionResponseV2_Quetion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *AssembleExamResponse) GetItems() []*AssembledItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AssembleExamResponse) GetTotalPoints() int32 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *AssembleExamResponse) GetEstimatedMinutes() float32 {
+	if x != nil {
+		return x.EstimatedMinutes
+	}
+	return 0
+}
+
+func (x *AssembleExamResponse) GetViolations() []*SuggestExamQuestionResponseV2_Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *AssembleExamResponse) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+type UploadDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Format  string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "markdown", "html", "text" or "pdf", the text of a PDF with pages separated by form feeds
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UploadDocumentRequest) Reset() {
+	*x = UploadDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentRequest) ProtoMessage() {}
+
+func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UploadDocumentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UploadDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId uint64 `protobuf:"varint,1,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	Title      string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ChunkCount int32  `protobuf:"varint,3,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"` // Passages the document was split into
+}
+
+func (x *UploadDocumentResponse) Reset() {
+	*x = UploadDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentResponse) ProtoMessage() {}
+
+func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UploadDocumentResponse) GetDocumentId() uint64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *UploadDocumentResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UploadDocumentResponse) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+type ExportExamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exam   *SuggestExamQuestionResponseV2 `protobuf:"bytes,1,opt,name=exam,proto3" json:"exam,omitempty"`
+	Format string                         `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "qti" (a QTI 2.1 zip package), "moodle_xml", "gift", "aiken" or "csv"
+	Title  string                         `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`   // Names the file, and the question category where the format has one
+}
+
+func (x *ExportExamRequest) Reset() {
+	*x = ExportExamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportExamRequest) ProtoMessage() {}
+
+func (x *ExportExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportExamRequest.ProtoReflect.Descriptor instead.
+func (*ExportExamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportExamRequest) GetExam() *SuggestExamQuestionResponseV2 {
+	if x != nil {
+		return x.Exam
+	}
+	return nil
+}
+
+func (x *ExportExamRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportExamRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type ExportExamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName    string                                     `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ContentType string                                     `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content     []byte                                     `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Skipped     []*SuggestExamQuestionResponseV2_Violation `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"` // Questions the format can't express, left out of the file
+}
+
+func (x *ExportExamResponse) Reset() {
+	*x = ExportExamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportExamResponse) ProtoMessage() {}
+
+func (x *ExportExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportExamResponse.ProtoReflect.Descriptor instead.
+func (*ExportExamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportExamResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportExamResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportExamResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportExamResponse) GetSkipped() []*SuggestExamQuestionResponseV2_Violation {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ImportQuestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format  string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // "moodle_xml", "gift", "aiken" or "csv", laid out like ExportExam writes it
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ImportQuestionsRequest) Reset() {
+	*x = ImportQuestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQuestionsRequest) ProtoMessage() {}
+
+func (x *ImportQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportQuestionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportQuestionsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportQuestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions  []*SuggestExamQuestionResponseV2_Quetion   `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`   // Numbered from 1 in the order of the file
+	Violations []*SuggestExamQuestionResponseV2_Violation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"` // Questions of the file that were left out
+}
+
+func (x *ImportQuestionsResponse) Reset() {
+	*x = ImportQuestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQuestionsResponse) ProtoMessage() {}
+
+func (x *ImportQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportQuestionsResponse) GetQuestions() []*SuggestExamQuestionResponseV2_Quetion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ImportQuestionsResponse) GetViolations() []*SuggestExamQuestionResponseV2_Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type SuggestExamQuestionResponseV2_Quetion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int32                                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // Unique identifier for the question
+	TestId           string                                    `protobuf:"bytes,2,opt,name=testId,proto3" json:"testId,omitempty"`                        // Identifier for the test this question belongs to
+	Text             string                                    `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`                            // The question text
+	Points           int32                                     `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`                       // Points assigned to the question
+	Type             string                                    `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                            // Type of question: MCQ, LONG_ANSWER, TRUE_FALSE, MULTI_SELECT, FILL_IN_BLANK, MATCHING, ORDERING or CODE
+	Detail           *SuggestExamQuestionResponseV2_Detail     `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`                        // Detailed information about the question
+	KeyCheck         *SuggestExamQuestionResponseV2_KeyCheck   `protobuf:"bytes,7,opt,name=keyCheck,proto3" json:"keyCheck,omitempty"`                    // Blind check of the answer key, set on MCQs when verification is on
+	Citations        []*SuggestExamQuestionResponseV2_Citation `protobuf:"bytes,8,rep,name=citations,proto3" json:"citations,omitempty"`                  // Passages of the reference documents the question is based on
+	BloomLevel       string                                    `protobuf:"bytes,9,opt,name=bloomLevel,proto3" json:"bloomLevel,omitempty"`                // Cognitive level of Bloom's taxonomy: REMEMBER, UNDERSTAND, APPLY, ANALYZE, EVALUATE or CREATE
+	EstimatedMinutes float32                                   `protobuf:"fixed32,10,opt,name=estimatedMinutes,proto3" json:"estimatedMinutes,omitempty"` // About how long a candidate takes to answer the question
+	TagCheck         *SuggestExamQuestionResponseV2_TagCheck   `protobuf:"bytes,11,opt,name=tagCheck,proto3" json:"tagCheck,omitempty"`                   // Second classification of the Bloom level and solve time, set when classification is on
+	CodeCheck        *SuggestExamQuestionResponseV2_CodeCheck  `protobuf:"bytes,12,opt,name=codeCheck,proto3" json:"codeCheck,omitempty"`                 // Sandbox run of the reference solution, set on CODE questions
+	Topic            string                                    `protobuf:"bytes,13,opt,name=topic,proto3" json:"topic,omitempty"`                         // Topic of the requested breakdown the question belongs to
+	Level            string                                    `protobuf:"bytes,14,opt,name=level,proto3" json:"level,omitempty"`                         // Difficulty level of the requested breakdown the question belongs to: Intern, Junior, Middle, Senior, Lead or Expert
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) Reset() {
+	*x = SuggestExamQuestionResponseV2_Quetion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_Quetion) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_Quetion.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_Quetion) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetTestId() string {
+	if x != nil {
+		return x.TestId
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetDetail() *SuggestExamQuestionResponseV2_Detail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetKeyCheck() *SuggestExamQuestionResponseV2_KeyCheck {
+	if x != nil {
+		return x.KeyCheck
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetCitations() []*SuggestExamQuestionResponseV2_Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetBloomLevel() string {
+	if x != nil {
+		return x.BloomLevel
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetEstimatedMinutes() float32 {
+	if x != nil {
+		return x.EstimatedMinutes
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetTagCheck() *SuggestExamQuestionResponseV2_TagCheck {
+	if x != nil {
+		return x.TagCheck
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetCodeCheck() *SuggestExamQuestionResponseV2_CodeCheck {
+	if x != nil {
+		return x.CodeCheck
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Quetion) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SuggestExamQuestionResponseV2_Detail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                    // Type of question, e.g., "MCQ"
+	Options       []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`              // List of options for the MCQ
+	CorrectOption int32    `protobuf:"varint,3,opt,name=correctOption,proto3" json:"correctOption,omitempty"` // Index of the correct option, null if not applicable
+	ImageLinks    []string `protobuf:"bytes,4,rep,name=imageLinks,proto3" json:"imageLinks,omitempty"`        // Optional links to images related to the question
+	ExtraText     string   `protobuf:"bytes,5,opt,name=extraText,proto3" json:"extraText,omitempty"`          // Optional additional text for context
+	CorrectAnswer string   `protobuf:"bytes,6,opt,name=correctAnswer,proto3" json:"correctAnswer,omitempty"`  // The correct answer for long answer questions
+	// Answer key of the other question types, the one matching type is set.
+	//
+	// Types that are assignable to Answer:
+	//	*SuggestExamQuestionResponseV2_Detail_TrueFalse
+	//	*SuggestExamQuestionResponseV2_Detail_MultiSelect
+	//	*SuggestExamQuestionResponseV2_Detail_FillInBlank
+	//	*SuggestExamQuestionResponseV2_Detail_Matching
+	//	*SuggestExamQuestionResponseV2_Detail_Ordering
+	//	*SuggestExamQuestionResponseV2_Detail_Code
+	Answer             isSuggestExamQuestionResponseV2_Detail_Answer      `protobuf_oneof:"answer"`
+	OptionExplanations []*SuggestExamQuestionResponseV2_OptionExplanation `protobuf:"bytes,13,rep,name=optionExplanations,proto3" json:"optionExplanations,omitempty"` // One per MCQ option, in the same order as options
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) Reset() {
+	*x = SuggestExamQuestionResponseV2_Detail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_Detail) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_Detail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_Detail.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_Detail) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetCorrectOption() int32 {
+	if x != nil {
+		return x.CorrectOption
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetImageLinks() []string {
+	if x != nil {
+		return x.ImageLinks
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetExtraText() string {
+	if x != nil {
+		return x.ExtraText
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetCorrectAnswer() string {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return ""
+}
+
+func (m *SuggestExamQuestionResponseV2_Detail) GetAnswer() isSuggestExamQuestionResponseV2_Detail_Answer {
+	if m != nil {
+		return m.Answer
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetTrueFalse() *SuggestExamQuestionResponseV2_TrueFalseDetail {
+	if x, ok := x.GetAnswer().(*SuggestExamQuestionResponseV2_Detail_TrueFalse); ok {
+		return x.TrueFalse
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetMultiSelect() *SuggestExamQuestionResponseV2_MultiSelectDetail {
+	if x, ok := x.GetAnswer().(*SuggestExamQuestionResponseV2_Detail_MultiSelect); ok {
+		return x.MultiSelect
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetFillInBlank() *SuggestExamQuestionResponseV2_FillInBlankDetail {
+	if x, ok := x.GetAnswer().(*SuggestExamQuestionResponseV2_Detail_FillInBlank); ok {
+		return x.FillInBlank
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetMatching() *SuggestExamQuestionResponseV2_MatchingDetail {
+	if x, ok := x.GetAnswer().(*SuggestExamQuestionResponseV2_Detail_Matching); ok {
+		return x.Matching
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetOrdering() *SuggestExamQuestionResponseV2_OrderingDetail {
+	if x, ok := x.GetAnswer().(*SuggestExamQuestionResponseV2_Detail_Ordering); ok {
+		return x.Ordering
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetCode() *SuggestExamQuestionResponseV2_CodeDetail {
+	if x, ok := x.GetAnswer().(*SuggestExamQuestionResponseV2_Detail_Code); ok {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_Detail) GetOptionExplanations() []*SuggestExamQuestionResponseV2_OptionExplanation {
+	if x != nil {
+		return x.OptionExplanations
+	}
+	return nil
+}
+
+type isSuggestExamQuestionResponseV2_Detail_Answer interface {
+	isSuggestExamQuestionResponseV2_Detail_Answer()
+}
+
+type SuggestExamQuestionResponseV2_Detail_TrueFalse struct {
+	TrueFalse *SuggestExamQuestionResponseV2_TrueFalseDetail `protobuf:"bytes,7,opt,name=trueFalse,proto3,oneof"`
+}
+
+type SuggestExamQuestionResponseV2_Detail_MultiSelect struct {
+	MultiSelect *SuggestExamQuestionResponseV2_MultiSelectDetail `protobuf:"bytes,8,opt,name=multiSelect,proto3,oneof"`
+}
+
+type SuggestExamQuestionResponseV2_Detail_FillInBlank struct {
+	FillInBlank *SuggestExamQuestionResponseV2_FillInBlankDetail `protobuf:"bytes,9,opt,name=fillInBlank,proto3,oneof"`
+}
+
+type SuggestExamQuestionResponseV2_Detail_Matching struct {
+	Matching *SuggestExamQuestionResponseV2_MatchingDetail `protobuf:"bytes,10,opt,name=matching,proto3,oneof"`
+}
+
+type SuggestExamQuestionResponseV2_Detail_Ordering struct {
+	Ordering *SuggestExamQuestionResponseV2_OrderingDetail `protobuf:"bytes,11,opt,name=ordering,proto3,oneof"`
+}
+
+type SuggestExamQuestionResponseV2_Detail_Code struct {
+	Code *SuggestExamQuestionResponseV2_CodeDetail `protobuf:"bytes,12,opt,name=code,proto3,oneof"`
+}
+
+func (*SuggestExamQuestionResponseV2_Detail_TrueFalse) isSuggestExamQuestionResponseV2_Detail_Answer() {
+}
+
+func (*SuggestExamQuestionResponseV2_Detail_MultiSelect) isSuggestExamQuestionResponseV2_Detail_Answer() {
+}
+
+func (*SuggestExamQuestionResponseV2_Detail_FillInBlank) isSuggestExamQuestionResponseV2_Detail_Answer() {
+}
+
+func (*SuggestExamQuestionResponseV2_Detail_Matching) isSuggestExamQuestionResponseV2_Detail_Answer() {
+}
+
+func (*SuggestExamQuestionResponseV2_Detail_Ordering) isSuggestExamQuestionResponseV2_Detail_Answer() {
+}
+
+func (*SuggestExamQuestionResponseV2_Detail_Code) isSuggestExamQuestionResponseV2_Detail_Answer() {}
+
+type SuggestExamQuestionResponseV2_OptionExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Explanation   string `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation,omitempty"`     // Why the option is correct, or why it is wrong
+	Misconception string `protobuf:"bytes,2,opt,name=misconception,proto3" json:"misconception,omitempty"` // Short tag of the misconception a distractor targets, e.g. "off-by-one"; empty for the correct option
+}
+
+func (x *SuggestExamQuestionResponseV2_OptionExplanation) Reset() {
+	*x = SuggestExamQuestionResponseV2_OptionExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_OptionExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_OptionExplanation) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_OptionExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_OptionExplanation.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_OptionExplanation) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *SuggestExamQuestionResponseV2_OptionExplanation) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_OptionExplanation) GetMisconception() string {
+	if x != nil {
+		return x.Misconception
+	}
+	return ""
+}
+
+type SuggestExamQuestionResponseV2_TrueFalseDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrectAnswer bool `protobuf:"varint,1,opt,name=correctAnswer,proto3" json:"correctAnswer,omitempty"` // Whether the statement in the question text is true
+}
+
+func (x *SuggestExamQuestionResponseV2_TrueFalseDetail) Reset() {
+	*x = SuggestExamQuestionResponseV2_TrueFalseDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_TrueFalseDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_TrueFalseDetail) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_TrueFalseDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_TrueFalseDetail.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_TrueFalseDetail) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *SuggestExamQuestionResponseV2_TrueFalseDetail) GetCorrectAnswer() bool {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return false
+}
+
+type SuggestExamQuestionResponseV2_MultiSelectDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options        []string `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	CorrectOptions []int32  `protobuf:"varint,2,rep,packed,name=correctOptions,proto3" json:"correctOptions,omitempty"` // Indexes of every correct option
+}
+
+func (x *SuggestExamQuestionResponseV2_MultiSelectDetail) Reset() {
+	*x = SuggestExamQuestionResponseV2_MultiSelectDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_MultiSelectDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_MultiSelectDetail) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_MultiSelectDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_MultiSelectDetail.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_MultiSelectDetail) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *SuggestExamQuestionResponseV2_MultiSelectDetail) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_MultiSelectDetail) GetCorrectOptions() []int32 {
+	if x != nil {
+		return x.CorrectOptions
+	}
+	return nil
+}
+
+type SuggestExamQuestionResponseV2_FillInBlankDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcceptedAnswers []string `protobuf:"bytes,1,rep,name=acceptedAnswers,proto3" json:"acceptedAnswers,omitempty"` // Answers accepted for the blank (___) in the question text
+	CaseSensitive   bool     `protobuf:"varint,2,opt,name=caseSensitive,proto3" json:"caseSensitive,omitempty"`
+}
+
+func (x *SuggestExamQuestionResponseV2_FillInBlankDetail) Reset() {
+	*x = SuggestExamQuestionResponseV2_FillInBlankDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_FillInBlankDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_FillInBlankDetail) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_FillInBlankDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_FillInBlankDetail.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_FillInBlankDetail) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *SuggestExamQuestionResponseV2_FillInBlankDetail) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_FillInBlankDetail) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+type SuggestExamQuestionResponseV2_MatchingDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*SuggestExamQuestionResponseV2_MatchingDetail_Pair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // Matching pairs, clients shuffle the right side
+}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail) Reset() {
+	*x = SuggestExamQuestionResponseV2_MatchingDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_MatchingDetail) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_MatchingDetail.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_MatchingDetail) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail) GetPairs() []*SuggestExamQuestionResponseV2_MatchingDetail_Pair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type SuggestExamQuestionResponseV2_OrderingDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Items in their correct order, clients shuffle them
+}
+
+func (x *SuggestExamQuestionResponseV2_OrderingDetail) Reset() {
+	*x = SuggestExamQuestionResponseV2_OrderingDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_OrderingDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_OrderingDetail) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_OrderingDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_OrderingDetail.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_OrderingDetail) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *SuggestExamQuestionResponseV2_OrderingDetail) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SuggestExamQuestionResponseV2_CodeDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language          string                                               `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"` // Programming language, e.g. "python"
+	StarterCode       string                                               `protobuf:"bytes,2,opt,name=starterCode,proto3" json:"starterCode,omitempty"`
+	ReferenceSolution string                                               `protobuf:"bytes,3,opt,name=referenceSolution,proto3" json:"referenceSolution,omitempty"` // Checked to pass every test case before the question is returned
+	TestCases         []*SuggestExamQuestionResponseV2_CodeDetail_TestCase `protobuf:"bytes,4,rep,name=testCases,proto3" json:"testCases,omitempty"`
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail) Reset() {
+	*x = SuggestExamQuestionResponseV2_CodeDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_CodeDetail) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_CodeDetail.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_CodeDetail) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 8}
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail) GetStarterCode() string {
+	if x != nil {
+		return x.StarterCode
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail) GetReferenceSolution() string {
+	if x != nil {
+		return x.ReferenceSolution
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail) GetTestCases() []*SuggestExamQuestionResponseV2_CodeDetail_TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+// The outcome of a second, blind solving pass that answers an MCQ without seeing its key.
+type SuggestExamQuestionResponseV2_KeyCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confidence        float32 `protobuf:"fixed32,1,opt,name=confidence,proto3" json:"confidence,omitempty"`                     // How likely the key is right (0-1), low when the solver disagrees or finds several defensible options
+	SolverOption      int32   `protobuf:"varint,2,opt,name=solverOption,proto3" json:"solverOption,omitempty"`                  // Index of the option the solver picked
+	DefensibleOptions []int32 `protobuf:"varint,3,rep,packed,name=defensibleOptions,proto3" json:"defensibleOptions,omitempty"` // Every option the solver found defensible
+	Reason            string  `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                               // The solver's short justification
+}
+
+func (x *SuggestExamQuestionResponseV2_KeyCheck) Reset() {
+	*x = SuggestExamQuestionResponseV2_KeyCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_KeyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_KeyCheck) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_KeyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_KeyCheck.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_KeyCheck) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *SuggestExamQuestionResponseV2_KeyCheck) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_KeyCheck) GetSolverOption() int32 {
+	if x != nil {
+		return x.SolverOption
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_KeyCheck) GetDefensibleOptions() []int32 {
+	if x != nil {
+		return x.DefensibleOptions
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_KeyCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// The outcome of running the reference solution of a CODE question against its test cases.
+type SuggestExamQuestionResponseV2_CodeCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verified bool   `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"` // The reference solution passed every test case
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`      // Why it failed or could not be run, empty when verified
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeCheck) Reset() {
+	*x = SuggestExamQuestionResponseV2_CodeCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_CodeCheck) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_CodeCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_CodeCheck.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_CodeCheck) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 10}
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeCheck) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// The outcome of a second, separate pass that classifies a question without seeing its tags.
+type SuggestExamQuestionResponseV2_TagCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BloomLevel       string  `protobuf:"bytes,1,opt,name=bloomLevel,proto3" json:"bloomLevel,omitempty"`               // Bloom level the classifier found
+	EstimatedMinutes float32 `protobuf:"fixed32,2,opt,name=estimatedMinutes,proto3" json:"estimatedMinutes,omitempty"` // Solve time the classifier estimated
+	Reason           string  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                       // The classifier's short justification
+}
+
+func (x *SuggestExamQuestionResponseV2_TagCheck) Reset() {
+	*x = SuggestExamQuestionResponseV2_TagCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_TagCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_TagCheck) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_TagCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_TagCheck.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_TagCheck) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 11}
+}
+
+func (x *SuggestExamQuestionResponseV2_TagCheck) GetBloomLevel() string {
+	if x != nil {
+		return x.BloomLevel
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_TagCheck) GetEstimatedMinutes() float32 {
+	if x != nil {
+		return x.EstimatedMinutes
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_TagCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// How close the Bloom levels of the exam came to the requested distribution.
+type SuggestExamQuestionResponseV2_BloomReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target *BloomDistribution `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Actual *BloomDistribution `protobuf:"bytes,2,opt,name=actual,proto3" json:"actual,omitempty"` // Questions per level, as classified when classification is on
+	Match  float32            `protobuf:"fixed32,3,opt,name=match,proto3" json:"match,omitempty"` // Share of the target (0-1) the exam meets: per level, the questions up to its target count
+}
+
+func (x *SuggestExamQuestionResponseV2_BloomReport) Reset() {
+	*x = SuggestExamQuestionResponseV2_BloomReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_BloomReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_BloomReport) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_BloomReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_BloomReport.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_BloomReport) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 12}
+}
+
+func (x *SuggestExamQuestionResponseV2_BloomReport) GetTarget() *BloomDistribution {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_BloomReport) GetActual() *BloomDistribution {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_BloomReport) GetMatch() float32 {
+	if x != nil {
+		return x.Match
+	}
+	return 0
+}
+
+// A passage of an uploaded document a question is based on.
+type SuggestExamQuestionResponseV2_Citation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId uint64 `protobuf:"varint,1,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	ChunkId    uint64 `protobuf:"varint,2,opt,name=chunkId,proto3" json:"chunkId,omitempty"` // Passage of the document
+	Section    string `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`  // Headings the passage is under, e.g. "Concurrency > Channels"
+	Page       int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`       // Page of PDF material, 0 for the other formats
+	Quote      string `protobuf:"bytes,5,opt,name=quote,proto3" json:"quote,omitempty"`      // Words of the passage the question relies on
+}
+
+func (x *SuggestExamQuestionResponseV2_Citation) Reset() {
+	*x = SuggestExamQuestionResponseV2_Citation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_Citation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_Citation) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_Citation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_Citation.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_Citation) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 13}
+}
+
+func (x *SuggestExamQuestionResponseV2_Citation) GetDocumentId() uint64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Citation) GetChunkId() uint64 {
+	if x != nil {
+		return x.ChunkId
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Citation) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Citation) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Citation) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+type SuggestExamQuestionResponseV2_McqDetailCommonSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                    // Type of question, e.g., "MCQ"
+	Options       []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`              // List of options for the MCQ
+	CorrectOption int32    `protobuf:"varint,3,opt,name=correctOption,proto3" json:"correctOption,omitempty"` // Index of the correct option, null if not applicable
+}
+
+func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) Reset() {
+	*x = SuggestExamQuestionResponseV2_McqDetailCommonSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_McqDetailCommonSchema) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_McqDetailCommonSchema.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_McqDetailCommonSchema) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 14}
+}
+
+func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_McqDetailCommonSchema) GetCorrectOption() int32 {
+	if x != nil {
+		return x.CorrectOption
+	}
+	return 0
+}
+
+type SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                   // Type of question, e.g., "LONG_ANSWER"
+	ImageLinks    []string `protobuf:"bytes,2,rep,name=imageLinks,proto3" json:"imageLinks,omitempty"`       // Optional links to images related to the question
+	ExtraText     string   `protobuf:"bytes,3,opt,name=extraText,proto3" json:"extraText,omitempty"`         // Optional additional text for context
+	CorrectAnswer string   `protobuf:"bytes,4,opt,name=correctAnswer,proto3" json:"correctAnswer,omitempty"` // The correct answer for long answer questions
+}
+
+func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) Reset() {
+	*x = SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 15}
+}
+
+func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetImageLinks() []string {
+	if x != nil {
+		return x.ImageLinks
+	}
+	return nil
+}
+
+func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetExtraText() string {
+	if x != nil {
+		return x.ExtraText
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema) GetCorrectAnswer() string {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return ""
+}
+
+// A rule a generated question still breaks after repair. questionId is 0 for
+// problems with the exam as a whole, such as the question count.
+type SuggestExamQuestionResponseV2_Violation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId int32  `protobuf:"varint,1,opt,name=questionId,proto3" json:"questionId,omitempty"`
+	Field      string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Code       string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message    string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SuggestExamQuestionResponseV2_Violation) Reset() {
+	*x = SuggestExamQuestionResponseV2_Violation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_Violation) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_Violation.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_Violation) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 16}
+}
+
+func (x *SuggestExamQuestionResponseV2_Violation) GetQuestionId() int32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *SuggestExamQuestionResponseV2_Violation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Violation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_Violation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SuggestExamQuestionResponseV2_MatchingDetail_Pair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Left  string `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right string `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail_Pair) Reset() {
+	*x = SuggestExamQuestionResponseV2_MatchingDetail_Pair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail_Pair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_MatchingDetail_Pair) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail_Pair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_MatchingDetail_Pair.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_MatchingDetail_Pair) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 6, 0}
+}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail_Pair) GetLeft() string {
+	if x != nil {
+		return x.Left
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_MatchingDetail_Pair) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
+}
+
+type SuggestExamQuestionResponseV2_CodeDetail_TestCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Input          string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`                   // Written to the program's standard input
+	ExpectedOutput string `protobuf:"bytes,2,opt,name=expectedOutput,proto3" json:"expectedOutput,omitempty"` // Standard output, compared ignoring trailing whitespace
+	Hidden         bool   `protobuf:"varint,3,opt,name=hidden,proto3" json:"hidden,omitempty"`                // Hidden tests are not shown to candidates
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail_TestCase) Reset() {
+	*x = SuggestExamQuestionResponseV2_CodeDetail_TestCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail_TestCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionResponseV2_CodeDetail_TestCase) ProtoMessage() {}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail_TestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionResponseV2_CodeDetail_TestCase.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionResponseV2_CodeDetail_TestCase) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{0, 8, 0}
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail_TestCase) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail_TestCase) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionResponseV2_CodeDetail_TestCase) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type SuggestExamQuestionRequest_Context struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text  string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Links []string `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"` // External links
+}
+
+func (x *SuggestExamQuestionRequest_Context) Reset() {
+	*x = SuggestExamQuestionRequest_Context{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestExamQuestionRequest_Context) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestExamQuestionRequest_Context) ProtoMessage() {}
+
+func (x *SuggestExamQuestionRequest_Context) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestExamQuestionRequest_Context.ProtoReflect.Descriptor instead.
+func (*SuggestExamQuestionRequest_Context) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *SuggestExamQuestionRequest_Context) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SuggestExamQuestionRequest_Context) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type SuggestInterviewQuestionRequest_Context struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position     string   `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Experience   string   `protobuf:"bytes,2,opt,name=experience,proto3" json:"experience,omitempty"`
+	Language     string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Models       string   `protobuf:"bytes,4,opt,name=models,proto3" json:"models,omitempty"`
+	Speed        int32    `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	Skills       []string `protobuf:"bytes,6,rep,name=skills,proto3" json:"skills,omitempty"`
+	MaxQuestions int32    `protobuf:"varint,7,opt,name=maxQuestions,proto3" json:"maxQuestions,omitempty"`
+	SkipIntro    bool     `protobuf:"varint,8,opt,name=skipIntro,proto3" json:"skipIntro,omitempty"`
+	SkipCode     bool     `protobuf:"varint,9,opt,name=skipCode,proto3" json:"skipCode,omitempty"`
+	InterviewId  string   `protobuf:"bytes,10,opt,name=interviewId,proto3" json:"interviewId,omitempty"`
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) Reset() {
+	*x = SuggestInterviewQuestionRequest_Context{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestInterviewQuestionRequest_Context) ProtoMessage() {}
+
+func (x *SuggestInterviewQuestionRequest_Context) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestInterviewQuestionRequest_Context.ProtoReflect.Descriptor instead.
+func (*SuggestInterviewQuestionRequest_Context) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetExperience() string {
+	if x != nil {
+		return x.Experience
+	}
+	return ""
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetModels() string {
+	if x != nil {
+		return x.Models
+	}
+	return ""
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetMaxQuestions() int32 {
+	if x != nil {
+		return x.MaxQuestions
+	}
+	return 0
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetSkipIntro() bool {
+	if x != nil {
+		return x.SkipIntro
+	}
+	return false
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetSkipCode() bool {
+	if x != nil {
+		return x.SkipCode
+	}
+	return false
+}
+
+func (x *SuggestInterviewQuestionRequest_Context) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+type SuggestInterviewQuestionRequest_Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Answer   string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *SuggestInterviewQuestionRequest_Submission) Reset() {
+	*x = SuggestInterviewQuestionRequest_Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestInterviewQuestionRequest_Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestInterviewQuestionRequest_Submission) ProtoMessage() {}
+
+func (x *SuggestInterviewQuestionRequest_Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestInterviewQuestionRequest_Submission.ProtoReflect.Descriptor instead.
+func (*SuggestInterviewQuestionRequest_Submission) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *SuggestInterviewQuestionRequest_Submission) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *SuggestInterviewQuestionRequest_Submission) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type ScoreInterviewRequest_Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Question string `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Answer   string `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *ScoreInterviewRequest_Submission) Reset() {
+	*x = ScoreInterviewRequest_Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreInterviewRequest_Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreInterviewRequest_Submission) ProtoMessage() {}
+
+func (x *ScoreInterviewRequest_Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreInterviewRequest_Submission.ProtoReflect.Descriptor instead.
+func (*ScoreInterviewRequest_Submission) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *ScoreInterviewRequest_Submission) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ScoreInterviewRequest_Submission) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *ScoreInterviewRequest_Submission) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type ScoreInterviewResponse_Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Score   string `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScoreInterviewResponse_Submission) Reset() {
+	*x = ScoreInterviewResponse_Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreInterviewResponse_Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreInterviewResponse_Submission) ProtoMessage() {}
+
+func (x *ScoreInterviewResponse_Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreInterviewResponse_Submission.ProtoReflect.Descriptor instead.
+func (*ScoreInterviewResponse_Submission) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *ScoreInterviewResponse_Submission) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ScoreInterviewResponse_Submission) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ScoreInterviewResponse_Submission) GetScore() string {
+	if x != nil {
+		return x.Score
+	}
+	return ""
+}
+
+type ScoreInterviewResponse_SkillScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skill string `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	Score string `protobuf:"bytes,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScoreInterviewResponse_SkillScore) Reset() {
+	*x = ScoreInterviewResponse_SkillScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_suggest_suggest_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreInterviewResponse_SkillScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreInterviewResponse_SkillScore) ProtoMessage() {}
+
+func (x *ScoreInterviewResponse_SkillScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_suggest_suggest_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreInterviewResponse_SkillScore.ProtoReflect.Descriptor instead.
+func (*ScoreInterviewResponse_SkillScore) Descriptor() ([]byte, []int) {
+	return file_proto_suggest_suggest_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *ScoreInterviewResponse_SkillScore) GetSkill() string {
+	if x != nil {
+		return x.Skill
+	}
+	return ""
+}
+
+func (x *ScoreInterviewResponse_SkillScore) GetScore() string {
+	if x != nil {
+		return x.Score
+	}
+	return ""
+}
+
+var File_proto_suggest_suggest_proto protoreflect.FileDescriptor
+
+var file_proto_suggest_suggest_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2f,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xff, 0x1b, 0x0a, 0x1d, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x32, 0x12, 0x4c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x51, 0x75,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x50, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x54, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e,
+	0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0xe9, 0x04, 0x0a, 0x07, 0x51, 0x75, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x06,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x32, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x4b, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x4b, 0x65,
+	0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x4d, 0x0a, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x43, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x2a, 0x0a, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x74,
+	0x61, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x08,
+	0x74, 0x61, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x32, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x1a, 0xbb, 0x06, 0x0a, 0x06, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x54, 0x65, 0x78, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x65, 0x46,
+	0x61, 0x6c, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x32, 0x2e, 0x54, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00,
+	0x52, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x5c, 0x0a,
+	0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x49,
+	0x6e, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x12, 0x53, 0x0a, 0x08, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x12, 0x53, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x43, 0x6f, 0x64, 0x65,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x68,
+	0x0a, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x32, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x1a, 0x5b, 0x0a, 0x11, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x37, 0x0a, 0x0f, 0x54, 0x72, 0x75, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0x55, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x63, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x1a, 0x94, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x50, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x1a, 0x30, 0x0a, 0x04, 0x50, 0x61, 0x69,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x26, 0x0a, 0x0e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x1a, 0xb4, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58,
+	0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x1a, 0x94, 0x01, 0x0a, 0x08, 0x4b,
+	0x65, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x64,
+	0x65, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x1a, 0x3f, 0x0a, 0x09, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x1a, 0x6e, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2a,
+	0x0a, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x1a, 0x8b, 0x01, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f,
+	0x6f, 0x6d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x1a, 0x88, 0x01, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x1a, 0x6b, 0x0a, 0x15, 0x4d,
+	0x63, 0x71, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x96, 0x01, 0x0a, 0x1c, 0x4c, 0x6f, 0x6e,
+	0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x54, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x1a, 0x6f, 0x0a, 0x09, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x75, 0x6e, 0x69, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4a, 0x75, 0x6e, 0x69, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x69, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x65, 0x6e, 0x69, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x4c, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4c, 0x65, 0x61,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x45, 0x78, 0x70, 0x65, 0x72, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x42, 0x6c,
+	0x6f, 0x6f, 0x6d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x55,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x22,
+	0x74, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x16,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe2, 0x06, 0x0a, 0x1a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x45,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x50, 0x0a, 0x09, 0x74, 0x79, 0x70,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6f, 0x64, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x4c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x51, 0x75, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x73, 0x12,
+	0x5c, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x32, 0x2e, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a,
+	0x11, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x33, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x1a, 0x3c, 0x0a, 0x0e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x1b, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a,
+	0x17, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0xe0, 0x04, 0x0a, 0x1f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xa7, 0x02, 0x0a, 0x07,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6b, 0x69,
+	0x70, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b,
+	0x69, 0x70, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
+	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x64, 0x1a, 0x40, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x20, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x32, 0x0a,
+	0x14, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x54, 0x0a, 0x12, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x45, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x6f, 0x73, 0x65,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x13, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5b,
+	0x0a, 0x17, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x15,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a,
+	0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x22, 0x5a, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x45, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x0c,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x22, 0x76, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x18, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x88, 0x05, 0x0a, 0x17, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4f, 0x66, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xd4, 0x01, 0x0a, 0x15, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x1a,
+	0x56, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xbe, 0x04, 0x0a, 0x16, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x52, 0x0a, 0x0a, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a,
+	0x38, 0x0a, 0x0a, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6b,
+	0x69, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xea, 0x02, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x51,
+	0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x22, 0xda, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x79, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x08, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x32, 0x2e, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22,
+	0xb4, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x32, 0x2e, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32,
+	0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x32, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22,
+	0x8e, 0x03, 0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x4a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x51, 0x75, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xe0, 0x01, 0x0a, 0x14, 0x53, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x09, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x32, 0x2e, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4b, 0x65, 0x79, 0x22, 0x4c, 0x0a, 0x15, 0x53, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x82, 0x02, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x45, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09,
+	0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x62, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0xd2, 0x02,
+	0x0a, 0x14, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x32, 0x2e, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x50, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b,
+	0x65, 0x79, 0x22, 0x5f, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x65, 0x78, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x52, 0x04,
+	0x65, 0x78, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x4a, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x4a, 0x0a,
+	0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x17, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32,
+	0x2e, 0x51, 0x75, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xa6, 0x13, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1f, 0x2e, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x3a, 0x01, 0x2a, 0x12,
+	0x71, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x10, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12,
+	0x71, 0x0a, 0x0e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x3a,
+	0x01, 0x2a, 0x12, 0x75, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x15, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x32, 0x12, 0x23, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x32,
+	0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x92, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23,
+	0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x51, 0x75, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x32,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x12, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76,
+	0x32, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x0c,
+	0x56, 0x61, 0x72, 0x79, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x79, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x32, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f,
+	0x76, 0x32, 0x2f, 0x76, 0x61, 0x72, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x75, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61,
+	0x6d, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x22, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x65, 0x78, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a,
+	0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x75, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22,
+	0x14, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x24, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x12, 0x6b,
+	0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x32, 0x2f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a, 0x0a, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x12, 0x75,
+	0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76,
+	0x32, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12,
+	0x16, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x7d, 0x12,
+	0x3d, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x16, 0x2e, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x17,
+	0x5a, 0x15, 0x6d, 0x79, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_suggest_suggest_proto_rawDescOnce sync.Once
+	file_proto_suggest_suggest_proto_rawDescData = file_proto_suggest_suggest_proto_rawDesc
+)
+
+func file_proto_suggest_suggest_proto_rawDescGZIP() []byte {
+	file_proto_suggest_suggest_proto_rawDescOnce.Do(func() {
+		file_proto_suggest_suggest_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_suggest_suggest_proto_rawDescData)
+	})
+	return file_proto_suggest_suggest_proto_rawDescData
+}
+
+var file_proto_suggest_suggest_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_proto_suggest_suggest_proto_goTypes = []interface{}{
+	(*SuggestExamQuestionResponseV2)(nil),                              // 0: suggest.SuggestExamQuestionResponseV2
+	(*DifficultyDistribution)(nil),                                     // 1: suggest.DifficultyDistribution
+	(*BloomDistribution)(nil),                                          // 2: suggest.BloomDistribution
+	(*Topic)(nil),                                                      // 3: suggest.Topic
+	(*SuggestExamQuestionRequest)(nil),                                 // 4: suggest.SuggestExamQuestionRequest
+	(*SuggestExamQuestionResponse)(nil),                                // 5: suggest.SuggestExamQuestionResponse
+	(*SuggestOutlinesRequest)(nil),                                     // 6: suggest.SuggestOutlinesRequest
+	(*SuggestOutlinesResponse)(nil),                                    // 7: suggest.SuggestOutlinesResponse
+	(*SuggestInterviewQuestionRequest)(nil),                            // 8: suggest.SuggestInterviewQuestionRequest
+	(*SuggestInterviewQuestionResponse)(nil),                           // 9: suggest.SuggestInterviewQuestionResponse
+	(*GeneralInfo)(nil),                                                // 10: suggest.GeneralInfo
+	(*CriteriaEleRequest)(nil),                                         // 11: suggest.CriteriaEleRequest
+	(*SuggestCriteriaRequest)(nil),                                     // 12: suggest.SuggestCriteriaRequest
+	(*CriteriaEleResponse)(nil),                                        // 13: suggest.CriteriaEleResponse
+	(*SuggestCriteriaResponse)(nil),                                    // 14: suggest.SuggestCriteriaResponse
+	(*SuggestOptionsRequest)(nil),                                      // 15: suggest.SuggestOptionsRequest
+	(*SuggestOptionsResponse)(nil),                                     // 16: suggest.SuggestOptionsResponse
+	(*AnswerOption)(nil),                                               // 17: suggest.AnswerOption
+	(*Question)(nil),                                                   // 18: suggest.Question
+	(*SuggestQuestionsResponse)(nil),                                   // 19: suggest.SuggestQuestionsResponse
+	(*SuggestQuestionsRequest)(nil),                                    // 20: suggest.SuggestQuestionsRequest
+	(*ScoreInterviewRequest)(nil),                                      // 21: suggest.ScoreInterviewRequest
+	(*ScoreInterviewResponse)(nil),                                     // 22: suggest.ScoreInterviewResponse
+	(*GetJobRequest)(nil),                                              // 23: suggest.GetJobRequest
+	(*GetJobResponse)(nil),                                             // 24: suggest.GetJobResponse
+	(*QuestionContext)(nil),                                            // 25: suggest.QuestionContext
+	(*RegenerateQuestionRequest)(nil),                                  // 26: suggest.RegenerateQuestionRequest
+	(*RewriteQuestionRequest)(nil),                                     // 27: suggest.RewriteQuestionRequest
+	(*VaryQuestionRequest)(nil),                                        // 28: suggest.VaryQuestionRequest
+	(*QuestionEditResponse)(nil),                                       // 29: suggest.QuestionEditResponse
+	(*TranslateExamRequest)(nil),                                       // 30: suggest.TranslateExamRequest
+	(*BankQuestion)(nil),                                               // 31: suggest.BankQuestion
+	(*SaveQuestionsRequest)(nil),                                       // 32: suggest.SaveQuestionsRequest
+	(*SaveQuestionsResponse)(nil),                                      // 33: suggest.SaveQuestionsResponse
+	(*SearchQuestionsRequest)(nil),                                     // 34: suggest.SearchQuestionsRequest
+	(*SearchQuestionsResponse)(nil),                                    // 35: suggest.SearchQuestionsResponse
+	(*UpdateQuestionStatusRequest)(nil),                                // 36: suggest.UpdateQuestionStatusRequest
+	(*AssembleExamRequest)(nil),                                        // 37: suggest.AssembleExamRequest
+	(*AssembledItem)(nil),                                              // 38: suggest.AssembledItem
+	(*AssembleExamResponse)(nil),                                       // 39: suggest.AssembleExamResponse
+	(*UploadDocumentRequest)(nil),                                      // 40: suggest.UploadDocumentRequest
+	(*UploadDocumentResponse)(nil),                                     // 41: suggest.UploadDocumentResponse
+	(*ExportExamRequest)(nil),                                          // 42: suggest.ExportExamRequest
+	(*ExportExamResponse)(nil),                                         // 43: suggest.ExportExamResponse
+	(*ImportQuestionsRequest)(nil),                                     // 44: suggest.ImportQuestionsRequest
+	(*ImportQuestionsResponse)(nil),                                    // 45: suggest.ImportQuestionsResponse
+	(*SuggestExamQuestionResponseV2_Quetion)(nil),                      // 46: suggest.SuggestExamQuestionResponseV2.Quetion
+	(*SuggestExamQuestionResponseV2_Detail)(nil),                       // 47: suggest.SuggestExamQuestionResponseV2.Detail
+	(*SuggestExamQuestionResponseV2_OptionExplanation)(nil),            // 48: suggest.SuggestExamQuestionResponseV2.OptionExplanation
+	(*SuggestExamQuestionResponseV2_TrueFalseDetail)(nil),              // 49: suggest.SuggestExamQuestionResponseV2.TrueFalseDetail
+	(*SuggestExamQuestionResponseV2_MultiSelectDetail)(nil),            // 50: suggest.SuggestExamQuestionResponseV2.MultiSelectDetail
+	(*SuggestExamQuestionResponseV2_FillInBlankDetail)(nil),            // 51: suggest.SuggestExamQuestionResponseV2.FillInBlankDetail
+	(*SuggestExamQuestionResponseV2_MatchingDetail)(nil),               // 52: suggest.SuggestExamQuestionResponseV2.MatchingDetail
+	(*SuggestExamQuestionResponseV2_OrderingDetail)(nil),               // 53: suggest.SuggestExamQuestionResponseV2.OrderingDetail
+	(*SuggestExamQuestionResponseV2_CodeDetail)(nil),                   // 54: suggest.SuggestExamQuestionResponseV2.CodeDetail
+	(*SuggestExamQuestionResponseV2_KeyCheck)(nil),                     // 55: suggest.SuggestExamQuestionResponseV2.KeyCheck
+	(*SuggestExamQuestionResponseV2_CodeCheck)(nil),                    // 56: suggest.SuggestExamQuestionResponseV2.CodeCheck
+	(*SuggestExamQuestionResponseV2_TagCheck)(nil),                     // 57: suggest.SuggestExamQuestionResponseV2.TagCheck
+	(*SuggestExamQuestionResponseV2_BloomReport)(nil),                  // 58: suggest.SuggestExamQuestionResponseV2.BloomReport
+	(*SuggestExamQuestionResponseV2_Citation)(nil),                     // 59: suggest.SuggestExamQuestionResponseV2.Citation
+	(*SuggestExamQuestionResponseV2_McqDetailCommonSchema)(nil),        // 60: suggest.SuggestExamQuestionResponseV2.McqDetailCommonSchema
+	(*SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema)(nil), // 61: suggest.SuggestExamQuestionResponseV2.LongAnswerDetailCommonSchema
+	(*SuggestExamQuestionResponseV2_Violation)(nil),                    // 62: suggest.SuggestExamQuestionResponseV2.Violation
+	(*SuggestExamQuestionResponseV2_MatchingDetail_Pair)(nil),          // 63: suggest.SuggestExamQuestionResponseV2.MatchingDetail.Pair
+	(*SuggestExamQuestionResponseV2_CodeDetail_TestCase)(nil),          // 64: suggest.SuggestExamQuestionResponseV2.CodeDetail.TestCase
+	(*SuggestExamQuestionRequest_Context)(nil),                         // 65: suggest.SuggestExamQuestionRequest.Context
+	nil, // 66: suggest.SuggestExamQuestionRequest.TypeRatioEntry
+	(*SuggestInterviewQuestionRequest_Context)(nil),    // 67: suggest.SuggestInterviewQuestionRequest.Context
+	(*SuggestInterviewQuestionRequest_Submission)(nil), // 68: suggest.SuggestInterviewQuestionRequest.Submission
+	nil,                                      // 69: suggest.SuggestQuestionsRequest.TypeRatioEntry
+	(*ScoreInterviewRequest_Submission)(nil), // 70: suggest.ScoreInterviewRequest.Submission
+	(*ScoreInterviewResponse_Submission)(nil), // 71: suggest.ScoreInterviewResponse.Submission
+	(*ScoreInterviewResponse_SkillScore)(nil), // 72: suggest.ScoreInterviewResponse.SkillScore
+	nil,                           // 73: suggest.ScoreInterviewResponse.TotalScoreEntry
+	(*timestamppb.Timestamp)(nil), // 74: google.protobuf.Timestamp
+}
+var file_proto_suggest_suggest_proto_depIdxs = []int32{
+	46, // 0: suggest.SuggestExamQuestionResponseV2.questions:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	62, // 1: suggest.SuggestExamQuestionResponseV2.violations:type_name -> suggest.SuggestExamQuestionResponseV2.Violation
+	58, // 2: suggest.SuggestExamQuestionResponseV2.bloomReport:type_name -> suggest.SuggestExamQuestionResponseV2.BloomReport
+	1,  // 3: suggest.Topic.difficultyDistribution:type_name -> suggest.DifficultyDistribution
+	3,  // 4: suggest.SuggestExamQuestionRequest.topics:type_name -> suggest.Topic
+	65, // 5: suggest.SuggestExamQuestionRequest.context:type_name -> suggest.SuggestExamQuestionRequest.Context
+	66, // 6: suggest.SuggestExamQuestionRequest.typeRatio:type_name -> suggest.SuggestExamQuestionRequest.TypeRatioEntry
+	46, // 7: suggest.SuggestExamQuestionRequest.exemplars:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	46, // 8: suggest.SuggestExamQuestionRequest.excludedQuestions:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	2,  // 9: suggest.SuggestExamQuestionRequest.bloomDistribution:type_name -> suggest.BloomDistribution
+	18, // 10: suggest.SuggestExamQuestionResponse.questions:type_name -> suggest.Question
+	67, // 11: suggest.SuggestInterviewQuestionRequest.context:type_name -> suggest.SuggestInterviewQuestionRequest.Context
+	68, // 12: suggest.SuggestInterviewQuestionRequest.submissions:type_name -> suggest.SuggestInterviewQuestionRequest.Submission
+	10, // 13: suggest.SuggestCriteriaRequest.generalInfo:type_name -> suggest.GeneralInfo
+	11, // 14: suggest.SuggestCriteriaRequest.criteriaList:type_name -> suggest.CriteriaEleRequest
+	13, // 15: suggest.SuggestCriteriaResponse.criteriaList:type_name -> suggest.CriteriaEleResponse
+	10, // 16: suggest.SuggestOptionsRequest.generalInfo:type_name -> suggest.GeneralInfo
+	11, // 17: suggest.SuggestOptionsRequest.criteriaList:type_name -> suggest.CriteriaEleRequest
+	13, // 18: suggest.SuggestOptionsResponse.criteriaList:type_name -> suggest.CriteriaEleResponse
+	18, // 19: suggest.SuggestQuestionsResponse.questions:type_name -> suggest.Question
+	69, // 20: suggest.SuggestQuestionsRequest.typeRatio:type_name -> suggest.SuggestQuestionsRequest.TypeRatioEntry
+	70, // 21: suggest.ScoreInterviewRequest.submissions:type_name -> suggest.ScoreInterviewRequest.Submission
+	71, // 22: suggest.ScoreInterviewResponse.result:type_name -> suggest.ScoreInterviewResponse.Submission
+	72, // 23: suggest.ScoreInterviewResponse.skills:type_name -> suggest.ScoreInterviewResponse.SkillScore
+	73, // 24: suggest.ScoreInterviewResponse.totalScore:type_name -> suggest.ScoreInterviewResponse.TotalScoreEntry
+	74, // 25: suggest.GetJobResponse.createdAt:type_name -> google.protobuf.Timestamp
+	74, // 26: suggest.GetJobResponse.startedAt:type_name -> google.protobuf.Timestamp
+	74, // 27: suggest.GetJobResponse.finishedAt:type_name -> google.protobuf.Timestamp
+	46, // 28: suggest.RegenerateQuestionRequest.question:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	25, // 29: suggest.RegenerateQuestionRequest.context:type_name -> suggest.QuestionContext
+	46, // 30: suggest.RewriteQuestionRequest.question:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	25, // 31: suggest.RewriteQuestionRequest.context:type_name -> suggest.QuestionContext
+	46, // 32: suggest.VaryQuestionRequest.question:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	25, // 33: suggest.VaryQuestionRequest.context:type_name -> suggest.QuestionContext
+	46, // 34: suggest.QuestionEditResponse.question:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	62, // 35: suggest.QuestionEditResponse.violations:type_name -> suggest.SuggestExamQuestionResponseV2.Violation
+	0,  // 36: suggest.TranslateExamRequest.exam:type_name -> suggest.SuggestExamQuestionResponseV2
+	46, // 37: suggest.BankQuestion.question:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	74, // 38: suggest.BankQuestion.createdAt:type_name -> google.protobuf.Timestamp
+	74, // 39: suggest.BankQuestion.updatedAt:type_name -> google.protobuf.Timestamp
+	46, // 40: suggest.SaveQuestionsRequest.questions:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	31, // 41: suggest.SaveQuestionsResponse.questions:type_name -> suggest.BankQuestion
+	31, // 42: suggest.SearchQuestionsResponse.questions:type_name -> suggest.BankQuestion
+	4,  // 43: suggest.AssembleExamRequest.blueprint:type_name -> suggest.SuggestExamQuestionRequest
+	46, // 44: suggest.AssembleExamResponse.questions:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	38, // 45: suggest.AssembleExamResponse.items:type_name -> suggest.AssembledItem
+	62, // 46: suggest.AssembleExamResponse.violations:type_name -> suggest.SuggestExamQuestionResponseV2.Violation
+	0,  // 47: suggest.ExportExamRequest.exam:type_name -> suggest.SuggestExamQuestionResponseV2
+	62, // 48: suggest.ExportExamResponse.skipped:type_name -> suggest.SuggestExamQuestionResponseV2.Violation
+	46, // 49: suggest.ImportQuestionsResponse.questions:type_name -> suggest.SuggestExamQuestionResponseV2.Quetion
+	62, // 50: suggest.ImportQuestionsResponse.violations:type_name -> suggest.SuggestExamQuestionResponseV2.Violation
+	47, // 51: suggest.SuggestExamQuestionResponseV2.Quetion.detail:type_name -> suggest.SuggestExamQuestionResponseV2.Detail
+	55, // 52: suggest.SuggestExamQuestionResponseV2.Quetion.keyCheck:type_name -> suggest.SuggestExamQuestionResponseV2.KeyCheck
+	59, // 53: suggest.SuggestExamQuestionResponseV2.Quetion.citations:type_name -> suggest.SuggestExamQuestionResponseV2.Citation
+	57, // 54: suggest.SuggestExamQuestionResponseV2.Quetion.tagCheck:type_name -> suggest.SuggestExamQuestionResponseV2.TagCheck
+	56, // 55: suggest.SuggestExamQuestionResponseV2.Quetion.codeCheck:type_name -> suggest.SuggestExamQuestionResponseV2.CodeCheck
+	49, // 56: suggest.SuggestExamQuestionResponseV2.Detail.trueFalse:type_name -> suggest.SuggestExamQuestionResponseV2.TrueFalseDetail
+	50, // 57: suggest.SuggestExamQuestionResponseV2.Detail.multiSelect:type_name -> suggest.SuggestExamQuestionResponseV2.MultiSelectDetail
+	51, // 58: suggest.SuggestExamQuestionResponseV2.Detail.fillInBlank:type_name -> suggest.SuggestExamQuestionResponseV2.FillInBlankDetail
+	52, // 59: suggest.SuggestExamQuestionResponseV2.Detail.matching:type_name -> suggest.SuggestExamQuestionResponseV2.MatchingDetail
+	53, // 60: suggest.SuggestExamQuestionResponseV2.Detail.ordering:type_name -> suggest.SuggestExamQuestionResponseV2.OrderingDetail
+	54, // 61: suggest.SuggestExamQuestionResponseV2.Detail.code:type_name -> suggest.SuggestExamQuestionResponseV2.CodeDetail
+	48, // 62: suggest.SuggestExamQuestionResponseV2.Detail.optionExplanations:type_name -> suggest.SuggestExamQuestionResponseV2.OptionExplanation
+	63, // 63: suggest.SuggestExamQuestionResponseV2.MatchingDetail.pairs:type_name -> suggest.SuggestExamQuestionResponseV2.MatchingDetail.Pair
+	64, // 64: suggest.SuggestExamQuestionResponseV2.CodeDetail.testCases:type_name -> suggest.SuggestExamQuestionResponseV2.CodeDetail.TestCase
+	2,  // 65: suggest.SuggestExamQuestionResponseV2.BloomReport.target:type_name -> suggest.BloomDistribution
+	2,  // 66: suggest.SuggestExamQuestionResponseV2.BloomReport.actual:type_name -> suggest.BloomDistribution
+	12, // 67: suggest.SuggestService.SuggestCriteria:input_type -> suggest.SuggestCriteriaRequest
+	15, // 68: suggest.SuggestService.SuggestOptions:input_type -> suggest.SuggestOptionsRequest
+	20, // 69: suggest.SuggestService.SuggestQuestions:input_type -> suggest.SuggestQuestionsRequest
+	8,  // 70: suggest.SuggestService.SuggestInterviewQuestion:input_type -> suggest.SuggestInterviewQuestionRequest
+	21, // 71: suggest.SuggestService.ScoreInterview:input_type -> suggest.ScoreInterviewRequest
+	6,  // 72: suggest.SuggestService.SuggestOutlines:input_type -> suggest.SuggestOutlinesRequest
+	4,  // 73: suggest.SuggestService.SuggestExamQuestionV2:input_type -> suggest.SuggestExamQuestionRequest
+	4,  // 74: suggest.SuggestService.StreamExamQuestions:input_type -> suggest.SuggestExamQuestionRequest
+	26, // 75: suggest.SuggestService.RegenerateQuestion:input_type -> suggest.RegenerateQuestionRequest
+	27, // 76: suggest.SuggestService.RewriteQuestion:input_type -> suggest.RewriteQuestionRequest
+	28, // 77: suggest.SuggestService.VaryQuestion:input_type -> suggest.VaryQuestionRequest
+	30, // 78: suggest.SuggestService.TranslateExam:input_type -> suggest.TranslateExamRequest
+	32, // 79: suggest.SuggestService.SaveQuestions:input_type -> suggest.SaveQuestionsRequest
+	34, // 80: suggest.SuggestService.SearchQuestions:input_type -> suggest.SearchQuestionsRequest
+	36, // 81: suggest.SuggestService.UpdateQuestionStatus:input_type -> suggest.UpdateQuestionStatusRequest
+	37, // 82: suggest.SuggestService.AssembleExam:input_type -> suggest.AssembleExamRequest
+	40, // 83: suggest.SuggestService.UploadDocument:input_type -> suggest.UploadDocumentRequest
+	42, // 84: suggest.SuggestService.ExportExam:input_type -> suggest.ExportExamRequest
+	44, // 85: suggest.SuggestService.ImportQuestions:input_type -> suggest.ImportQuestionsRequest
+	23, // 86: suggest.SuggestService.GetJob:input_type -> suggest.GetJobRequest
+	23, // 87: suggest.SuggestService.WatchJob:input_type -> suggest.GetJobRequest
+	14, // 88: suggest.SuggestService.SuggestCriteria:output_type -> suggest.SuggestCriteriaResponse
+	16, // 89: suggest.SuggestService.SuggestOptions:output_type -> suggest.SuggestOptionsResponse
+	0,  // 90: suggest.SuggestService.SuggestQuestions:output_type -> suggest.SuggestExamQuestionResponseV2
+	9,  // 91: suggest.SuggestService.SuggestInterviewQuestion:output_type -> suggest.SuggestInterviewQuestionResponse
+	22, // 92: suggest.SuggestService.ScoreInterview:output_type -> suggest.ScoreInterviewResponse
+	7,  // 93: suggest.SuggestService.SuggestOutlines:output_type -> suggest.SuggestOutlinesResponse
+	0,  // 94: suggest.SuggestService.SuggestExamQuestionV2:output_type -> suggest.SuggestExamQuestionResponseV2
+	46, // 95: suggest.SuggestService.StreamExamQuestions:output_type -> suggest.SuggestExamQuestionResponseV2.Quetion
+	29, // 96: suggest.SuggestService.RegenerateQuestion:output_type -> suggest.QuestionEditResponse
+	29, // 97: suggest.SuggestService.RewriteQuestion:output_type -> suggest.QuestionEditResponse
+	0,  // 98: suggest.SuggestService.VaryQuestion:output_type -> suggest.SuggestExamQuestionResponseV2
+	0,  // 99: suggest.SuggestService.TranslateExam:output_type -> suggest.SuggestExamQuestionResponseV2
+	33, // 100: suggest.SuggestService.SaveQuestions:output_type -> suggest.SaveQuestionsResponse
+	35, // 101: suggest.SuggestService.SearchQuestions:output_type -> suggest.SearchQuestionsResponse
+	31, // 102: suggest.SuggestService.UpdateQuestionStatus:output_type -> suggest.BankQuestion
+	39, // 103: suggest.SuggestService.AssembleExam:output_type -> suggest.AssembleExamResponse
+	41, // 104: suggest.SuggestService.UploadDocument:output_type -> suggest.UploadDocumentResponse
+	43, // 105: suggest.SuggestService.ExportExam:output_type -> suggest.ExportExamResponse
+	45, // 106: suggest.SuggestService.ImportQuestions:output_type -> suggest.ImportQuestionsResponse
+	24, // 107: suggest.SuggestService.GetJob:output_type -> suggest.GetJobResponse
+	24, // 108: suggest.SuggestService.WatchJob:output_type -> suggest.GetJobResponse
+	88, // [88:109] is the sub-list for method output_type
+	67, // [67:88] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_proto_suggest_suggest_proto_init() }
 func file_proto_suggest_suggest_proto_init() {
 	if File_proto_suggest_suggest_proto != nil {
 		return
@@ -2677,8 +6388,248 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DifficultyDistribution); i {
+		file_proto_suggest_suggest_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DifficultyDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BloomDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Topic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestOutlinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestOutlinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestInterviewQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestInterviewQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneralInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriteriaEleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestCriteriaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriteriaEleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestCriteriaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestOptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreInterviewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2689,8 +6640,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Topic); i {
+		file_proto_suggest_suggest_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreInterviewResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2701,8 +6652,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestExamQuestionRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2713,8 +6664,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestExamQuestionResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2725,8 +6676,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestOutlinesRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2737,8 +6688,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestOutlinesResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateQuestionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2749,8 +6700,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestInterviewQuestionRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewriteQuestionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2761,8 +6712,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestInterviewQuestionResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaryQuestionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2773,8 +6724,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeneralInfo); i {
+		file_proto_suggest_suggest_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionEditResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2785,8 +6736,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CriteriaEleRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateExamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2797,8 +6748,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestCriteriaRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2809,8 +6760,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CriteriaEleResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveQuestionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2821,8 +6772,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestCriteriaResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveQuestionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2833,8 +6784,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestOptionsRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchQuestionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2845,8 +6796,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestOptionsResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchQuestionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2857,8 +6808,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnswerOption); i {
+		file_proto_suggest_suggest_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateQuestionStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2869,8 +6820,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Question); i {
+		file_proto_suggest_suggest_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssembleExamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2881,8 +6832,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestQuestionsResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssembledItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2893,8 +6844,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestQuestionsRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssembleExamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2905,8 +6856,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScoreInterviewRequest); i {
+		file_proto_suggest_suggest_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDocumentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2917,8 +6868,8 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScoreInterviewResponse); i {
+		file_proto_suggest_suggest_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDocumentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2929,7 +6880,55 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SuggestExamQuestionResponseV2_Quetion); i {
 			case 0:
 				return &v.state
@@ -2941,7 +6940,7 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SuggestExamQuestionResponseV2_Detail); i {
 			case 0:
 				return &v.state
@@ -2953,7 +6952,151 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_OptionExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_TrueFalseDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_MultiSelectDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_FillInBlankDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_MatchingDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_OrderingDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_CodeDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_KeyCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_CodeCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_TagCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_BloomReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_Citation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SuggestExamQuestionResponseV2_McqDetailCommonSchema); i {
 			case 0:
 				return &v.state
@@ -2965,7 +7108,7 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SuggestExamQuestionResponseV2_LongAnswerDetailCommonSchema); i {
 			case 0:
 				return &v.state
@@ -2977,7 +7120,43 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_Violation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_MatchingDetail_Pair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestExamQuestionResponseV2_CodeDetail_TestCase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_suggest_suggest_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SuggestExamQuestionRequest_Context); i {
 			case 0:
 				return &v.state
@@ -2989,7 +7168,7 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SuggestInterviewQuestionRequest_Context); i {
 			case 0:
 				return &v.state
@@ -3001,7 +7180,7 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SuggestInterviewQuestionRequest_Submission); i {
 			case 0:
 				return &v.state
@@ -3013,7 +7192,7 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScoreInterviewRequest_Submission); i {
 			case 0:
 				return &v.state
@@ -3025,7 +7204,7 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScoreInterviewResponse_Submission); i {
 			case 0:
 				return &v.state
@@ -3037,7 +7216,7 @@ func file_proto_suggest_suggest_proto_init() {
 				return nil
 			}
 		}
-		file_proto_suggest_suggest_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_suggest_suggest_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScoreInterviewResponse_SkillScore); i {
 			case 0:
 				return &v.state
@@ -3050,13 +7229,21 @@ func file_proto_suggest_suggest_proto_init() {
 			}
 		}
 	}
+	file_proto_suggest_suggest_proto_msgTypes[47].OneofWrappers = []interface{}{
+		(*SuggestExamQuestionResponseV2_Detail_TrueFalse)(nil),
+		(*SuggestExamQuestionResponseV2_Detail_MultiSelect)(nil),
+		(*SuggestExamQuestionResponseV2_Detail_FillInBlank)(nil),
+		(*SuggestExamQuestionResponseV2_Detail_Matching)(nil),
+		(*SuggestExamQuestionResponseV2_Detail_Ordering)(nil),
+		(*SuggestExamQuestionResponseV2_Detail_Code)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_suggest_suggest_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   33,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,46 @@ type SuggestServiceClient interface {
 	ScoreInterview(ctx context.Context, in *ScoreInterviewRequest, opts ...grpc.CallOption) (*ScoreInterviewResponse, error)
 	SuggestOutlines(ctx context.Context, in *SuggestOutlinesRequest, opts ...grpc.CallOption) (*SuggestOutlinesResponse, error)
 	SuggestExamQuestionV2(ctx context.Context, in *SuggestExamQuestionRequest, opts ...grpc.CallOption) (*SuggestExamQuestionResponseV2, error)
+	// Streams each question as soon as it has been parsed from the LLM output and has
+	// passed the checks of SuggestExamQuestionV2, repaired on its own when it breaks them.
+	// Questions that still break them are left out; doubtful answer keys, tags and
+	// unverified code are recorded in keyCheck, tagCheck and codeCheck.
+	// The gateway serves it as newline-delimited JSON.
+	StreamExamQuestions(ctx context.Context, in *SuggestExamQuestionRequest, opts ...grpc.CallOption) (SuggestService_StreamExamQuestionsClient, error)
+	// Replaces one exam question with a new one on the same topic and level.
+	RegenerateQuestion(ctx context.Context, in *RegenerateQuestionRequest, opts ...grpc.CallOption) (*QuestionEditResponse, error)
+	// Rewrites one exam question following an instruction such as "make harder" or "shorten".
+	RewriteQuestion(ctx context.Context, in *RewriteQuestionRequest, opts ...grpc.CallOption) (*QuestionEditResponse, error)
+	// Generates isomorphic variants of one exam question: the same skill at the same
+	// difficulty with different details.
+	VaryQuestion(ctx context.Context, in *VaryQuestionRequest, opts ...grpc.CallOption) (*SuggestExamQuestionResponseV2, error)
+	// Translates a generated exam into another language, keeping its ids, answer keys,
+	// points and code as they are.
+	TranslateExam(ctx context.Context, in *TranslateExamRequest, opts ...grpc.CallOption) (*SuggestExamQuestionResponseV2, error)
+	// Saves questions to the question bank as drafts, so they can be reviewed and reused.
+	SaveQuestions(ctx context.Context, in *SaveQuestionsRequest, opts ...grpc.CallOption) (*SaveQuestionsResponse, error)
+	// Searches the question bank by text, metadata and tags, newest first. Callers only see
+	// their own questions unless their x-user-role is "reviewer".
+	SearchQuestions(ctx context.Context, in *SearchQuestionsRequest, opts ...grpc.CallOption) (*SearchQuestionsResponse, error)
+	// Sets the review status of a question of the bank. Only its author and reviewers may.
+	UpdateQuestionStatus(ctx context.Context, in *UpdateQuestionStatusRequest, opts ...grpc.CallOption) (*BankQuestion, error)
+	// Assembles an exam from the blueprint of a SuggestExamQuestionRequest, filling it with
+	// approved questions of the question bank first and generating only the gaps.
+	AssembleExam(ctx context.Context, in *AssembleExamRequest, opts ...grpc.CallOption) (*AssembleExamResponse, error)
+	// Uploads course material to ground generated exams in. The document is split into
+	// passages, which exams listing it in documentIds are generated from and cite.
+	UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error)
+	// Exports an exam in a format learning management systems import. The gateway also
+	// serves the file itself as a download at POST /v2/export_exam/download.
+	ExportExam(ctx context.Context, in *ExportExamRequest, opts ...grpc.CallOption) (*ExportExamResponse, error)
+	// Reads the questions of a GIFT, Aiken, Moodle XML or CSV file, to be saved to the
+	// question bank or passed to exam generation as exemplars or excluded questions.
+	ImportQuestions(ctx context.Context, in *ImportQuestionsRequest, opts ...grpc.CallOption) (*ImportQuestionsResponse, error)
+	// Returns the state of an async generation started with a requestKey.
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	// Streams the job state on every transition until it succeeds or fails. The gateway
+	// serves it as server-sent events on /v1/jobs/{requestKey}/events.
+	WatchJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (SuggestService_WatchJobClient, error)
 }
 
 type suggestServiceClient struct {
@@ -102,6 +142,178 @@ func (c *suggestServiceClient) SuggestExamQuestionV2(ctx context.Context, in *Su
 	return out, nil
 }
 
+func (c *suggestServiceClient) StreamExamQuestions(ctx context.Context, in *SuggestExamQuestionRequest, opts ...grpc.CallOption) (SuggestService_StreamExamQuestionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SuggestService_ServiceDesc.Streams[0], "/suggest.SuggestService/StreamExamQuestions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &suggestServiceStreamExamQuestionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SuggestService_StreamExamQuestionsClient interface {
+	Recv() (*SuggestExamQuestionResponseV2_Quetion, error)
+	grpc.ClientStream
+}
+
+type suggestServiceStreamExamQuestionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *suggestServiceStreamExamQuestionsClient) Recv() (*SuggestExamQuestionResponseV2_Quetion, error) {
+	m := new(SuggestExamQuestionResponseV2_Quetion)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *suggestServiceClient) RegenerateQuestion(ctx context.Context, in *RegenerateQuestionRequest, opts ...grpc.CallOption) (*QuestionEditResponse, error) {
+	out := new(QuestionEditResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/RegenerateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) RewriteQuestion(ctx context.Context, in *RewriteQuestionRequest, opts ...grpc.CallOption) (*QuestionEditResponse, error) {
+	out := new(QuestionEditResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/RewriteQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) VaryQuestion(ctx context.Context, in *VaryQuestionRequest, opts ...grpc.CallOption) (*SuggestExamQuestionResponseV2, error) {
+	out := new(SuggestExamQuestionResponseV2)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/VaryQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) TranslateExam(ctx context.Context, in *TranslateExamRequest, opts ...grpc.CallOption) (*SuggestExamQuestionResponseV2, error) {
+	out := new(SuggestExamQuestionResponseV2)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/TranslateExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) SaveQuestions(ctx context.Context, in *SaveQuestionsRequest, opts ...grpc.CallOption) (*SaveQuestionsResponse, error) {
+	out := new(SaveQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/SaveQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) SearchQuestions(ctx context.Context, in *SearchQuestionsRequest, opts ...grpc.CallOption) (*SearchQuestionsResponse, error) {
+	out := new(SearchQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/SearchQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) UpdateQuestionStatus(ctx context.Context, in *UpdateQuestionStatusRequest, opts ...grpc.CallOption) (*BankQuestion, error) {
+	out := new(BankQuestion)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/UpdateQuestionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) AssembleExam(ctx context.Context, in *AssembleExamRequest, opts ...grpc.CallOption) (*AssembleExamResponse, error) {
+	out := new(AssembleExamResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/AssembleExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error) {
+	out := new(UploadDocumentResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/UploadDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) ExportExam(ctx context.Context, in *ExportExamRequest, opts ...grpc.CallOption) (*ExportExamResponse, error) {
+	out := new(ExportExamResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/ExportExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) ImportQuestions(ctx context.Context, in *ImportQuestionsRequest, opts ...grpc.CallOption) (*ImportQuestionsResponse, error) {
+	out := new(ImportQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/ImportQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, "/suggest.SuggestService/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestServiceClient) WatchJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (SuggestService_WatchJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SuggestService_ServiceDesc.Streams[1], "/suggest.SuggestService/WatchJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &suggestServiceWatchJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SuggestService_WatchJobClient interface {
+	Recv() (*GetJobResponse, error)
+	grpc.ClientStream
+}
+
+type suggestServiceWatchJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *suggestServiceWatchJobClient) Recv() (*GetJobResponse, error) {
+	m := new(GetJobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SuggestServiceServer is the server API for SuggestService service.
 // All implementations must embed UnimplementedSuggestServiceServer
 // for forward compatibility
@@ -113,6 +325,46 @@ type SuggestServiceServer interface {
 	ScoreInterview(context.Context, *ScoreInterviewRequest) (*ScoreInterviewResponse, error)
 	SuggestOutlines(context.Context, *SuggestOutlinesRequest) (*SuggestOutlinesResponse, error)
 	SuggestExamQuestionV2(context.Context, *SuggestExamQuestionRequest) (*SuggestExamQuestionResponseV2, error)
+	// Streams each question as soon as it has been parsed from the LLM output and has
+	// passed the checks of SuggestExamQuestionV2, repaired on its own when it breaks them.
+	// Questions that still break them are left out; doubtful answer keys, tags and
+	// unverified code are recorded in keyCheck, tagCheck and codeCheck.
+	// The gateway serves it as newline-delimited JSON.
+	StreamExamQuestions(*SuggestExamQuestionRequest, SuggestService_StreamExamQuestionsServer) error
+	// Replaces one exam question with a new one on the same topic and level.
+	RegenerateQuestion(context.Context, *RegenerateQuestionRequest) (*QuestionEditResponse, error)
+	// Rewrites one exam question following an instruction such as "make harder" or "shorten".
+	RewriteQuestion(context.Context, *RewriteQuestionRequest) (*QuestionEditResponse, error)
+	// Generates isomorphic variants of one exam question: the same skill at the same
+	// difficulty with different details.
+	VaryQuestion(context.Context, *VaryQuestionRequest) (*SuggestExamQuestionResponseV2, error)
+	// Translates a generated exam into another language, keeping its ids, answer keys,
+	// points and code as they are.
+	TranslateExam(context.Context, *TranslateExamRequest) (*SuggestExamQuestionResponseV2, error)
+	// Saves questions to the question bank as drafts, so they can be reviewed and reused.
+	SaveQuestions(context.Context, *SaveQuestionsRequest) (*SaveQuestionsResponse, error)
+	// Searches the question bank by text, metadata and tags, newest first. Callers only see
+	// their own questions unless their x-user-role is "reviewer".
+	SearchQuestions(context.Context, *SearchQuestionsRequest) (*SearchQuestionsResponse, error)
+	// Sets the review status of a question of the bank. Only its author and reviewers may.
+	UpdateQuestionStatus(context.Context, *UpdateQuestionStatusRequest) (*BankQuestion, error)
+	// Assembles an exam from the blueprint of a SuggestExamQuestionRequest, filling it with
+	// approved questions of the question bank first and generating only the gaps.
+	AssembleExam(context.Context, *AssembleExamRequest) (*AssembleExamResponse, error)
+	// Uploads course material to ground generated exams in. The document is split into
+	// passages, which exams listing it in documentIds are generated from and cite.
+	UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error)
+	// Exports an exam in a format learning management systems import. The gateway also
+	// serves the file itself as a download at POST /v2/export_exam/download.
+	ExportExam(context.Context, *ExportExamRequest) (*ExportExamResponse, error)
+	// Reads the questions of a GIFT, Aiken, Moodle XML or CSV file, to be saved to the
+	// question bank or passed to exam generation as exemplars or excluded questions.
+	ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error)
+	// Returns the state of an async generation started with a requestKey.
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	// Streams the job state on every transition until it succeeds or fails. The gateway
+	// serves it as server-sent events on /v1/jobs/{requestKey}/events.
+	WatchJob(*GetJobRequest, SuggestService_WatchJobServer) error
 	mustEmbedUnimplementedSuggestServiceServer()
 }
 
@@ -141,6 +393,48 @@ func (UnimplementedSuggestServiceServer) SuggestOutlines(context.Context, *Sugge
 func (UnimplementedSuggestServiceServer) SuggestExamQuestionV2(context.Context, *SuggestExamQuestionRequest) (*SuggestExamQuestionResponseV2, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuggestExamQuestionV2 not implemented")
 }
+func (UnimplementedSuggestServiceServer) StreamExamQuestions(*SuggestExamQuestionRequest, SuggestService_StreamExamQuestionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExamQuestions not implemented")
+}
+func (UnimplementedSuggestServiceServer) RegenerateQuestion(context.Context, *RegenerateQuestionRequest) (*QuestionEditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateQuestion not implemented")
+}
+func (UnimplementedSuggestServiceServer) RewriteQuestion(context.Context, *RewriteQuestionRequest) (*QuestionEditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewriteQuestion not implemented")
+}
+func (UnimplementedSuggestServiceServer) VaryQuestion(context.Context, *VaryQuestionRequest) (*SuggestExamQuestionResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaryQuestion not implemented")
+}
+func (UnimplementedSuggestServiceServer) TranslateExam(context.Context, *TranslateExamRequest) (*SuggestExamQuestionResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TranslateExam not implemented")
+}
+func (UnimplementedSuggestServiceServer) SaveQuestions(context.Context, *SaveQuestionsRequest) (*SaveQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveQuestions not implemented")
+}
+func (UnimplementedSuggestServiceServer) SearchQuestions(context.Context, *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchQuestions not implemented")
+}
+func (UnimplementedSuggestServiceServer) UpdateQuestionStatus(context.Context, *UpdateQuestionStatusRequest) (*BankQuestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestionStatus not implemented")
+}
+func (UnimplementedSuggestServiceServer) AssembleExam(context.Context, *AssembleExamRequest) (*AssembleExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssembleExam not implemented")
+}
+func (UnimplementedSuggestServiceServer) UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
+}
+func (UnimplementedSuggestServiceServer) ExportExam(context.Context, *ExportExamRequest) (*ExportExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportExam not implemented")
+}
+func (UnimplementedSuggestServiceServer) ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportQuestions not implemented")
+}
+func (UnimplementedSuggestServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedSuggestServiceServer) WatchJob(*GetJobRequest, SuggestService_WatchJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJob not implemented")
+}
 func (UnimplementedSuggestServiceServer) mustEmbedUnimplementedSuggestServiceServer() {}
 
 // UnsafeSuggestServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -280,6 +574,264 @@ func _SuggestService_SuggestExamQuestionV2_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SuggestService_StreamExamQuestions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SuggestExamQuestionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SuggestServiceServer).StreamExamQuestions(m, &suggestServiceStreamExamQuestionsServer{stream})
+}
+
+type SuggestService_StreamExamQuestionsServer interface {
+	Send(*SuggestExamQuestionResponseV2_Quetion) error
+	grpc.ServerStream
+}
+
+type suggestServiceStreamExamQuestionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *suggestServiceStreamExamQuestionsServer) Send(m *SuggestExamQuestionResponseV2_Quetion) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SuggestService_RegenerateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).RegenerateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/RegenerateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).RegenerateQuestion(ctx, req.(*RegenerateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_RewriteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewriteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).RewriteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/RewriteQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).RewriteQuestion(ctx, req.(*RewriteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_VaryQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaryQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).VaryQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/VaryQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).VaryQuestion(ctx, req.(*VaryQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_TranslateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).TranslateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/TranslateExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).TranslateExam(ctx, req.(*TranslateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_SaveQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).SaveQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/SaveQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).SaveQuestions(ctx, req.(*SaveQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_SearchQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).SearchQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/SearchQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).SearchQuestions(ctx, req.(*SearchQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_UpdateQuestionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).UpdateQuestionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/UpdateQuestionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).UpdateQuestionStatus(ctx, req.(*UpdateQuestionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_AssembleExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssembleExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).AssembleExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/AssembleExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).AssembleExam(ctx, req.(*AssembleExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_UploadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).UploadDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/UploadDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).UploadDocument(ctx, req.(*UploadDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_ExportExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).ExportExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/ExportExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).ExportExam(ctx, req.(*ExportExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_ImportQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).ImportQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/ImportQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).ImportQuestions(ctx, req.(*ImportQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/suggest.SuggestService/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestService_WatchJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SuggestServiceServer).WatchJob(m, &suggestServiceWatchJobServer{stream})
+}
+
+type SuggestService_WatchJobServer interface {
+	Send(*GetJobResponse) error
+	grpc.ServerStream
+}
+
+type suggestServiceWatchJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *suggestServiceWatchJobServer) Send(m *GetJobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // SuggestService_ServiceDesc is the grpc.ServiceDesc for SuggestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -315,7 +867,66 @@ var SuggestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SuggestExamQuestionV2",
 			Handler:    _SuggestService_SuggestExamQuestionV2_Handler,
 		},
+		{
+			MethodName: "RegenerateQuestion",
+			Handler:    _SuggestService_RegenerateQuestion_Handler,
+		},
+		{
+			MethodName: "RewriteQuestion",
+			Handler:    _SuggestService_RewriteQuestion_Handler,
+		},
+		{
+			MethodName: "VaryQuestion",
+			Handler:    _SuggestService_VaryQuestion_Handler,
+		},
+		{
+			MethodName: "TranslateExam",
+			Handler:    _SuggestService_TranslateExam_Handler,
+		},
+		{
+			MethodName: "SaveQuestions",
+			Handler:    _SuggestService_SaveQuestions_Handler,
+		},
+		{
+			MethodName: "SearchQuestions",
+			Handler:    _SuggestService_SearchQuestions_Handler,
+		},
+		{
+			MethodName: "UpdateQuestionStatus",
+			Handler:    _SuggestService_UpdateQuestionStatus_Handler,
+		},
+		{
+			MethodName: "AssembleExam",
+			Handler:    _SuggestService_AssembleExam_Handler,
+		},
+		{
+			MethodName: "UploadDocument",
+			Handler:    _SuggestService_UploadDocument_Handler,
+		},
+		{
+			MethodName: "ExportExam",
+			Handler:    _SuggestService_ExportExam_Handler,
+		},
+		{
+			MethodName: "ImportQuestions",
+			Handler:    _SuggestService_ImportQuestions_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _SuggestService_GetJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamExamQuestions",
+			Handler:       _SuggestService_StreamExamQuestions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJob",
+			Handler:       _SuggestService_WatchJob_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/suggest/suggest.proto",
 }